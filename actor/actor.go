@@ -0,0 +1,157 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package actor
+
+import (
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/goki/ki/kit"
+)
+
+// ActorLayer is an output layer that selects an action at the end of the
+// minus phase, from its unit activations (one action per unit) or, for a layer
+// with pools (4D), from its pool average activations (one action per pool),
+// according to the Select policy.  If Clamp is on, the selected action is clamped
+// in the plus phase (all units in the pool for pools), so that the ActorPrjn
+// DA-modulated learning applies to the action actually taken.
+// Receives dopamine from a critic (e.g., rl.TDDaLayer, rl.RWDaLayer) via SendDA.
+// Action selection uses the layer's Rnd random number stream, which is derived
+// from the network seed and the layer name when the network is in RndStreams
+// mode (see leabra.Network.SetSeed), so that it is reproducible.
+type ActorLayer struct {
+	leabra.Layer
+	Select SelectParams `view:"inline" desc:"action selection parameters"`
+	Clamp  bool         `def:"true" desc:"clamp the selected action in the plus phase -- otherwise the plus phase settles freely (e.g., with a Target from the environment)"`
+	DA     float32      `inactive:"+" desc:"dopamine value for this layer"`
+	Action int          `inactive:"+" desc:"action selected on the current trial, -1 if none yet"`
+	Forced int          `desc:"if >= 0, this action is selected on the next trial instead of using the policy (e.g., for demonstrations) -- reset to -1 after use"`
+	Vals   []float32    `view:"-" desc:"action values at the end of the minus phase"`
+	Probs  []float32    `view:"-" desc:"action selection probabilities for the current trial"`
+}
+
+var KiT_ActorLayer = kit.Types.AddType(&ActorLayer{}, leabra.LayerProps)
+
+func (ly *ActorLayer) Defaults() {
+	ly.Layer.Defaults()
+	ly.Select.Defaults()
+	ly.Clamp = true
+	ly.Action = -1
+	ly.Forced = -1
+}
+
+// DALayer interface:
+
+func (ly *ActorLayer) GetDA() float32   { return ly.DA }
+func (ly *ActorLayer) SetDA(da float32) { ly.DA = da }
+
+// ByPool returns true if actions are represented by pools, i.e., the layer is 4D
+func (ly *ActorLayer) ByPool() bool {
+	return ly.Is4D()
+}
+
+// NActions returns the number of actions: pools if ByPool, else units
+func (ly *ActorLayer) NActions() int {
+	if ly.ByPool() {
+		return len(ly.Pools) - 1
+	}
+	return len(ly.Neurons)
+}
+
+// Build constructs the layer state, including calling Build on the projections.
+func (ly *ActorLayer) Build() error {
+	err := ly.Layer.Build()
+	if err != nil {
+		return err
+	}
+	na := ly.NActions()
+	ly.Vals = make([]float32, na)
+	ly.Probs = make([]float32, na)
+	return nil
+}
+
+func (ly *ActorLayer) InitWts() {
+	ly.Layer.InitWts()
+	ly.Action = -1
+	ly.DA = 0
+}
+
+func (ly *ActorLayer) InitActs() {
+	ly.Layer.InitActs()
+	ly.DA = 0
+}
+
+// AlphaCycInit handles all initialization at start of new input pattern --
+// clears the action clamped on the previous trial, if Clamp is on
+// (any Targ input is retained, and applied in the plus phase as usual).
+func (ly *ActorLayer) AlphaCycInit() {
+	if ly.Clamp {
+		for ni := range ly.Neurons {
+			nrn := &ly.Neurons[ni]
+			nrn.Ext = 0
+			nrn.ClearFlag(leabra.NeurHasExt)
+		}
+	}
+	ly.Layer.AlphaCycInit()
+}
+
+// ActionVals computes the action values from the minus phase activations:
+// pool averages if ByPool, else unit activations.
+func (ly *ActorLayer) ActionVals() []float32 {
+	if ly.ByPool() {
+		for pi := 1; pi < len(ly.Pools); pi++ {
+			ly.Vals[pi-1] = ly.Pools[pi].ActM.Avg
+		}
+		return ly.Vals
+	}
+	for ni := range ly.Neurons {
+		ly.Vals[ni] = ly.Neurons[ni].ActM
+	}
+	return ly.Vals
+}
+
+// SelectAction selects the action for the current trial from the minus phase
+// activations, using the Forced action if set, and otherwise the Select policy.
+func (ly *ActorLayer) SelectAction() int {
+	vals := ly.ActionVals()
+	if ly.Forced >= 0 && ly.Forced < len(vals) {
+		ly.Action = ly.Forced
+		ly.Forced = -1
+		return ly.Action
+	}
+	ly.Action = ly.Select.Select(vals, ly.Probs, ly.Rnd)
+	return ly.Action
+}
+
+// ClampAction clamps the given action as external input for the plus phase:
+// activity of 1 for the unit or units in the pool for the action, and 0 otherwise.
+func (ly *ActorLayer) ClampAction(act int) {
+	for ni := range ly.Neurons {
+		nrn := &ly.Neurons[ni]
+		if nrn.IsOff() {
+			continue
+		}
+		ai := ni
+		if ly.ByPool() {
+			ai = int(nrn.SubPool) - 1
+		}
+		nrn.Ext = 0
+		if ai == act {
+			nrn.Ext = 1
+		}
+		nrn.SetFlag(leabra.NeurHasExt)
+	}
+}
+
+// QuarterFinal does updating after end of a quarter -- selects
+// the action at the end of the minus phase, and clamps it if Clamp is on.
+func (ly *ActorLayer) QuarterFinal(ltime *leabra.Time) {
+	ly.Layer.QuarterFinal(ltime)
+	if ltime.Quarter != 2 {
+		return
+	}
+	act := ly.SelectAction()
+	if ly.Clamp {
+		ly.ClampAction(act)
+	}
+}
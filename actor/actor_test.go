@@ -0,0 +1,186 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package actor
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/ccnlab/leabrax/rl"
+	"github.com/chewxy/math32"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+	"github.com/emer/emergent/relpos"
+)
+
+func TestProbs(t *testing.T) {
+	sp := SelectParams{}
+	sp.Defaults()
+	vals := []float32{0.1, 0.8, 0.3, 0.8}
+	probs := make([]float32, len(vals))
+	for _, pol := range []Policies{Softmax, EpsGreedy} {
+		sp.Policy = pol
+		sp.Probs(vals, probs)
+		sum := float32(0)
+		for _, p := range probs {
+			sum += p
+		}
+		if math32.Abs(sum-1) > 1.0e-5 {
+			t.Errorf("%v probs: %v sum: %g != 1", pol, probs, sum)
+		}
+		if probs[1] != probs[3] || probs[1] <= probs[2] || probs[2] < probs[0] {
+			t.Errorf("%v probs: %v not ordered by vals: %v", pol, probs, vals)
+		}
+	}
+	sp.Policy = EpsGreedy
+	sp.Probs(vals, probs)
+	if p := sp.Epsilon / 4; probs[0] != p || probs[2] != p {
+		t.Errorf("EpsGreedy probs: %v non-max != %g", probs, p)
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	sp.Epsilon = 0
+	for i := 0; i < 20; i++ {
+		if act := sp.Select(vals, probs, rnd); act != 1 && act != 3 {
+			t.Errorf("greedy selected: %d, not a max", act)
+		}
+	}
+}
+
+// banditNet is an Input layer driving an Actor, with a RW critic
+type banditNet struct {
+	Net   *leabra.Network
+	Input *leabra.Layer
+	Actor *ActorLayer
+	Rew   leabra.LeabraLayer
+	Time  *leabra.Time
+}
+
+func newBanditNet(t *testing.T, seed int64) *banditNet {
+	rand.Seed(1)
+	bn := &banditNet{}
+	net := &leabra.Network{}
+	net.InitName(net, "Bandit")
+	bn.Net = net
+	bn.Input = net.AddLayer2D("Input", 1, 2, emer.Input).(*leabra.Layer)
+	bn.Actor = AddActorLayer(net, "Actor", 1, 4)
+	rew, rp, _ := AddRWCritic(net, "", bn.Actor, relpos.Behind, 2)
+	bn.Rew = rew
+	apj := ConnectToActor(net, bn.Input, bn.Actor, prjn.NewFull()).(*ActorPrjn)
+	net.ConnectLayersPrjn(bn.Input, rp, prjn.NewFull(), emer.Forward, &rl.RWPrjn{})
+	net.Defaults()
+	apj.WtInit.Var = 0 // all actions equal initially
+	apj.Learn.Lrate = 0.1
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.SetSeed(seed)
+	net.InitWts()
+	bn.Time = leabra.NewTime()
+	return bn
+}
+
+// Trial runs one alpha cycle, applying reward 1 at the start of the plus phase
+// if the Actor selected the target action, and 0 otherwise.
+// Returns the selected action.
+func (bn *banditNet) Trial(target int) int {
+	bn.Net.InitExt()
+	bn.Input.ApplyExt1D32([]float32{1, 1})
+	bn.Net.AlphaCycInit()
+	bn.Time.AlphaCycStart()
+	for qtr := 0; qtr < 4; qtr++ {
+		if qtr == 3 {
+			rew := float32(0)
+			if bn.Actor.Action == target {
+				rew = 1
+			}
+			bn.Rew.AsLeabra().ApplyExt1D32([]float32{rew})
+		}
+		for cyc := 0; cyc < bn.Time.CycPerQtr; cyc++ {
+			bn.Net.Cycle(bn.Time)
+			bn.Time.CycleInc()
+		}
+		bn.Net.QuarterFinal(bn.Time)
+		bn.Time.QuarterInc()
+	}
+	bn.Net.DWt()
+	bn.Net.WtFmDWt()
+	return bn.Actor.Action
+}
+
+// TestClamp checks that the selected action is clamped in the plus phase
+func TestClamp(t *testing.T) {
+	bn := newBanditNet(t, 1)
+	for trl := 0; trl < 5; trl++ {
+		act := bn.Trial(-1)
+		if act < 0 || act >= 4 {
+			t.Fatalf("trial %d invalid action: %d", trl, act)
+		}
+		for ni := range bn.Actor.Neurons {
+			actP := bn.Actor.Neurons[ni].ActP
+			if (ni == act && actP < 0.9) || (ni != act && actP > 0.1) {
+				t.Errorf("trial %d action: %d unit %d ActP: %g", trl, act, ni, actP)
+			}
+		}
+	}
+	bn.Actor.Forced = 2
+	if act := bn.Trial(-1); act != 2 {
+		t.Errorf("Forced action: %d != 2", act)
+	}
+	if bn.Actor.Forced != -1 {
+		t.Errorf("Forced not reset after use: %d", bn.Actor.Forced)
+	}
+}
+
+// TestSeed checks that action selection is reproducible with the same network seed
+func TestSeed(t *testing.T) {
+	seqs := make([][]int, 3)
+	for i, seed := range []int64{1, 1, 2} {
+		bn := newBanditNet(t, seed)
+		for trl := 0; trl < 20; trl++ {
+			seqs[i] = append(seqs[i], bn.Trial(2))
+		}
+	}
+	same := func(a, b []int) bool {
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return true
+	}
+	if !same(seqs[0], seqs[1]) {
+		t.Errorf("same seed gave different actions: %v != %v", seqs[0], seqs[1])
+	}
+	if same(seqs[0], seqs[2]) {
+		t.Errorf("different seeds gave same actions: %v", seqs[0])
+	}
+}
+
+// TestLearn checks that the actor learns to select the rewarded action
+// with each policy, and then selects it greedily.
+func TestLearn(t *testing.T) {
+	target := 2
+	for _, pol := range []Policies{Softmax, EpsGreedy} {
+		bn := newBanditNet(t, 1)
+		bn.Actor.Select.Policy = pol
+		bn.Actor.Select.Epsilon = 0.2
+		for trl := 0; trl < 200; trl++ {
+			bn.Trial(target)
+		}
+		bn.Actor.Select.Epsilon = 0
+		ntst := 20
+		ncor := 0
+		for trl := 0; trl < ntst; trl++ {
+			if bn.Trial(target) == target {
+				ncor++
+			}
+		}
+		if ncor < ntst {
+			t.Errorf("%v: after learning, target action selected on: %d of %d trials", pol, ncor, ntst)
+		}
+	}
+}
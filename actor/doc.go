@@ -0,0 +1,19 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package actor provides action selection on top of the rl critics,
+for actor-critic reinforcement learning.
+
+* ActorLayer selects an action at the end of the minus phase
+  from its unit or pool activations, using a Softmax or EpsGreedy
+  policy with the layer's Rnd random number stream, and optionally
+  clamps the selected action in the plus phase.
+
+* ActorPrjn does DA-modulated learning for the action taken.
+
+* AddTDCritic and AddRWCritic add the rl TD or RW layers,
+  sending DA to the actor.
+*/
+package actor
@@ -0,0 +1,56 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package actor
+
+import (
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/ccnlab/leabrax/rl"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+	"github.com/emer/emergent/relpos"
+)
+
+// NOTE: like rl, actor layers are designed to be "mix-ins" with other networks
+// so there is no actor network type -- just routines to add layers.
+
+// AddActorLayer adds an ActorLayer with one action per unit, using 2D shape
+func AddActorLayer(nt *leabra.Network, name string, nY, nX int) *ActorLayer {
+	ly := &ActorLayer{}
+	nt.AddLayerInit(ly, name, []int{nY, nX}, emer.Hidden)
+	return ly
+}
+
+// AddActorLayer4D adds an ActorLayer with one action per pool, using 4D shape
+func AddActorLayer4D(nt *leabra.Network, name string, nPoolsY, nPoolsX, nNeurY, nNeurX int) *ActorLayer {
+	ly := &ActorLayer{}
+	nt.AddLayerInit(ly, name, []int{nPoolsY, nPoolsX, nNeurY, nNeurX}, emer.Hidden)
+	return ly
+}
+
+// ConnectToActor adds an ActorPrjn from given sending layer to an actor layer,
+// with class ActorPrjn
+func ConnectToActor(nt *leabra.Network, send, recv emer.Layer, pat prjn.Pattern) emer.Prjn {
+	pj := nt.ConnectLayersPrjn(send, recv, pat, emer.Forward, &ActorPrjn{})
+	pj.SetClass("ActorPrjn")
+	return pj
+}
+
+// AddTDCritic adds the rl TD layers (see rl.AddTDLayers) as a critic for the given
+// actor layer: the TD layer sends DA to the actor and the RewPred layer.
+// Input to RewPred must be added using rl.TDRewPredPrjn (or rl.TDLambdaPrjn).
+func AddTDCritic(nt *leabra.Network, prefix string, actor emer.Layer, rel relpos.Relations, space float32) (rew, rp, ri, td leabra.LeabraLayer) {
+	rew, rp, ri, td = rl.AddTDLayers(nt, prefix, rel, space)
+	td.(*rl.TDDaLayer).SendDA.Add(actor.Name(), rp.Name())
+	return
+}
+
+// AddRWCritic adds the rl Rescorla-Wagner layers (see rl.AddRWLayers) as a critic for
+// the given actor layer: the DA layer sends DA to the actor and the RWPred layer.
+// Input to RWPred must be added using rl.RWPrjn.
+func AddRWCritic(nt *leabra.Network, prefix string, actor emer.Layer, rel relpos.Relations, space float32) (rew, rp, da leabra.LeabraLayer) {
+	rew, rp, da = rl.AddRWLayers(nt, prefix, rel, space)
+	da.(*rl.RWDaLayer).SendDA.Add(actor.Name(), rp.Name())
+	return
+}
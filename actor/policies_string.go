@@ -0,0 +1,40 @@
+// Code generated by "stringer -type=Policies"; DO NOT EDIT.
+
+package actor
+
+import (
+	"errors"
+	"strconv"
+)
+
+var _ = errors.New("dummy error")
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[Softmax-0]
+	_ = x[EpsGreedy-1]
+	_ = x[PoliciesN-2]
+}
+
+const _Policies_name = "SoftmaxEpsGreedyPoliciesN"
+
+var _Policies_index = [...]uint8{0, 7, 16, 25}
+
+func (i Policies) String() string {
+	if i < 0 || i >= Policies(len(_Policies_index)-1) {
+		return "Policies(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _Policies_name[_Policies_index[i]:_Policies_index[i+1]]
+}
+
+func (i *Policies) FromString(s string) error {
+	for j := 0; j < len(_Policies_index)-1; j++ {
+		if s == _Policies_name[_Policies_index[j]:_Policies_index[j+1]] {
+			*i = Policies(j)
+			return nil
+		}
+	}
+	return errors.New("String: " + s + " is not a valid option for type: Policies")
+}
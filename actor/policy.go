@@ -0,0 +1,104 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package actor
+
+import (
+	"math/rand"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/chewxy/math32"
+	"github.com/goki/ki/kit"
+)
+
+// Policies are the action selection policies
+type Policies int
+
+//go:generate stringer -type=Policies
+
+var KiT_Policies = kit.Enums.AddEnum(PoliciesN, kit.NotBitFlag, nil)
+
+func (ev Policies) MarshalJSON() ([]byte, error)  { return kit.EnumMarshalJSON(ev) }
+func (ev *Policies) UnmarshalJSON(b []byte) error { return kit.EnumUnmarshalJSON(ev, b) }
+
+const (
+	// Softmax selects each action with probability proportional to exp(val / Temp)
+	Softmax Policies = iota
+
+	// EpsGreedy selects a random action with probability Epsilon,
+	// and otherwise the action with the highest value (random among ties)
+	EpsGreedy
+
+	PoliciesN
+)
+
+// SelectParams are parameters for selecting an action from action values
+type SelectParams struct {
+	Policy  Policies `desc:"action selection policy"`
+	Temp    float32  `viewif:"Policy=Softmax" def:"0.1" min:"0" desc:"softmax temperature -- lower values are more deterministic, selecting the highest-valued action -- activations are in 0..1 range so values around 0.1 are typical"`
+	Epsilon float32  `viewif:"Policy=EpsGreedy" def:"0.1" min:"0" max:"1" desc:"probability of selecting a random action instead of the highest-valued one"`
+}
+
+func (sp *SelectParams) Defaults() {
+	sp.Policy = Softmax
+	sp.Temp = 0.1
+	sp.Epsilon = 0.1
+}
+
+// Probs computes the probability of selecting each action from
+// given action values according to the policy, into probs.
+func (sp *SelectParams) Probs(vals, probs []float32) {
+	n := len(vals)
+	if n == 0 {
+		return
+	}
+	mxi := 0
+	for i, v := range vals {
+		if v > vals[mxi] {
+			mxi = i
+		}
+	}
+	switch sp.Policy {
+	case Softmax:
+		temp := math32.Max(sp.Temp, 1.0e-6)
+		sum := float32(0)
+		for i, v := range vals {
+			probs[i] = math32.Exp((v - vals[mxi]) / temp) // subtract max for numerical stability
+			sum += probs[i]
+		}
+		for i := range probs {
+			probs[i] /= sum
+		}
+	case EpsGreedy:
+		nmx := 0
+		for _, v := range vals {
+			if v == vals[mxi] {
+				nmx++
+			}
+		}
+		for i, v := range vals {
+			probs[i] = sp.Epsilon / float32(n)
+			if v == vals[mxi] {
+				probs[i] += (1 - sp.Epsilon) / float32(nmx)
+			}
+		}
+	}
+}
+
+// Select returns the index of an action selected from given action values
+// according to the policy, using given random number stream,
+// or the global generator if nil.
+// probs is filled in with the selection probabilities.
+func (sp *SelectParams) Select(vals, probs []float32, rnd *rand.Rand) int {
+	sp.Probs(vals, probs)
+	r := float32(leabra.RndFloat64(rnd))
+	cum := float32(0)
+	for i, p := range probs {
+		cum += p
+		if r < cum {
+			return i
+		}
+	}
+	return len(probs) - 1
+}
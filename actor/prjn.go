@@ -0,0 +1,91 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package actor
+
+import (
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/ccnlab/leabrax/rl"
+	"github.com/chewxy/math32"
+	"github.com/goki/ki/kit"
+)
+
+// ActorPrjn does dopamine-modulated learning for action selection,
+// into an ActorLayer (or any rl.DALayer):
+// DWt = Da * Send.ActM * Recv.ActP
+// where Recv.ActP is the clamped selected action, so that the weights for
+// the action taken increase when DA is positive and decrease when negative.
+// Weights are soft-bounded to 0..1.
+type ActorPrjn struct {
+	leabra.Prjn
+}
+
+var KiT_ActorPrjn = kit.Types.AddType(&ActorPrjn{}, leabra.PrjnProps)
+
+func (pj *ActorPrjn) Defaults() {
+	pj.Prjn.Defaults()
+	// no additional factors
+	pj.Learn.WtSig.Gain = 1
+	pj.Learn.Norm.On = false
+	pj.Learn.Momentum.On = false
+	pj.Learn.WtBal.On = false
+}
+
+// DWt computes the weight change (learning) -- on sending projections.
+func (pj *ActorPrjn) DWt() {
+	if !pj.Learn.Learn {
+		return
+	}
+	slay := pj.Send.(leabra.LeabraLayer).AsLeabra()
+	rlay := pj.Recv.(leabra.LeabraLayer).AsLeabra()
+	da := pj.Recv.(rl.DALayer).GetDA()
+	if da == 0 {
+		return
+	}
+	for si := range slay.Neurons {
+		sn := &slay.Neurons[si]
+		nc := int(pj.SConN[si])
+		st := int(pj.SConIdxSt[si])
+		syns := pj.Syns[st : st+nc]
+		scons := pj.SConIdx[st : st+nc]
+
+		for ci := range syns {
+			sy := &syns[ci]
+			ri := scons[ci]
+			rn := &rlay.Neurons[ri]
+
+			dwt := da * sn.ActM * rn.ActP
+			if dwt > 0 {
+				dwt *= (1 - sy.LWt)
+			} else {
+				dwt *= sy.LWt
+			}
+
+			norm := float32(1)
+			if pj.Learn.Norm.On {
+				norm = pj.Learn.Norm.NormFmAbsDWt(&sy.Norm, math32.Abs(dwt))
+			}
+			if pj.Learn.Momentum.On {
+				dwt = norm * pj.Learn.Momentum.MomentFmDWt(&sy.Moment, dwt)
+			} else {
+				dwt *= norm
+			}
+			sy.DWt += pj.Learn.Lrate * dwt
+		}
+		// aggregate max DWtNorm over sending synapses
+		if pj.Learn.Norm.On {
+			maxNorm := float32(0)
+			for ci := range syns {
+				sy := &syns[ci]
+				if sy.Norm > maxNorm {
+					maxNorm = sy.Norm
+				}
+			}
+			for ci := range syns {
+				sy := &syns[ci]
+				sy.Norm = maxNorm
+			}
+		}
+	}
+}
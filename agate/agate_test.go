@@ -0,0 +1,242 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package agate
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ccnlab/leabrax/glong"
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+	"github.com/emer/etable/etensor"
+)
+
+// testNet is a minimal Input -> Mnt -> Out maintenance circuit with 3 stripes
+// of 2x2 units, where Mnt -> Out is Off except when output gating.
+type testNet struct {
+	Net    *leabra.Network
+	Input  *leabra.Layer
+	Mnt    *MaintLayer
+	Out    *OutLayer
+	MntOut *leabra.Prjn
+	Time   *leabra.Time
+	Pat    *etensor.Float32
+}
+
+func newTestNet(t *testing.T, nmdaGbar float32) *testNet {
+	rand.Seed(1)
+	tn := &testNet{}
+	net := &leabra.Network{}
+	net.InitName(net, "AGateTest")
+	tn.Net = net
+	tn.Input = net.AddLayer4D("Input", 1, 3, 2, 2, emer.Input).(*leabra.Layer)
+	tn.Mnt = AddMaintLayer(net, "Mnt", 1, 3, 2, 2)
+	tn.Out = AddOutLayer(net, "Out", 1, 3, 2, 2)
+	net.ConnectLayers(tn.Input, tn.Mnt, prjn.NewOneToOne(), emer.Forward)
+	tn.MntOut = net.ConnectLayers(tn.Mnt, tn.Out, prjn.NewOneToOne(), emer.Forward).(*leabra.Prjn)
+	tn.Mnt.InterInhib.Lays.Add(tn.Out.Name())
+	tn.Out.Out.ClearLays.Add(tn.Mnt.Name())
+	net.Defaults()
+	tn.Mnt.NMDA.Gbar = nmdaGbar
+	tn.Mnt.RcvPrjns.SendName("Mnt").(*glong.NMDAPrjn).WtScale.Abs = 2
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	tn.MntOut.Off = true
+	tn.Time = leabra.NewTime()
+	tn.Pat = etensor.NewFloat32([]int{1, 3, 2, 2}, nil, nil)
+	return tn
+}
+
+// Trial runs one alpha cycle with input to given stripe (none if < 0),
+// with Mnt -> Out on if gate is true.  If cycFn is non-nil it is
+// called after each cycle.
+func (tn *testNet) Trial(stripe int, gate bool, cycFn func(cyc int)) {
+	tn.Pat.SetZeros()
+	if stripe >= 0 {
+		for i := 0; i < 4; i++ {
+			tn.Pat.Values[stripe*4+i] = 1
+		}
+	}
+	tn.Input.ApplyExt(tn.Pat)
+	tn.MntOut.Off = !gate
+	tn.Net.AlphaCycInit()
+	tn.Time.AlphaCycStart()
+	for qtr := 0; qtr < 4; qtr++ {
+		for cyc := 0; cyc < tn.Time.CycPerQtr; cyc++ {
+			tn.Net.Cycle(tn.Time)
+			if cycFn != nil {
+				cycFn(tn.Time.Cycle)
+			}
+			tn.Time.CycleInc()
+		}
+		tn.Net.QuarterFinal(tn.Time)
+		tn.Time.QuarterInc()
+	}
+}
+
+// StripeAct returns the max activation in given stripe (pool) of layer
+func StripeAct(ly *leabra.Layer, stripe int) float32 {
+	return ly.Pools[stripe+1].Inhib.Act.Max
+}
+
+func TestMaintNMDA(t *testing.T) {
+	tn := newTestNet(t, 0.04)
+	tn.Trial(1, false, nil)
+	if act := StripeAct(tn.Mnt.AsLeabra(), 1); act < 0.5 {
+		t.Fatalf("Mnt stripe 1 not activated by input: %g\n", act)
+	}
+	for trl := 0; trl < 3; trl++ {
+		tn.Trial(-1, false, nil)
+		for si := 0; si < 3; si++ {
+			act := StripeAct(tn.Mnt.AsLeabra(), si)
+			if si == 1 && act < 0.5 {
+				t.Errorf("blank trial %d: Mnt stripe 1 not maintained: %g\n", trl, act)
+			}
+			if si != 1 && act > 0.1 {
+				t.Errorf("blank trial %d: Mnt stripe %d active: %g\n", trl, si, act)
+			}
+		}
+		if nmda := tn.Mnt.GlNeurs[4].NMDA; nmda <= 0 {
+			t.Errorf("blank trial %d: no NMDA in maintained stripe: %g\n", trl, nmda)
+		}
+	}
+
+	// without NMDA, the activity decays as soon as input is removed
+	tn = newTestNet(t, 0)
+	tn.Trial(1, false, nil)
+	if act := StripeAct(tn.Mnt.AsLeabra(), 1); act < 0.5 {
+		t.Fatalf("no NMDA: Mnt stripe 1 not activated by input: %g\n", act)
+	}
+	tn.Trial(-1, false, nil)
+	if act := StripeAct(tn.Mnt.AsLeabra(), 1); act > 0.1 {
+		t.Errorf("no NMDA: Mnt stripe 1 maintained: %g\n", act)
+	}
+}
+
+// restoreAct stores stripe 1, output gates it to clear Mnt, and then stores
+// stripe 2, returning the mean Mnt Gk at the end of the gating trial and the
+// Mnt activation of stripe 2 at cycle 6 of the next store trial.
+func restoreAct(t *testing.T, gabab float32) (gk, act float32) {
+	tn := newTestNet(t, 0.04)
+	tn.Mnt.PulseClear.GABAB = gabab
+	tn.Trial(1, false, nil)
+	tn.Trial(-1, false, nil)
+	cleared := false
+	tn.Trial(-1, true, func(cyc int) {
+		if cleared || cyc < tn.Out.NMDA.AlphaMaxCyc || StripeAct(tn.Out.AsLeabra(), 1) <= tn.Out.Out.ResetThr {
+			return
+		}
+		cleared = true
+		for ni := range tn.Mnt.GlNeurs {
+			nrn := &tn.Mnt.Neurons[ni]
+			gnr := &tn.Mnt.GlNeurs[ni]
+			if nrn.Act != 0 || gnr.NMDA != 0 || gnr.Gnmda != 0 {
+				t.Errorf("cycle %d: Mnt neuron %d not cleared: act: %g  nmda: %g\n", cyc, ni, nrn.Act, gnr.NMDA)
+			}
+			if gnr.GABAB != gabab {
+				t.Errorf("cycle %d: Mnt neuron %d GABAB: %g != PulseClear.GABAB: %g\n", cyc, ni, gnr.GABAB, gabab)
+			}
+		}
+	})
+	if !cleared {
+		t.Fatalf("Out never exceeded ResetThr: %g\n", StripeAct(tn.Out.AsLeabra(), 1))
+	}
+	if act := StripeAct(tn.Mnt.AsLeabra(), 1); act > 0.1 {
+		t.Errorf("Mnt stripe 1 still active after PulseClear: %g\n", act)
+	}
+	for ni := range tn.Mnt.Neurons {
+		gk += tn.Mnt.Neurons[ni].Gk
+	}
+	gk /= float32(len(tn.Mnt.Neurons))
+	tn.Trial(2, false, func(cyc int) {
+		if cyc == 6 {
+			act = StripeAct(tn.Mnt.AsLeabra(), 2)
+		}
+	})
+	return
+}
+
+func TestPulseClear(t *testing.T) {
+	gk, act := restoreAct(t, 2)
+	nogk, noact := restoreAct(t, 0)
+	if gk <= nogk {
+		t.Errorf("PulseClear GABAB did not increase Gk: with GABAB: %g  without: %g\n", gk, nogk)
+	}
+	if act >= noact {
+		t.Errorf("GABAB refractory period did not slow re-activation: with GABAB: %g  without: %g\n", act, noact)
+	}
+}
+
+// mntGi returns the Mnt layer-level Gi at the end of a trial with stripe 1
+// input, with the given InterInhib source layer driven or not
+func mntGi(t *testing.T, addSrc func(net *leabra.Network) emer.Layer, drive bool) float32 {
+	rand.Seed(1)
+	net := &leabra.Network{}
+	net.InitName(net, "AGateInterInhib")
+	in := net.AddLayer4D("Input", 1, 3, 2, 2, emer.Input).(*leabra.Layer)
+	sin := net.AddLayer2D("SrcInput", 2, 2, emer.Input).(*leabra.Layer)
+	mnt := AddMaintLayer(net, "Mnt", 1, 3, 2, 2)
+	src := addSrc(net)
+	net.ConnectLayers(in, mnt, prjn.NewOneToOne(), emer.Forward)
+	net.ConnectLayers(sin, src, prjn.NewOneToOne(), emer.Forward)
+	mnt.InterInhib.Lays.Add(src.Name())
+	net.Defaults()
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	ltime := leabra.NewTime()
+	pat := etensor.NewFloat32([]int{1, 3, 2, 2}, nil, nil)
+	for i := 0; i < 4; i++ {
+		pat.Values[4+i] = 1
+	}
+	in.ApplyExt(pat)
+	spat := etensor.NewFloat32([]int{2, 2}, nil, nil)
+	if drive {
+		spat.Set1D(0, 1)
+	}
+	sin.ApplyExt(spat)
+	net.AlphaCycInit()
+	ltime.AlphaCycStart()
+	for qtr := 0; qtr < 4; qtr++ {
+		for cyc := 0; cyc < ltime.CycPerQtr; cyc++ {
+			net.Cycle(ltime)
+			ltime.CycleInc()
+		}
+		net.QuarterFinal(ltime)
+		ltime.QuarterInc()
+	}
+	return mnt.Pools[0].Inhib.Gi
+}
+
+func TestInterInhib(t *testing.T) {
+	srcs := map[string]func(net *leabra.Network) emer.Layer{
+		"leabra.Layer": func(net *leabra.Network) emer.Layer {
+			return net.AddLayer2D("Src", 2, 2, emer.Hidden)
+		},
+		"glong.Layer": func(net *leabra.Network) emer.Layer {
+			return glong.AddGlongLayer2D(net, "Src", 2, 2)
+		},
+		"glong.AlphaMaxLayer": func(net *leabra.Network) emer.Layer {
+			ly := &glong.AlphaMaxLayer{}
+			net.AddLayerInit(ly, "Src", []int{2, 2}, emer.Hidden)
+			return ly
+		},
+		"OutLayer": func(net *leabra.Network) emer.Layer {
+			return AddOutLayer(net, "Src", 1, 1, 2, 2)
+		},
+	}
+	for nm, addSrc := range srcs {
+		off := mntGi(t, addSrc, false)
+		on := mntGi(t, addSrc, true)
+		if on <= off {
+			t.Errorf("%s: InterInhib did not increase Mnt Gi: source active: %g  inactive: %g\n", nm, on, off)
+		}
+	}
+}
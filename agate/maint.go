@@ -54,7 +54,16 @@ func (ly *MaintLayer) InhibFmGeAct(ltime *leabra.Time) {
 	ly.InhibFmPool(ltime)
 }
 
-// InterInhibMaxAct returns the AlphaMax activation for source layers
+// AlphaMaxer is an interface for layers that record the maximum activation
+// over the alpha cycle (AlphaMax), such as glong.Layer and glong.AlphaMaxLayer
+type AlphaMaxer interface {
+	// MaxAlphaMax returns the maximum AlphaMax across the layer
+	MaxAlphaMax() float32
+}
+
+// InterInhibMaxAct returns the maximum activation across the InterInhib source layers:
+// the AlphaMax for layers that record it (AlphaMaxer, e.g., OutLayer),
+// and otherwise the current layer-level max activation.
 func (ly *MaintLayer) InterInhibMaxAct(ltime *leabra.Time) float32 {
 	mxact := float32(0)
 	for _, lnm := range ly.InterInhib.Lays {
@@ -62,11 +71,12 @@ func (ly *MaintLayer) InterInhibMaxAct(ltime *leabra.Time) float32 {
 		if oli == nil {
 			continue
 		}
-		ol, ok := oli.(*OutLayer)
-		if ok {
-			mxact = ol.MaxAlphaMax()
+		switch ol := oli.(type) {
+		case AlphaMaxer:
+			mxact = math32.Max(mxact, ol.MaxAlphaMax())
+		case leabra.LeabraLayer:
+			mxact = math32.Max(mxact, ol.AsLeabra().Pools[0].Inhib.Act.Max)
 		}
-		// todo: anything else?
 	}
 	return mxact
 }
@@ -5,6 +5,7 @@
 package agate
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/ccnlab/leabrax/leabra"
@@ -45,8 +46,15 @@ func (ly *OutLayer) ClearLays() ([]PulseClearer, error) {
 		tly, err = ly.Network.LayerByNameTry(nm)
 		if err != nil {
 			log.Printf("OutLayer %s, ClearLay: %v\n", ly.Name(), err)
+			continue
 		}
-		lays = append(lays, tly.(PulseClearer))
+		cly, ok := tly.(PulseClearer)
+		if !ok {
+			err = fmt.Errorf("OutLayer %s, ClearLay: %s is not a PulseClearer", ly.Name(), nm)
+			log.Println(err)
+			continue
+		}
+		lays = append(lays, cly)
 	}
 	return lays, err
 }
@@ -51,17 +51,25 @@ func (ly *CTLayer) InitActs() {
 	for ni := range ly.CtxtGes {
 		ly.CtxtGes[ni] = 0
 	}
+	for _, p := range ly.RcvPrjns {
+		if pj, ok := p.(*CTCtxtPrjn); ok {
+			pj.InitSendBuf()
+		}
+	}
 }
 
 // GFmInc integrates new synaptic conductances from increments sent during last SendGDelta.
 func (ly *CTLayer) GFmInc(ltime *leabra.Time) {
 	ly.RecvGInc(ltime)
+	if len(ly.InterPools.EPools) > 0 {
+		ly.InterPools.EPoolsGe(&ly.Layer)
+	}
 	for ni := range ly.Neurons {
 		nrn := &ly.Neurons[ni]
 		if nrn.IsOff() {
 			continue
 		}
-		geRaw := nrn.GeRaw + ly.CtxtGes[ni]
+		geRaw := nrn.GeRaw + ly.CtxtGes[ni] + ly.InterPools.EPoolGe(nrn)
 		ly.Act.GeFmRaw(nrn, geRaw)
 		ly.Act.GiFmRaw(nrn, nrn.GiRaw)
 	}
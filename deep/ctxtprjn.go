@@ -27,16 +27,27 @@ type CtxtSender interface {
 // (corticothalamic deep layer 6) where the CtxtGe excitatory input
 // is integrated only at end of Burst Quarter.
 // Set FmSuper for the main projection from corresponding Super layer.
+// Set Lag > 1 to deliver the sending activations from further back in time,
+// using a buffer of past sending values -- multiple context projections
+// with different lags into the same CTLayer sum their contributions.
 type CTCtxtPrjn struct {
 	leabra.Prjn           // access as .Prjn
 	FmSuper     bool      `desc:"if true, this is the projection from corresponding Superficial layer -- should be OneToOne prjn, with Learn.Learn = false, WtInit.Var = 0, Mean = 0.8 -- these defaults are set if FmSuper = true"`
+	Lag         int       `def:"1" min:"1" desc:"number of Burst quarter updates back in time for the sending activations delivered as context: 1 = the standard context from the most recent Burst, 2 = the Burst before that, etc.  Lag > 1 uses the SendBuf buffer of past sending activations, and DWt uses the corresponding lagged sending activations."`
 	CtxtGeInc   []float32 `desc:"local per-recv unit accumulator for Ctxt excitatory conductance from sending units -- not a delta -- the full value"`
+	SendBuf     []float32 `view:"-" desc:"ring buffer of past sending activations for Lag > 1: Lag x number of sending units"`
+	SendBufIdx  int       `view:"-" desc:"index into SendBuf of the slot for the current sending activations"`
+	SendLag     []float32 `view:"-" desc:"lagged sending activations delivered at the most recent context update, for Lag > 1"`
+	SendLagPrv  []float32 `view:"-" desc:"lagged sending activations delivered at the previous context update, for Lag > 1 -- these drive the current context, and are used for learning"`
 }
 
 var KiT_CTCtxtPrjn = kit.Types.AddType(&CTCtxtPrjn{}, PrjnProps)
 
 func (pj *CTCtxtPrjn) Defaults() {
 	pj.Prjn.Defaults()
+	if pj.Lag < 1 {
+		pj.Lag = 1
+	}
 	if pj.FmSuper {
 		pj.Learn.Learn = false
 		pj.WtInit.Mean = 0.5 // .5 better than .8 in several cases..
@@ -73,9 +84,28 @@ func (pj *CTCtxtPrjn) Build() error {
 	rsh := pj.Recv.Shape()
 	rlen := rsh.Len()
 	pj.CtxtGeInc = make([]float32, rlen)
+	pj.BuildSendBuf()
 	return nil
 }
 
+// BuildSendBuf allocates the SendBuf buffer of past sending activations
+// according to the current Lag -- called in Build, and must be called again
+// if Lag is changed after Build.
+func (pj *CTCtxtPrjn) BuildSendBuf() {
+	if pj.Lag <= 1 {
+		pj.SendBuf = nil
+		pj.SendLag = nil
+		pj.SendLagPrv = nil
+		pj.SendBufIdx = 0
+		return
+	}
+	slen := pj.Send.Shape().Len()
+	pj.SendBuf = make([]float32, pj.Lag*slen)
+	pj.SendLag = make([]float32, slen)
+	pj.SendLagPrv = make([]float32, slen)
+	pj.SendBufIdx = 0
+}
+
 //////////////////////////////////////////////////////////////////////////////////////
 //  Init methods
 
@@ -86,6 +116,22 @@ func (pj *CTCtxtPrjn) InitGInc() {
 	}
 }
 
+// InitSendBuf initializes the buffer of past sending activations
+// used for Lag > 1 -- called by CTLayer.InitActs.
+func (pj *CTCtxtPrjn) InitSendBuf() {
+	if pj.Lag > 1 && len(pj.SendBuf) != pj.Lag*pj.Send.Shape().Len() {
+		pj.BuildSendBuf()
+	}
+	for i := range pj.SendBuf {
+		pj.SendBuf[i] = 0
+	}
+	for i := range pj.SendLag {
+		pj.SendLag[i] = 0
+		pj.SendLagPrv[i] = 0
+	}
+	pj.SendBufIdx = 0
+}
+
 //////////////////////////////////////////////////////////////////////////////////////
 //  Act methods
 
@@ -98,8 +144,20 @@ func (pj *CTCtxtPrjn) RecvGInc() {
 }
 
 // SendCtxtGe sends the full Burst activation from sending neuron index si,
-// to integrate CtxtGe excitatory conductance on receivers
+// to integrate CtxtGe excitatory conductance on receivers.
+// For Lag > 1, the activation is stored in the SendBuf buffer, and the
+// lagged activations are sent in RecvCtxtGeInc.
 func (pj *CTCtxtPrjn) SendCtxtGe(si int, dburst float32) {
+	if pj.Lag > 1 {
+		pj.SendBuf[pj.SendBufIdx*len(pj.SendLag)+si] = dburst
+		return
+	}
+	pj.SendCtxtGeInc(si, dburst)
+}
+
+// SendCtxtGeInc sends given activation from sending neuron index si
+// to the CtxtGeInc accumulator on receivers.
+func (pj *CTCtxtPrjn) SendCtxtGeInc(si int, dburst float32) {
 	scdb := dburst * pj.GScale
 	nc := pj.SConN[si]
 	st := pj.SConIdxSt[si]
@@ -111,12 +169,33 @@ func (pj *CTCtxtPrjn) SendCtxtGe(si int, dburst float32) {
 	}
 }
 
+// SendLagCtxtGe sends the oldest activations in the SendBuf buffer, which are
+// Lag updates back in time, and advances the buffer, for Lag > 1.
+// Called in RecvCtxtGeInc, after all current activations have been sent.
+func (pj *CTCtxtPrjn) SendLagCtxtGe() {
+	slen := len(pj.SendLag)
+	oi := (pj.SendBufIdx + 1) % pj.Lag
+	buf := pj.SendBuf[oi*slen : (oi+1)*slen]
+	copy(pj.SendLagPrv, pj.SendLag)
+	copy(pj.SendLag, buf)
+	for si, act := range buf {
+		if act > 0 {
+			pj.SendCtxtGeInc(si, act)
+		}
+		buf[si] = 0 // oldest slot becomes the next current slot
+	}
+	pj.SendBufIdx = oi
+}
+
 // RecvCtxtGeInc increments the receiver's CtxtGe from that of all the projections
 func (pj *CTCtxtPrjn) RecvCtxtGeInc() {
 	rlay, ok := pj.Recv.(*CTLayer)
 	if !ok {
 		return
 	}
+	if pj.Lag > 1 {
+		pj.SendLagCtxtGe()
+	}
 	for ri := range rlay.CtxtGes {
 		rlay.CtxtGes[ri] += pj.CtxtGeInc[ri]
 		pj.CtxtGeInc[ri] = 0
@@ -126,7 +205,9 @@ func (pj *CTCtxtPrjn) RecvCtxtGeInc() {
 //////////////////////////////////////////////////////////////////////////////////////
 //  Learn methods
 
-// DWt computes the weight change (learning) for Ctxt projections
+// DWt computes the weight change (learning) for Ctxt projections,
+// using the sending activations that drove the current context
+// (BurstPrv for Super senders, lagged activations for Lag > 1).
 func (pj *CTCtxtPrjn) DWt() {
 	if !pj.Learn.Learn {
 		return
@@ -136,7 +217,9 @@ func (pj *CTCtxtPrjn) DWt() {
 	rlay := pj.Recv.(leabra.LeabraLayer).AsLeabra()
 	for si := range slay.Neurons {
 		sact := float32(0)
-		if issuper {
+		if pj.Lag > 1 {
+			sact = pj.SendLagPrv[si]
+		} else if issuper {
 			sact = sslay.SuperNeurs[si].BurstPrv
 		} else {
 			sact = slay.Neurons[si].ActQ0
@@ -0,0 +1,65 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deep
+
+import (
+	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/chewxy/math32"
+	"github.com/emer/emergent/prjn"
+)
+
+func TestCtxtLag(t *testing.T) {
+	net := &Network{}
+	net.InitName(net, "LagNet")
+	super, ct := net.AddDeepNoTRC2D("Hid", 2, 2)
+	pj1 := ct.RecvPrjns().SendName("Hid").(*CTCtxtPrjn)
+	pj3 := net.ConnectCtxtToCT(super, ct, prjn.NewOneToOne()).(*CTCtxtPrjn)
+	net.Defaults()
+	pj3.Lag = 3
+	pj3.WtInit.Mean = 0.5
+	pj3.WtInit.Var = 0
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	pj1.GScale = 1
+	pj3.GScale = 1
+
+	sly := super.(*SuperLayer)
+	cly := ct.(*CTLayer)
+	ltime := leabra.NewTime()
+	ltime.Quarter = 3
+	for step := 0; step < 6; step++ {
+		for ni := range sly.SuperNeurs {
+			sly.SuperNeurs[ni].Burst = float32(step + 1 + ni)
+		}
+		net.CTCtxt(ltime)
+		for ni, ge := range cly.CtxtGes {
+			cor := 0.5 * float32(step+1+ni)
+			if step >= 2 {
+				cor += 0.5 * float32(step-2+1+ni)
+			}
+			if math32.Abs(ge-cor) > 1.0e-6 {
+				t.Errorf("step: %d unit: %d CtxtGe: %g != %g", step, ni, ge, cor)
+			}
+			lprv := float32(0)
+			if step >= 3 {
+				lprv = float32(step - 3 + 1 + ni)
+			}
+			if pj3.SendLagPrv[ni] != lprv {
+				t.Errorf("step: %d unit: %d SendLagPrv: %g != %g", step, ni, pj3.SendLagPrv[ni], lprv)
+			}
+		}
+	}
+
+	net.InitActs()
+	for ni := range pj3.SendLag {
+		if pj3.SendLag[ni] != 0 {
+			t.Errorf("SendLag not reset by InitActs")
+		}
+	}
+}
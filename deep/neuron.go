@@ -13,7 +13,7 @@ import (
 
 var (
 	// NeuronVars are for full list across all deep Layer types
-	NeuronVars = []string{"Burst", "BurstPrv", "Attn", "CtxtGe"}
+	NeuronVars = []string{"Burst", "BurstPrv", "Attn", "CtxtGe", "PredCos", "PredSSE", "PredAvgSSE", "PredSurprise"}
 
 	// SuperNeuronVars are for SuperLayer directly
 	SuperNeuronVars = []string{"Burst", "BurstPrv", "Attn"}
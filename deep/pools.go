@@ -7,6 +7,8 @@ package deep
 import (
 	"log"
 
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/chewxy/math32"
 	"github.com/emer/emergent/emer"
 	"github.com/emer/emergent/evec"
 )
@@ -87,3 +89,176 @@ func (ip *IPools) Validate(net emer.Network, ctxt string) error {
 	}
 	return lasterr
 }
+
+///////////////////////////////////////////////////////////////////////////////////
+//   InterPools
+
+// InterPools is a layer mixin that provides pool-aligned inhibition (IPools) and
+// excitation (EPools) from other layers, using pool-level Inhib values from those
+// layers.  When the pool geometries differ, each receiving pool maps onto a
+// proportional range of sending pools, which are averaged (if there are more
+// sending pools) or shared (if there are fewer).  Layers without pools act as
+// a single pool (the layer-level pool).
+// Used in TopoInhibLayer, and thus available in SuperLayer, CTLayer and TRCLayer.
+type InterPools struct {
+	IPools   IPools    `desc:"inhibition from pools in other layers -- Wt applies to layer-level inhibition and PoolWt to pool-specific inhibition, integrated using the Max operation"`
+	EPools   EPools    `desc:"excitation from corresponding pools in other layers, as Wt times the average activation in those pools, added to the excitatory conductance"`
+	EPoolGes []float32 `inactive:"+" desc:"excitatory conductance from EPools, per pool (index 0 = layer-level pool, used for layers without pools)"`
+}
+
+// BuildInterPools allocates the per-pool state for given layer, and validates
+// the IPools and EPools layer names.
+func (ip *InterPools) BuildInterPools(ly *leabra.Layer) error {
+	ip.EPoolGes = make([]float32, len(ly.Pools))
+	var err error
+	if len(ip.IPools) > 0 {
+		err = ip.IPools.Validate(ly.Network, ly.Name())
+	}
+	if len(ip.EPools) > 0 {
+		if eerr := ip.EPools.Validate(ly.Network, ly.Name()); eerr != nil {
+			err = eerr
+		}
+	}
+	return err
+}
+
+// InitInterPools initializes the per-pool state
+func (ip *InterPools) InitInterPools() {
+	for pi := range ip.EPoolGes {
+		ip.EPoolGes[pi] = 0
+	}
+}
+
+// PoolGrid returns the number of pools in Y, X for given layer, and
+// the index of the first pool -- layers without pools are a 1x1 grid
+// using the layer-level pool at index 0.
+func PoolGrid(ly *leabra.Layer) (ny, nx, st int) {
+	if ly.Is4D() {
+		return ly.Shp.Dim(0), ly.Shp.Dim(1), 1
+	}
+	return 1, 1, 0
+}
+
+// PoolRange returns the range [st, ed) of sending pool indexes, out of
+// sn sending pools, that correspond to receiving pool index ri out of rn
+// receiving pools, mapping proportionally and always including at least 1 pool.
+func PoolRange(ri, rn, sn int) (st, ed int) {
+	st = (ri * sn) / rn
+	ed = ((ri + 1) * sn) / rn
+	if ed <= st {
+		ed = st + 1
+	}
+	if ed > sn {
+		ed = sn
+	}
+	return
+}
+
+// PoolsAvg returns the average over the sending layer's pools that correspond to
+// receiving pool at py, px within a grid of rny, rnx pools, with sending pools
+// starting at given soff offset, using given function to get the pool value.
+func PoolsAvg(sly *leabra.Layer, py, px, rny, rnx int, soff evec.Vec2i, fun func(pl *leabra.Pool) float32) float32 {
+	sny, snx, sst := PoolGrid(sly)
+	sny -= soff.Y
+	snx -= soff.X
+	if sny <= 0 || snx <= 0 {
+		return 0
+	}
+	yst, yed := PoolRange(py, rny, sny)
+	xst, xed := PoolRange(px, rnx, snx)
+	sum := float32(0)
+	n := 0
+	for sy := yst; sy < yed; sy++ {
+		for sx := xst; sx < xed; sx++ {
+			pi := sst + (sy+soff.Y)*(snx+soff.X) + sx + soff.X
+			sum += fun(&sly.Pools[pi])
+			n++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	return sum / float32(n)
+}
+
+// IPoolsLayGi applies layer-level inhibition from IPools (Wt) to the layer-level
+// pool, using the Max operation -- called prior to computing pool-level inhibition.
+func (ip *InterPools) IPoolsLayGi(ly *leabra.Layer) {
+	lpl := &ly.Pools[0]
+	for _, p := range ip.IPools {
+		if p.Wt == 0 {
+			continue
+		}
+		sly, err := ly.Network.LayerByNameTry(p.LayNm)
+		if err != nil {
+			continue
+		}
+		sgi := sly.(leabra.LeabraLayer).AsLeabra().Pools[0].Inhib.Gi
+		lpl.Inhib.Gi = math32.Max(lpl.Inhib.Gi, p.Wt*sgi)
+	}
+}
+
+// IPoolsPoolGi applies pool-specific inhibition from IPools (PoolWt), using the
+// Max operation, with the ROff, SOff offsets and averaging across sending
+// pools as needed to match the geometry -- called after pool-level inhibition
+// is computed, prior to InhibFmPool.
+func (ip *InterPools) IPoolsPoolGi(ly *leabra.Layer) {
+	rny, rnx, rst := PoolGrid(ly)
+	for _, p := range ip.IPools {
+		if p.PoolWt == 0 {
+			continue
+		}
+		slay, err := ly.Network.LayerByNameTry(p.LayNm)
+		if err != nil {
+			continue
+		}
+		sly := slay.(leabra.LeabraLayer).AsLeabra()
+		eny := rny - p.ROff.Y
+		enx := rnx - p.ROff.X
+		for ry := 0; ry < eny; ry++ {
+			for rx := 0; rx < enx; rx++ {
+				gi := PoolsAvg(sly, ry, rx, eny, enx, p.SOff, func(pl *leabra.Pool) float32 { return pl.Inhib.Gi })
+				pl := &ly.Pools[rst+(ry+p.ROff.Y)*rnx+rx+p.ROff.X]
+				pl.Inhib.Gi = math32.Max(pl.Inhib.Gi, p.PoolWt*gi)
+			}
+		}
+	}
+}
+
+// EPoolsGe computes the EPoolGes excitatory conductances from EPools,
+// as Wt times the average activation in corresponding pools.
+func (ip *InterPools) EPoolsGe(ly *leabra.Layer) {
+	for pi := range ip.EPoolGes {
+		ip.EPoolGes[pi] = 0
+	}
+	rny, rnx, rst := PoolGrid(ly)
+	for _, p := range ip.EPools {
+		slay, err := ly.Network.LayerByNameTry(p.LayNm)
+		if err != nil {
+			continue
+		}
+		sly := slay.(leabra.LeabraLayer).AsLeabra()
+		for ry := 0; ry < rny; ry++ {
+			for rx := 0; rx < rnx; rx++ {
+				act := PoolsAvg(sly, ry, rx, rny, rnx, evec.Vec2i{}, func(pl *leabra.Pool) float32 { return pl.Inhib.Act.Avg })
+				ip.EPoolGes[rst+ry*rnx+rx] += p.Wt * act
+			}
+		}
+	}
+	if rst == 1 { // layer pool is average of sub-pools
+		np := len(ip.EPoolGes) - 1
+		sum := float32(0)
+		for pi := 1; pi <= np; pi++ {
+			sum += ip.EPoolGes[pi]
+		}
+		ip.EPoolGes[0] = sum / float32(np)
+	}
+}
+
+// EPoolGe returns the EPools excitatory conductance for given neuron
+func (ip *InterPools) EPoolGe(nrn *leabra.Neuron) float32 {
+	if len(ip.EPools) == 0 {
+		return 0
+	}
+	return ip.EPoolGes[nrn.SubPool]
+}
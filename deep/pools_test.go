@@ -0,0 +1,172 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deep
+
+import (
+	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/chewxy/math32"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/evec"
+	"github.com/emer/emergent/prjn"
+)
+
+func newPoolsNet(t *testing.T) (*Network, *SuperLayer, *TRCLayer) {
+	net := &Network{}
+	net.InitName(net, "PoolsNet")
+	in := net.AddLayer4D("Input", 2, 2, 2, 2, emer.Input)
+	src4 := net.AddLayer4D("Src4", 4, 4, 1, 1, emer.Hidden)
+	net.AddLayer4D("Src12", 1, 2, 2, 2, emer.Hidden)
+	net.AddLayer2D("Src2D", 3, 3, emer.Hidden)
+	super, _, trc := net.AddDeep4D("Rec", 2, 2, 2, 2)
+	AddSuperLayer2D(&net.Network, "Rec2D", 3, 3)
+	trc.(*TRCLayer).Drivers.Add("Input")
+	net.ConnectLayers(in, super, prjn.NewPoolOneToOne(), emer.Forward)
+	net.ConnectLayers(in, src4, prjn.NewFull(), emer.Forward)
+	net.Defaults()
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	return net, super.(*SuperLayer), trc.(*TRCLayer)
+}
+
+// setPools sets pool-level Gi and Act.Avg values on given layer by pool index
+func setPools(ly *leabra.Layer) {
+	for pi := range ly.Pools {
+		pl := &ly.Pools[pi]
+		pl.Inhib.Gi = float32(pi)
+		pl.Inhib.Act.Avg = 0.1 * float32(pi)
+	}
+}
+
+func clearGi(ly *leabra.Layer) {
+	for pi := range ly.Pools {
+		ly.Pools[pi].Inhib.Gi = 0
+	}
+}
+
+func cmprPools(t *testing.T, ly *leabra.Layer, cor []float32, ctxt string) {
+	for pi, c := range cor {
+		if math32.Abs(ly.Pools[pi].Inhib.Gi-c) > 1.0e-6 {
+			t.Errorf("%s: pool: %d Gi: %g != %g", ctxt, pi, ly.Pools[pi].Inhib.Gi, c)
+		}
+	}
+}
+
+func TestPoolRange(t *testing.T) {
+	cases := []struct{ ri, rn, sn, st, ed int }{
+		{0, 2, 4, 0, 2}, {1, 2, 4, 2, 4}, // more sending pools: average
+		{0, 4, 2, 0, 1}, {1, 4, 2, 0, 1}, {3, 4, 2, 1, 2}, // fewer: shared
+		{0, 2, 3, 0, 1}, {1, 2, 3, 1, 3}, // uneven
+		{0, 1, 1, 0, 1},
+	}
+	for _, c := range cases {
+		st, ed := PoolRange(c.ri, c.rn, c.sn)
+		if st != c.st || ed != c.ed {
+			t.Errorf("PoolRange(%d, %d, %d): %d, %d != %d, %d", c.ri, c.rn, c.sn, st, ed, c.st, c.ed)
+		}
+	}
+}
+
+func TestIPoolsGeom(t *testing.T) {
+	net, super, _ := newPoolsNet(t)
+	for _, nm := range []string{"Src4", "Src12", "Src2D"} {
+		setPools(net.LayerByName(nm).(leabra.LeabraLayer).AsLeabra())
+	}
+	ly := &super.Layer
+
+	// 4x4 sending pools into 2x2 receiving: average of 2x2 blocks
+	super.InterPools.IPools = nil
+	super.InterPools.IPools.Add("Src4", 0).PoolWt = 1
+	clearGi(ly)
+	super.InterPools.IPoolsPoolGi(ly)
+	cmprPools(t, ly, []float32{0, 3.5, 5.5, 11.5, 13.5}, "Src4")
+
+	// 1x2 sending pools into 2x2 receiving: rows share the same pool
+	super.InterPools.IPools = nil
+	super.InterPools.IPools.Add("Src12", 0).PoolWt = 0.5
+	clearGi(ly)
+	super.InterPools.IPoolsPoolGi(ly)
+	cmprPools(t, ly, []float32{0, 0.5, 1, 0.5, 1}, "Src12")
+
+	// 2D sending layer into 2x2 receiving: all use layer pool
+	super.InterPools.IPools = nil
+	ip := super.InterPools.IPools.Add("Src2D", 0)
+	ip.PoolWt = 1
+	clearGi(ly)
+	ly.Pools[0].Inhib.Gi = 0
+	net.LayerByName("Src2D").(leabra.LeabraLayer).AsLeabra().Pools[0].Inhib.Gi = 2
+	super.InterPools.IPoolsPoolGi(ly)
+	cmprPools(t, ly, []float32{0, 2, 2, 2, 2}, "Src2D")
+
+	// offsets: sending 3x3 from (1,1) into receiving 1x2 at (1,0)
+	super.InterPools.IPools = nil
+	ip = super.InterPools.IPools.Add("Src4", 0)
+	ip.PoolWt = 1
+	ip.SOff = evec.Vec2i{X: 1, Y: 1}
+	ip.ROff = evec.Vec2i{X: 0, Y: 1}
+	clearGi(ly)
+	super.InterPools.IPoolsPoolGi(ly)
+	cmprPools(t, ly, []float32{0, 0, 0, 10, 11.5}, "offsets")
+
+	// layer-level Wt uses Max with own inhibition
+	ly.Pools[0].Inhib.Gi = 1
+	super.InterPools.IPools = nil
+	super.InterPools.IPools.Add("Src2D", 2)
+	super.InterPools.IPoolsLayGi(ly)
+	cmprPools(t, ly, []float32{4}, "layer Wt")
+
+	// 4x4 pools into a 2D receiving layer: average of all pools
+	r2d := net.LayerByName("Rec2D").(*SuperLayer)
+	r2d.InterPools.IPools.Add("Src4", 0).PoolWt = 1
+	clearGi(&r2d.Layer)
+	r2d.InterPools.IPoolsPoolGi(&r2d.Layer)
+	cmprPools(t, &r2d.Layer, []float32{8.5}, "2D recv")
+}
+
+func TestEPools(t *testing.T) {
+	net, super, trc := newPoolsNet(t)
+	src := net.LayerByName("Src4").(leabra.LeabraLayer).AsLeabra()
+	setPools(src)
+	super.InterPools.EPools.Add("Src4", 2)
+	super.InterPools.EPoolGes = make([]float32, len(super.Pools))
+	super.InterPools.EPoolsGe(&super.Layer)
+	cor := []float32{1.7, 0.7, 1.1, 2.3, 2.7}
+	for pi, c := range cor {
+		if math32.Abs(super.InterPools.EPoolGes[pi]-c) > 1.0e-6 {
+			t.Errorf("EPoolGes: pool: %d: %g != %g", pi, super.InterPools.EPoolGes[pi], c)
+		}
+	}
+
+	// run a full network with IPools / EPools on all deep layer types
+	super.InterPools.IPools.Add("Src4", 1).PoolWt = 1
+	trc.InterPools.IPools.Add("RecCT", 1).PoolWt = 1
+	ct := net.LayerByName("RecCT").(*CTLayer)
+	ct.InterPools.EPools.Add("Src12", 1)
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	in := net.LayerByName("Input").(leabra.LeabraLayer).AsLeabra()
+	for ni := range in.Neurons {
+		in.Neurons[ni].Ext = 1
+	}
+	ltime := leabra.NewTime()
+	net.AlphaCycInit()
+	ltime.AlphaCycStart()
+	for qtr := 0; qtr < 4; qtr++ {
+		for cyc := 0; cyc < ltime.CycPerQtr; cyc++ {
+			net.Cycle(ltime)
+			ltime.CycleInc()
+		}
+		net.QuarterFinal(ltime)
+		ltime.QuarterInc()
+	}
+	if super.Pools[0].Inhib.Gi < src.Pools[0].Inhib.Gi-1.0e-6 {
+		t.Errorf("super Gi: %g should be >= Src4 Gi: %g", super.Pools[0].Inhib.Gi, src.Pools[0].Inhib.Gi)
+	}
+}
@@ -0,0 +1,40 @@
+// Code generated by "stringer -type=TopoAgg"; DO NOT EDIT.
+
+package deep
+
+import (
+	"errors"
+	"strconv"
+)
+
+var _ = errors.New("dummy error")
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[TopoMax-0]
+	_ = x[TopoSum-1]
+	_ = x[TopoAggN-2]
+}
+
+const _TopoAgg_name = "TopoMaxTopoSumTopoAggN"
+
+var _TopoAgg_index = [...]uint8{0, 7, 14, 22}
+
+func (i TopoAgg) String() string {
+	if i < 0 || i >= TopoAgg(len(_TopoAgg_index)-1) {
+		return "TopoAgg(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _TopoAgg_name[_TopoAgg_index[i]:_TopoAgg_index[i+1]]
+}
+
+func (i *TopoAgg) FromString(s string) error {
+	for j := 0; j < len(_TopoAgg_index)-1; j++ {
+		if s == _TopoAgg_name[_TopoAgg_index[j]:_TopoAgg_index[j+1]] {
+			*i = TopoAgg(j)
+			return nil
+		}
+	}
+	return errors.New("String: " + s + " is not a valid option for type: TopoAgg")
+}
@@ -0,0 +1,41 @@
+// Code generated by "stringer -type=TopoBound"; DO NOT EDIT.
+
+package deep
+
+import (
+	"errors"
+	"strconv"
+)
+
+var _ = errors.New("dummy error")
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[TopoZero-0]
+	_ = x[TopoWrap-1]
+	_ = x[TopoReflect-2]
+	_ = x[TopoBoundN-3]
+}
+
+const _TopoBound_name = "TopoZeroTopoWrapTopoReflectTopoBoundN"
+
+var _TopoBound_index = [...]uint8{0, 8, 16, 27, 37}
+
+func (i TopoBound) String() string {
+	if i < 0 || i >= TopoBound(len(_TopoBound_index)-1) {
+		return "TopoBound(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _TopoBound_name[_TopoBound_index[i]:_TopoBound_index[i+1]]
+}
+
+func (i *TopoBound) FromString(s string) error {
+	for j := 0; j < len(_TopoBound_index)-1; j++ {
+		if s == _TopoBound_name[_TopoBound_index[j]:_TopoBound_index[j+1]] {
+			*i = TopoBound(j)
+			return nil
+		}
+	}
+	return errors.New("String: " + s + " is not a valid option for type: TopoBound")
+}
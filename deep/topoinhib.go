@@ -8,19 +8,24 @@ import (
 	"github.com/ccnlab/leabrax/leabra"
 	"github.com/chewxy/math32"
 	"github.com/emer/emergent/efuns"
-	"github.com/goki/ki/ints"
 	"github.com/goki/ki/kit"
 )
 
-// TopoInhib provides for topographic gaussian inhibition integrating over neighborhood.
-// Effective inhibition is
+// TopoInhib provides for topographic gaussian inhibition integrating over neighborhood
+// of pools, using a 2D kernel over pool offsets (excluding the pool itself).
+// Effective inhibition for each pool is the Max of its own inhibition, LayGi times
+// the maximum pool inhibition in the layer, and the kernel-weighted inhibition
+// from the neighborhood, aggregated according to Agg.
 type TopoInhib struct {
-	On    bool      `desc:"use topographic inhibition"`
-	Width int       `desc:"half-width of topographic inhibition within layer"`
-	Sigma float32   `desc:"normalized gaussian sigma as proportion of Width, for gaussian weighting"`
-	Gi    float32   `desc:"overall inhibition multiplier for topographic inhibition (generally <= 1)"`
-	LayGi float32   `desc:"layer-level baseline inhibition factor for Max computation -- ensures a baseline inhib as proportion of maximum inhib within any single pool"`
-	Wts   []float32 `inactive:"+" desc:"gaussian weights as function of distance, precomputed.  index 0 = dist 1"`
+	On     bool      `desc:"use topographic inhibition"`
+	Width  int       `desc:"half-width of topographic inhibition within layer, along the X axis, and also the Y axis if WidthY is 0"`
+	WidthY int       `desc:"half-width of topographic inhibition along the Y axis, for anisotropic inhibition -- if 0, Width is used"`
+	Sigma  float32   `desc:"normalized gaussian sigma as proportion of Width (and WidthY), for gaussian weighting"`
+	Gi     float32   `desc:"overall inhibition multiplier for topographic inhibition (generally <= 1)"`
+	LayGi  float32   `desc:"layer-level baseline inhibition factor for Max computation -- ensures a baseline inhib as proportion of maximum inhib within any single pool"`
+	Bound  TopoBound `desc:"how to treat pool positions beyond the edge of the layer"`
+	Agg    TopoAgg   `desc:"how to aggregate the kernel-weighted inhibition from the neighborhood"`
+	Wts    []float32 `inactive:"+" desc:"gaussian kernel weights as function of Y, X offset, precomputed, including Gi: (2*WidthY+1) x (2*Width+1), with 0 at the center"`
 }
 
 func (ti *TopoInhib) Defaults() {
@@ -31,20 +36,131 @@ func (ti *TopoInhib) Defaults() {
 	ti.Update()
 }
 
+// TopoMinSigma is the minimum gaussian sigma (in pool offsets) used in computing
+// the kernel weights, for a Sigma or half-width of 0, which would otherwise be NaN.
+const TopoMinSigma = 0.1
+
+// Widths returns the half-widths in Y and X
+func (ti *TopoInhib) Widths() (wy, wx int) {
+	wx = ti.Width
+	if wx < 0 {
+		wx = 0
+	}
+	wy = ti.WidthY
+	if wy <= 0 {
+		wy = wx
+	}
+	return
+}
+
 func (ti *TopoInhib) Update() {
-	if len(ti.Wts) != ti.Width {
-		ti.Wts = make([]float32, ti.Width)
+	wy, wx := ti.Widths()
+	ny := 2*wy + 1
+	nx := 2*wx + 1
+	if len(ti.Wts) != ny*nx {
+		ti.Wts = make([]float32, ny*nx)
 	}
-	sig := float32(ti.Width) * ti.Sigma
-	for i := range ti.Wts {
-		ti.Wts[i] = ti.Gi * efuns.Gauss1DNoNorm(float32(i+1), sig)
+	sigy := math32.Max(float32(wy)*ti.Sigma, TopoMinSigma)
+	sigx := math32.Max(float32(wx)*ti.Sigma, TopoMinSigma)
+	for dy := -wy; dy <= wy; dy++ {
+		for dx := -wx; dx <= wx; dx++ {
+			g := float32(0)
+			if dy != 0 || dx != 0 {
+				g = efuns.Gauss1DNoNorm(float32(dy), sigy) * efuns.Gauss1DNoNorm(float32(dx), sigx)
+			}
+			ti.Wts[(dy+wy)*nx+dx+wx] = ti.Gi * g
+		}
 	}
 }
 
-// TopoInhibLayer is a layer with topographically organized inhibition among pools
+// BoundIdx returns the index within [0, n) for given possibly out-of-range
+// index according to the Bound mode, and false if it is outside for TopoZero.
+func (ti *TopoInhib) BoundIdx(i, n int) (int, bool) {
+	if i >= 0 && i < n {
+		return i, true
+	}
+	switch ti.Bound {
+	case TopoWrap:
+		return ((i % n) + n) % n, true
+	case TopoReflect:
+		if n == 1 {
+			return 0, true
+		}
+		per := 2 * (n - 1)
+		i = ((i % per) + per) % per
+		if i >= n {
+			i = per - i
+		}
+		return i, true
+	}
+	return 0, false
+}
+
+// OffOk returns false if the kernel offset d along a dimension of size n
+// reaches the same pool as another offset closer to 0 in TopoWrap mode,
+// which happens for layers narrower than the kernel -- each neighboring
+// pool is then only counted once, at its nearest offset.
+func (ti *TopoInhib) OffOk(d, n int) bool {
+	if ti.Bound != TopoWrap {
+		return true
+	}
+	return d >= -(n-1)/2 && d <= n/2
+}
+
+// TopoBound are the ways of treating pool positions beyond the edge of the layer
+type TopoBound int
+
+//go:generate stringer -type=TopoBound
+
+var KiT_TopoBound = kit.Enums.AddEnum(TopoBoundN, kit.NotBitFlag, nil)
+
+func (ev TopoBound) MarshalJSON() ([]byte, error)  { return kit.EnumMarshalJSON(ev) }
+func (ev *TopoBound) UnmarshalJSON(b []byte) error { return kit.EnumUnmarshalJSON(ev, b) }
+
+// The topographic inhibition boundary modes
+const (
+	// TopoZero treats pools beyond the edge as having zero inhibition
+	TopoZero TopoBound = iota
+
+	// TopoWrap wraps around to the opposite edge (toroidal)
+	TopoWrap
+
+	// TopoReflect reflects back from the edge, mirroring about the edge pool
+	TopoReflect
+
+	TopoBoundN
+)
+
+// TopoAgg are the ways of aggregating the kernel-weighted inhibition
+type TopoAgg int
+
+//go:generate stringer -type=TopoAgg
+
+var KiT_TopoAgg = kit.Enums.AddEnum(TopoAggN, kit.NotBitFlag, nil)
+
+func (ev TopoAgg) MarshalJSON() ([]byte, error)  { return kit.EnumMarshalJSON(ev) }
+func (ev *TopoAgg) UnmarshalJSON(b []byte) error { return kit.EnumUnmarshalJSON(ev, b) }
+
+// The topographic inhibition aggregation modes
+const (
+	// TopoMax uses the maximum of the kernel-weighted pool inhibition values
+	TopoMax TopoAgg = iota
+
+	// TopoSum uses the kernel-weighted sum of pool inhibition values,
+	// normalized by the sum of the kernel weights of the pools that are
+	// actually reached (i.e., the weighted average, times Gi), so that
+	// pools at the edge of the layer are not under-inhibited for TopoZero.
+	TopoSum
+
+	TopoAggN
+)
+
+// TopoInhibLayer is a layer with topographically organized inhibition among pools,
+// and pool-aligned inhibition and excitation from other layers via InterPools.
 type TopoInhibLayer struct {
-	leabra.Layer           // access as .Layer
-	TopoInhib    TopoInhib `desc:"topographic inhibition parameters for pool-level inhibition (only used for layers with pools)"`
+	leabra.Layer            // access as .Layer
+	InterPools   InterPools `view:"inline" desc:"pool-aligned inhibition (IPools) and excitation (EPools) from other layers"`
+	TopoInhib    TopoInhib  `desc:"topographic inhibition parameters for pool-level inhibition (only used for layers with pools)"`
 }
 
 var KiT_TopoInhibLayer = kit.Types.AddType(&TopoInhibLayer{}, LayerProps)
@@ -61,27 +177,53 @@ func (ly *TopoInhibLayer) UpdateParams() {
 	ly.TopoInhib.Update()
 }
 
-// TopoGiPos returns position-specific Gi contribution
-func (ly *TopoInhibLayer) TopoGiPos(py, px, d int) float32 {
-	pyn := ly.Shp.Dim(0)
-	pxn := ly.Shp.Dim(1)
-	if py < 0 || py >= pyn {
-		return 0
+func (ly *TopoInhibLayer) Build() error {
+	err := ly.Layer.Build()
+	if err != nil {
+		return err
 	}
-	if px < 0 || px >= pxn {
-		return 0
+	return ly.InterPools.BuildInterPools(&ly.Layer)
+}
+
+func (ly *TopoInhibLayer) InitActs() {
+	ly.Layer.InitActs()
+	ly.InterPools.InitInterPools()
+}
+
+// GFmInc integrates new synaptic conductances from increments sent during last SendGDelta.
+func (ly *TopoInhibLayer) GFmInc(ltime *leabra.Time) {
+	ly.RecvGInc(ltime)
+	ly.GFmIncNeur(ltime)
+}
+
+// GFmIncNeur is the neuron-level code for GFmInc that integrates overall Ge, Gi values
+// from their G*Raw accumulators, adding excitation from InterPools EPools.
+func (ly *TopoInhibLayer) GFmIncNeur(ltime *leabra.Time) {
+	if len(ly.InterPools.EPools) == 0 {
+		ly.Layer.GFmIncNeur(ltime)
+		return
+	}
+	ly.InterPools.EPoolsGe(&ly.Layer)
+	for ni := range ly.Neurons {
+		nrn := &ly.Neurons[ni]
+		if nrn.IsOff() {
+			continue
+		}
+		ly.Act.GeFmRaw(nrn, nrn.GeRaw+ly.InterPools.EPoolGe(nrn))
+		ly.Act.GiFmRaw(nrn, nrn.GiRaw)
 	}
-	pi := py*pxn + px
-	pl := ly.Pools[pi+1]
-	g := ly.TopoInhib.Wts[d]
-	return g * pl.Inhib.GiOrig
 }
 
 // TopoGi computes topographic Gi between pools
 func (ly *TopoInhibLayer) TopoGi(ltime *leabra.Time) {
 	pyn := ly.Shp.Dim(0)
 	pxn := ly.Shp.Dim(1)
-	wd := ly.TopoInhib.Width
+	wy, wx := ly.TopoInhib.Widths()
+	nkx := 2*wx + 1
+	if len(ly.TopoInhib.Wts) != (2*wy+1)*nkx {
+		ly.TopoInhib.Update()
+	}
+	wts := ly.TopoInhib.Wts
 
 	laymax := float32(0)
 	np := len(ly.Pools)
@@ -94,18 +236,37 @@ func (ly *TopoInhibLayer) TopoGi(ltime *leabra.Time) {
 
 	for py := 0; py < pyn; py++ {
 		for px := 0; px < pxn; px++ {
-			max := laymax
-			for iy := 1; iy <= wd; iy++ {
-				for ix := 1; ix <= wd; ix++ {
-					max = math32.Max(max, ly.TopoGiPos(py+iy, px+ix, ints.MinInt(iy-1, ix-1)))
-					max = math32.Max(max, ly.TopoGiPos(py-iy, px+ix, ints.MinInt(iy-1, ix-1)))
-					max = math32.Max(max, ly.TopoGiPos(py+iy, px-ix, ints.MinInt(iy-1, ix-1)))
-					max = math32.Max(max, ly.TopoGiPos(py-iy, px-ix, ints.MinInt(iy-1, ix-1)))
+			agg := float32(0)
+			wsum := float32(0)
+			for dy := -wy; dy <= wy; dy++ {
+				for dx := -wx; dx <= wx; dx++ {
+					w := wts[(dy+wy)*nkx+dx+wx]
+					if w == 0 {
+						continue
+					}
+					if !ly.TopoInhib.OffOk(dy, pyn) || !ly.TopoInhib.OffOk(dx, pxn) {
+						continue
+					}
+					gy, oky := ly.TopoInhib.BoundIdx(py+dy, pyn)
+					gx, okx := ly.TopoInhib.BoundIdx(px+dx, pxn)
+					if !oky || !okx || (gy == py && gx == px) {
+						continue // beyond edge, or reflected back onto itself
+					}
+					gi := ly.Pools[gy*pxn+gx+1].Inhib.GiOrig
+					if ly.TopoInhib.Agg == TopoSum {
+						agg += w * gi
+						wsum += w
+					} else {
+						agg = math32.Max(agg, w*gi)
+					}
 				}
 			}
+			if ly.TopoInhib.Agg == TopoSum && wsum > 0 {
+				agg *= ly.TopoInhib.Gi / wsum
+			}
 			pi := py*pxn + px
 			pl := &ly.Pools[pi+1]
-			pl.Inhib.Gi = math32.Max(max, pl.Inhib.Gi)
+			pl.Inhib.Gi = math32.Max(math32.Max(laymax, agg), pl.Inhib.Gi)
 		}
 	}
 }
@@ -114,9 +275,11 @@ func (ly *TopoInhibLayer) TopoGi(ltime *leabra.Time) {
 func (ly *TopoInhibLayer) InhibFmGeAct(ltime *leabra.Time) {
 	lpl := &ly.Pools[0]
 	ly.Inhib.Layer.Inhib(&lpl.Inhib)
+	ly.InterPools.IPoolsLayGi(&ly.Layer)
 	ly.PoolInhibFmGeAct(ltime)
 	if ly.Is4D() && ly.TopoInhib.On {
 		ly.TopoGi(ltime)
 	}
+	ly.InterPools.IPoolsPoolGi(&ly.Layer)
 	ly.InhibFmPool(ltime)
 }
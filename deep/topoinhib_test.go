@@ -0,0 +1,216 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deep
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/emergent/emer"
+)
+
+// bruteBound maps index into [0,n) by explicit stepping
+func bruteBound(i, n int, bound TopoBound) (int, bool) {
+	switch bound {
+	case TopoWrap:
+		for i < 0 {
+			i += n
+		}
+		for i >= n {
+			i -= n
+		}
+	case TopoReflect:
+		if n == 1 {
+			return 0, true
+		}
+		for i < 0 || i >= n {
+			if i < 0 {
+				i = -i
+			}
+			if i >= n {
+				i = 2*(n-1) - i
+			}
+		}
+	default:
+		if i < 0 || i >= n {
+			return 0, false
+		}
+	}
+	return i, true
+}
+
+// bruteTopoGi computes the topographic inhibition for each pool by brute force,
+// counting each other pool once, at its largest kernel weight
+func bruteTopoGi(gis []float64, pyn, pxn int, ti *TopoInhib) []float64 {
+	wy, wx := ti.Widths()
+	sigy := math.Max(float64(wy)*float64(ti.Sigma), TopoMinSigma)
+	sigx := math.Max(float64(wx)*float64(ti.Sigma), TopoMinSigma)
+	laymax := 0.0
+	for _, g := range gis {
+		laymax = math.Max(laymax, g)
+	}
+	laymax *= float64(ti.LayGi)
+	res := make([]float64, len(gis))
+	for py := 0; py < pyn; py++ {
+		for px := 0; px < pxn; px++ {
+			max := 0.0
+			sum := 0.0
+			wsum := 0.0
+			wrapg := map[int]float64{}
+			for dy := -wy; dy <= wy; dy++ {
+				for dx := -wx; dx <= wx; dx++ {
+					if dy == 0 && dx == 0 {
+						continue
+					}
+					g := math.Exp(-float64(dy*dy)/(2*sigy*sigy)) * math.Exp(-float64(dx*dx)/(2*sigx*sigx))
+					y, oky := bruteBound(py+dy, pyn, ti.Bound)
+					x, okx := bruteBound(px+dx, pxn, ti.Bound)
+					if !oky || !okx || (y == py && x == px) {
+						continue
+					}
+					if ti.Bound == TopoWrap {
+						wrapg[y*pxn+x] = math.Max(wrapg[y*pxn+x], g)
+						continue
+					}
+					v := float64(ti.Gi) * g * gis[y*pxn+x]
+					max = math.Max(max, v)
+					sum += v
+					wsum += g
+				}
+			}
+			for pi, g := range wrapg {
+				v := float64(ti.Gi) * g * gis[pi]
+				max = math.Max(max, v)
+				sum += v
+				wsum += g
+			}
+			agg := max
+			if ti.Agg == TopoSum && wsum > 0 {
+				agg = sum / wsum
+			}
+			res[py*pxn+px] = math.Max(laymax, agg)
+		}
+	}
+	return res
+}
+
+func TestTopoGi(t *testing.T) {
+	net := &Network{}
+	net.InitName(net, "TopoNet")
+	ly := &TopoInhibLayer{}
+	pyn, pxn := 5, 7
+	net.AddLayerInit(ly, "Topo", []int{pyn, pxn, 1, 1}, emer.Hidden)
+	net.Defaults()
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	gis := make([]float64, pyn*pxn)
+	for i := range gis {
+		gis[i] = 0.05 * float64((i*7)%11)
+	}
+	ltime := leabra.NewTime()
+	widths := [][2]int{{2, 0}, {1, 3}, {4, 1}}
+	for bound := TopoZero; bound < TopoBoundN; bound++ {
+		for agg := TopoMax; agg < TopoAggN; agg++ {
+			for _, wd := range widths {
+				ctxt := fmt.Sprintf("bound: %v agg: %v width: %v", bound, agg, wd)
+				ly.TopoInhib.Width = wd[0]
+				ly.TopoInhib.WidthY = wd[1]
+				ly.TopoInhib.Bound = bound
+				ly.TopoInhib.Agg = agg
+				ly.TopoInhib.Gi = 1
+				ly.TopoInhib.LayGi = 0.2
+				ly.TopoInhib.Update()
+				for pi := range gis {
+					pl := &ly.Pools[pi+1]
+					pl.Inhib.GiOrig = float32(gis[pi])
+					pl.Inhib.Gi = 0
+				}
+				ly.TopoGi(ltime)
+				cor := bruteTopoGi(gis, pyn, pxn, &ly.TopoInhib)
+				for pi, c := range cor {
+					gi := float64(ly.Pools[pi+1].Inhib.Gi)
+					if math.Abs(gi-c) > 1.0e-5 {
+						t.Errorf("%s pool: %d Gi: %g != %g", ctxt, pi, gi, c)
+					}
+				}
+			}
+		}
+	}
+
+	// single active pool inhibits axis-aligned neighbors
+	ly.TopoInhib.Width = 1
+	ly.TopoInhib.WidthY = 0
+	ly.TopoInhib.Bound = TopoZero
+	ly.TopoInhib.Agg = TopoMax
+	ly.TopoInhib.LayGi = 0
+	ly.TopoInhib.Update()
+	for pi := 1; pi < len(ly.Pools); pi++ {
+		ly.Pools[pi].Inhib.GiOrig = 0
+		ly.Pools[pi].Inhib.Gi = 0
+	}
+	ly.Pools[1+2*pxn+3].Inhib.GiOrig = 1
+	ly.TopoGi(ltime)
+	for _, pi := range []int{2*pxn + 2, 2*pxn + 4, 1*pxn + 3, 3*pxn + 3} {
+		if ly.Pools[1+pi].Inhib.Gi <= 0 {
+			t.Errorf("axis-aligned neighbor pool: %d has no inhibition", pi)
+		}
+	}
+}
+
+func TestTopoGiEdges(t *testing.T) {
+	ti := &TopoInhib{}
+	ti.Defaults()
+	for _, wd := range [][3]float32{{4, 0, 0}, {0, 2, 0.5}, {0, 0, 0.5}, {-1, 0, 0.5}} {
+		ti.Width = int(wd[0])
+		ti.WidthY = int(wd[1])
+		ti.Sigma = wd[2]
+		ti.Update()
+		for i, w := range ti.Wts {
+			if math.IsNaN(float64(w)) || math.IsInf(float64(w), 0) {
+				t.Errorf("width: %v Wts[%d]: %g", wd, i, w)
+			}
+		}
+	}
+
+	// kernel much wider than the layer: each other pool is counted once, self never,
+	// and TopoSum is normalized by the weights of the pools reached
+	net := &Network{}
+	net.InitName(net, "TopoNet")
+	ly := &TopoInhibLayer{}
+	pyn, pxn := 1, 2
+	net.AddLayerInit(ly, "Topo", []int{pyn, pxn, 1, 1}, emer.Hidden)
+	net.Defaults()
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	ltime := leabra.NewTime()
+	ly.TopoInhib.Width = 4
+	ly.TopoInhib.WidthY = 0
+	ly.TopoInhib.Sigma = 0.5
+	ly.TopoInhib.Gi = 1
+	ly.TopoInhib.LayGi = 0
+	ly.TopoInhib.Agg = TopoSum
+	for bound := TopoZero; bound < TopoBoundN; bound++ {
+		ly.TopoInhib.Bound = bound
+		ly.TopoInhib.Update()
+		ly.Pools[1].Inhib.GiOrig = 1
+		ly.Pools[2].Inhib.GiOrig = 0
+		ly.Pools[1].Inhib.Gi = 0
+		ly.Pools[2].Inhib.Gi = 0
+		ly.TopoGi(ltime)
+		if gi := ly.Pools[1].Inhib.Gi; gi != 0 {
+			t.Errorf("bound: %v active pool inhibits itself: Gi: %g", bound, gi)
+		}
+		// the neighbor is the only pool reached, so its weighted average is not
+		// diluted by the weights of the offsets beyond the edge
+		cor := ly.TopoInhib.Gi
+		if gi := ly.Pools[2].Inhib.Gi; math.Abs(float64(gi-cor)) > 1.0e-6 {
+			t.Errorf("bound: %v neighbor pool Gi: %g != %g", bound, gi, cor)
+		}
+	}
+}
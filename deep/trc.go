@@ -105,9 +105,13 @@ func (tp *TRCParams) GeFmMaxAvg(max, avg float32) float32 {
 // * Similarly, there shouldn't generally be more TRC pools than driver pools, but
 //   if so, drivers replicate across pools.
 type TRCLayer struct {
-	TopoInhibLayer           // access as .TopoInhibLayer
-	TRC            TRCParams `view:"inline" desc:"parameters for computing TRC plus-phase (outcome) activations based on Burst activation from corresponding driver neuron"`
-	Drivers        Drivers   `desc:"name of SuperLayer that sends 5IB Burst driver inputs to this layer"`
+	TopoInhibLayer               // access as .TopoInhibLayer
+	TRC            TRCParams     `view:"inline" desc:"parameters for computing TRC plus-phase (outcome) activations based on Burst activation from corresponding driver neuron"`
+	Drivers        Drivers       `desc:"name of SuperLayer that sends 5IB Burst driver inputs to this layer"`
+	PredErr        PredErrParams `view:"inline" desc:"parameters for computing prediction error statistics, and surprise-based learning rate modulation"`
+	PredErrs       []PredErr     `inactive:"+" desc:"prediction error statistics computed at the end of each plus phase if PredErr.On, per pool -- index 0 is for the layer as a whole"`
+	PredErrN       int           `inactive:"+" desc:"number of trials over which prediction error statistics have been computed"`
+	lrMods         []trcLrateMod
 }
 
 var KiT_TRCLayer = kit.Types.AddType(&TRCLayer{}, LayerProps)
@@ -117,6 +121,7 @@ func (ly *TRCLayer) Defaults() {
 	ly.Act.Init.Decay = 0 // deep doesn't decay!
 	ly.TRC.Defaults()
 	ly.TopoInhib.Defaults()
+	ly.PredErr.Defaults()
 	ly.Typ = TRC
 }
 
@@ -126,6 +131,7 @@ func (ly *TRCLayer) UpdateParams() {
 	ly.TopoInhibLayer.UpdateParams()
 	ly.TRC.Update()
 	ly.TopoInhib.Update()
+	ly.PredErr.Update()
 }
 
 func (ly *TRCLayer) Class() string {
@@ -139,9 +145,20 @@ func (ly *TRCLayer) IsTarget() bool {
 ///////////////////////////////////////////////////////////////////////////////////////
 // Drivers
 
+// Build constructs the layer state, including calling Build on the projections.
+func (ly *TRCLayer) Build() error {
+	err := ly.TopoInhibLayer.Build()
+	if err != nil {
+		return err
+	}
+	ly.PredErrs = make([]PredErr, len(ly.Pools))
+	return nil
+}
+
 func (ly *TRCLayer) InitWts() {
 	ly.TopoInhibLayer.InitWts()
 	ly.SetDriverOffs()
+	ly.InitPredErrs()
 }
 
 // UnitsSize returns the dimension of the units, either within a pool for 4D, or layer for 2D
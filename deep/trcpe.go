@@ -0,0 +1,278 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deep
+
+import (
+	"fmt"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/chewxy/math32"
+)
+
+// PredErrParams determine how prediction error statistics are computed in TRCLayer,
+// comparing the minus-phase prediction (ActM) with the plus-phase burst-driven
+// outcome (ActP), and how surprise modulates learning in the predicting layers.
+type PredErrParams struct {
+	On       bool    `desc:"compute prediction error statistics per pool and for the layer at the end of each plus phase"`
+	Tau      float32 `viewif:"On" def:"100" min:"1" desc:"time constant in trials for the running average of SSE, which surprise is relative to"`
+	AvgMin   float32 `viewif:"On" def:"0.01" min:"0" desc:"minimum running average SSE used for normalizing surprise, to avoid extreme values when predictions have been consistently accurate"`
+	LrateMod bool    `viewif:"On" desc:"modulate the learning rate of projections into the CT and Super layers that project to this layer, as a function of the layer-level surprise -- the multiplier is surprise clipped to the LrateMin, LrateMax range (1 = average surprise)"`
+	LrateMin float32 `viewif:"LrateMod" def:"0.2" min:"0" desc:"minimum learning rate multiplier for surprise modulation"`
+	LrateMax float32 `viewif:"LrateMod" def:"2" min:"0" desc:"maximum learning rate multiplier for surprise modulation"`
+	Dt       float32 `view:"-" json:"-" inactive:"+" desc:"rate = 1 / tau"`
+}
+
+func (pe *PredErrParams) Defaults() {
+	pe.Tau = 100
+	pe.AvgMin = 0.01
+	pe.LrateMin = 0.2
+	pe.LrateMax = 2
+	pe.Update()
+}
+
+func (pe *PredErrParams) Update() {
+	pe.Dt = 1 / pe.Tau
+}
+
+// LrateMult returns the learning rate multiplier for given surprise
+func (pe *PredErrParams) LrateMult(surp float32) float32 {
+	return math32.Min(math32.Max(surp, pe.LrateMin), pe.LrateMax)
+}
+
+// PredErr has prediction error statistics for a pool or layer, comparing the
+// minus-phase prediction (ActM) with the plus-phase outcome (ActP)
+type PredErr struct {
+	Cos      float32 `desc:"cosine (normalized dot product) between ActM and ActP -- 1 = perfect prediction"`
+	SSE      float32 `desc:"sum squared error between ActP and ActM"`
+	AvgSSE   float32 `desc:"running average of SSE, with time constant PredErr.Tau"`
+	Surprise float32 `desc:"normalized surprise: SSE relative to the running average SSE prior to this trial -- 1 = typical prediction error, > 1 = more surprising than usual"`
+}
+
+func (pe *PredErr) Init() {
+	pe.Cos = 0
+	pe.SSE = 0
+	pe.AvgSSE = 0
+	pe.Surprise = 0
+}
+
+var (
+	// TRCNeuronVars are the prediction error variables for TRCLayer,
+	// with values for the pool that each neuron belongs to
+	TRCNeuronVars = []string{"PredCos", "PredSSE", "PredAvgSSE", "PredSurprise"}
+
+	TRCNeuronVarsMap map[string]int
+)
+
+func init() {
+	TRCNeuronVarsMap = make(map[string]int, len(TRCNeuronVars))
+	for i, v := range TRCNeuronVars {
+		TRCNeuronVarsMap[v] = i
+	}
+}
+
+// VarByIdx returns the PredErr value by index in TRCNeuronVars
+func (pe *PredErr) VarByIdx(idx int) float32 {
+	switch idx {
+	case 0:
+		return pe.Cos
+	case 1:
+		return pe.SSE
+	case 2:
+		return pe.AvgSSE
+	case 3:
+		return pe.Surprise
+	}
+	return math32.NaN()
+}
+
+// trcLrateMod records the learning rate that TRCLayer set for a projection,
+// so that the base learning rate can be recovered on the next trial,
+// unless it has been changed in the meantime (e.g., by LrateMult)
+type trcLrateMod struct {
+	pj    *leabra.Prjn
+	base  float32
+	lrate float32
+}
+
+//////////////////////////////////////////////////////////////////////////////////////
+//  TRCLayer prediction error
+
+// InitPredErrs initializes the prediction error statistics, and restores
+// the base learning rate of projections modulated by SurpriseLrate
+func (ly *TRCLayer) InitPredErrs() {
+	for pi := range ly.PredErrs {
+		ly.PredErrs[pi].Init()
+	}
+	ly.PredErrN = 0
+	for i := range ly.lrMods {
+		lm := &ly.lrMods[i]
+		if lm.pj.Learn.Lrate == lm.lrate { // not changed elsewhere
+			lm.pj.Learn.Lrate = lm.base
+		}
+	}
+	ly.lrMods = nil
+}
+
+// PredErrFmActs computes the prediction error statistics for each pool and the
+// layer as a whole (index 0), from ActM and ActP.  Called in QuarterFinal at
+// the end of the plus phase if PredErr.On.
+func (ly *TRCLayer) PredErrFmActs() {
+	for pi := range ly.Pools {
+		pl := &ly.Pools[pi]
+		pe := &ly.PredErrs[pi]
+		dp, mm, pp, sse := float32(0), float32(0), float32(0), float32(0)
+		for ni := pl.StIdx; ni < pl.EdIdx; ni++ {
+			nrn := &ly.Neurons[ni]
+			if nrn.IsOff() {
+				continue
+			}
+			dp += nrn.ActM * nrn.ActP
+			mm += nrn.ActM * nrn.ActM
+			pp += nrn.ActP * nrn.ActP
+			d := nrn.ActP - nrn.ActM
+			sse += d * d
+		}
+		pe.Cos = 0
+		if mm > 0 && pp > 0 {
+			pe.Cos = dp / math32.Sqrt(mm*pp)
+		}
+		pe.SSE = sse
+		if ly.PredErrN == 0 {
+			pe.AvgSSE = sse
+		}
+		pe.Surprise = sse / math32.Max(pe.AvgSSE, ly.PredErr.AvgMin)
+		pe.AvgSSE += ly.PredErr.Dt * (sse - pe.AvgSSE)
+	}
+	ly.PredErrN++
+}
+
+// LayPredErr returns the layer-level prediction error statistics
+func (ly *TRCLayer) LayPredErr() *PredErr {
+	return &ly.PredErrs[0]
+}
+
+// PoolPredErr returns the prediction error statistics for given pool index
+// (0 = layer, 1..n for sub-pools)
+func (ly *TRCLayer) PoolPredErr(pi int) (*PredErr, error) {
+	if pi < 0 || pi >= len(ly.PredErrs) {
+		return nil, fmt.Errorf("deep.TRCLayer: %s PoolPredErr: pool index %d out of range", ly.Nm, pi)
+	}
+	return &ly.PredErrs[pi], nil
+}
+
+// PredictLayers returns the CT and Super layers that project to this layer,
+// whose learning is modulated by surprise if PredErr.LrateMod is on.
+func (ly *TRCLayer) PredictLayers() []*leabra.Layer {
+	var lays []*leabra.Layer
+	for _, p := range ly.RcvPrjns {
+		if p.IsOff() {
+			continue
+		}
+		var sly *leabra.Layer
+		switch sl := p.SendLay().(type) {
+		case *CTLayer:
+			sly = &sl.Layer
+		case *SuperLayer:
+			sly = &sl.Layer
+		default:
+			continue
+		}
+		has := false
+		for _, l := range lays {
+			if l == sly {
+				has = true
+				break
+			}
+		}
+		if !has {
+			lays = append(lays, sly)
+		}
+	}
+	return lays
+}
+
+// SurpriseLrate sets the learning rate of projections into the PredictLayers
+// as a function of the layer-level surprise, relative to their base learning
+// rate.  Called in QuarterFinal after PredErrFmActs if PredErr.LrateMod is on.
+func (ly *TRCLayer) SurpriseLrate() {
+	if ly.lrMods == nil {
+		for _, sly := range ly.PredictLayers() {
+			for _, p := range sly.RcvPrjns {
+				pj := p.(leabra.LeabraPrjn).AsLeabra()
+				ly.lrMods = append(ly.lrMods, trcLrateMod{pj: pj, base: pj.Learn.Lrate, lrate: pj.Learn.Lrate})
+			}
+		}
+	}
+	mult := ly.PredErr.LrateMult(ly.PredErrs[0].Surprise)
+	for i := range ly.lrMods {
+		lm := &ly.lrMods[i]
+		if lm.pj.Learn.Lrate != lm.lrate { // changed elsewhere: new base
+			lm.base = lm.pj.Learn.Lrate
+		}
+		lm.lrate = lm.base * mult
+		lm.pj.Learn.Lrate = lm.lrate
+	}
+}
+
+// QuarterFinal does updating after end of a quarter, including
+// computing prediction error stats at end of plus phase
+func (ly *TRCLayer) QuarterFinal(ltime *leabra.Time) {
+	ly.TopoInhibLayer.QuarterFinal(ltime)
+	if ltime.Quarter != 3 || !ly.PredErr.On {
+		return
+	}
+	ly.PredErrFmActs()
+	if ly.PredErr.LrateMod {
+		ly.SurpriseLrate()
+	}
+}
+
+// UnitVarNames returns a list of variable names available on the units in this layer
+func (ly *TRCLayer) UnitVarNames() []string {
+	return NeuronVarsAll
+}
+
+// UnitVarIdx returns the index of given variable within the Neuron,
+// according to UnitVarNames() list (using a map to lookup index),
+// or -1 and error message if not found.
+func (ly *TRCLayer) UnitVarIdx(varNm string) (int, error) {
+	vidx, err := ly.TopoInhibLayer.UnitVarIdx(varNm)
+	if err == nil {
+		return vidx, err
+	}
+	vidx, ok := TRCNeuronVarsMap[varNm]
+	if !ok {
+		return -1, fmt.Errorf("deep.TRCLayer: variable named: %s not found", varNm)
+	}
+	return vidx + ly.TopoInhibLayer.UnitVarNum(), nil
+}
+
+// UnitVal1D returns value of given variable index on given unit, using 1-dimensional index.
+// returns NaN on invalid index.
+// This is the core unit var access method used by other methods,
+// so it is the only one that needs to be updated for derived layer types.
+func (ly *TRCLayer) UnitVal1D(varIdx int, idx int) float32 {
+	if varIdx < 0 {
+		return math32.NaN()
+	}
+	nn := ly.TopoInhibLayer.UnitVarNum()
+	if varIdx < nn {
+		return ly.TopoInhibLayer.UnitVal1D(varIdx, idx)
+	}
+	if idx < 0 || idx >= len(ly.Neurons) || len(ly.PredErrs) != len(ly.Pools) {
+		return math32.NaN()
+	}
+	varIdx -= nn
+	if varIdx >= len(TRCNeuronVars) {
+		return math32.NaN()
+	}
+	return ly.PredErrs[ly.Neurons[idx].SubPool].VarByIdx(varIdx)
+}
+
+// UnitVarNum returns the number of Neuron-level variables
+// for this layer.  This is needed for extending indexes in derived types.
+func (ly *TRCLayer) UnitVarNum() int {
+	return ly.TopoInhibLayer.UnitVarNum() + len(TRCNeuronVars)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deep
+
+import (
+	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/chewxy/math32"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+)
+
+// trcPredNet returns a small deep network with surprise lrate modulation on,
+// along with its Input and TRC layers and a CT projection that is modulated
+func trcPredNet(t *testing.T) (*Network, *leabra.Layer, *TRCLayer, *leabra.Prjn) {
+	net := &Network{}
+	net.InitName(net, "PredNet")
+	in := net.AddLayer4D("Input", 2, 2, 2, 2, emer.Input)
+	hid, ct, trci := net.AddDeep4D("Hid", 2, 2, 2, 2)
+	trc := trci.(*TRCLayer)
+	trc.Drivers.Add("Input")
+	net.ConnectLayers(in, hid, prjn.NewPoolOneToOne(), emer.Forward)
+	net.Defaults()
+	trc.PredErr.On = true
+	trc.PredErr.LrateMod = true
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	ctly := ct.(*CTLayer)
+	ctpj := ctly.RcvPrjns[0].(leabra.LeabraPrjn).AsLeabra()
+	return net, in.(leabra.LeabraLayer).AsLeabra(), trc, ctpj
+}
+
+// trcPredTrial runs one alpha trial with an input pattern that shifts by trial
+func trcPredTrial(net *Network, inl *leabra.Layer, ltime *leabra.Time, trl int) {
+	for ni := range inl.Neurons {
+		v := float32(0)
+		if (ni+trl)%3 == 0 {
+			v = 1
+		}
+		inl.Neurons[ni].Ext = v
+		inl.Neurons[ni].SetFlag(leabra.NeurHasExt)
+	}
+	net.AlphaCycInit()
+	ltime.AlphaCycStart()
+	for qtr := 0; qtr < 4; qtr++ {
+		for cyc := 0; cyc < ltime.CycPerQtr; cyc++ {
+			net.Cycle(ltime)
+			ltime.CycleInc()
+		}
+		net.QuarterFinal(ltime)
+		ltime.QuarterInc()
+	}
+}
+
+func TestTRCPredErr(t *testing.T) {
+	net, inl, trc, ctpj := trcPredNet(t)
+	base := ctpj.Learn.Lrate
+
+	ltime := leabra.NewTime()
+	for trl := 0; trl < 3; trl++ {
+		trcPredTrial(net, inl, ltime, trl)
+		if trc.PredErrN != trl+1 {
+			t.Errorf("PredErrN: %d != %d", trc.PredErrN, trl+1)
+		}
+		// check the layer-level stats against direct computation
+		sse, _ := trc.MSE(0)
+		lpe := trc.LayPredErr()
+		if math32.Abs(lpe.SSE-float32(sse)) > 1.0e-4 {
+			t.Errorf("trial: %d SSE: %g != %g", trl, lpe.SSE, sse)
+		}
+		pse := float32(0)
+		for pi := 1; pi < len(trc.Pools); pi++ {
+			ppe, _ := trc.PoolPredErr(pi)
+			pse += ppe.SSE
+			if ppe.Cos < -1.0e-6 || ppe.Cos > 1+1.0e-6 {
+				t.Errorf("pool: %d Cos out of range: %g", pi, ppe.Cos)
+			}
+		}
+		if math32.Abs(lpe.SSE-pse) > 1.0e-4 {
+			t.Errorf("trial: %d pool SSE sum: %g != layer: %g", trl, pse, lpe.SSE)
+		}
+		if trl == 0 && lpe.SSE > 0 && math32.Abs(lpe.Surprise-1) > 1.0e-6 {
+			t.Errorf("first trial surprise: %g != 1", lpe.Surprise)
+		}
+		cor := base * trc.PredErr.LrateMult(lpe.Surprise)
+		if math32.Abs(ctpj.Learn.Lrate-cor) > 1.0e-6 {
+			t.Errorf("trial: %d CT lrate: %g != %g", trl, ctpj.Learn.Lrate, cor)
+		}
+		uv := trc.UnitVal("PredSSE", []int{0, 0, 0, 0})
+		if ppe, _ := trc.PoolPredErr(1); uv != ppe.SSE {
+			t.Errorf("PredSSE unit var: %g != pool: %g", uv, ppe.SSE)
+		}
+	}
+	if _, err := trc.PoolPredErr(len(trc.Pools)); err == nil {
+		t.Errorf("PoolPredErr should return error for invalid pool")
+	}
+}
+
+func TestTRCPredErrInitWts(t *testing.T) {
+	net, inl, trc, ctpj := trcPredNet(t)
+	base := ctpj.Learn.Lrate
+
+	ltime := leabra.NewTime()
+	for run := 0; run < 3; run++ {
+		for trl := 0; trl < 3; trl++ {
+			trcPredTrial(net, inl, ltime, trl)
+		}
+		cor := base * trc.PredErr.LrateMult(trc.LayPredErr().Surprise)
+		if math32.Abs(ctpj.Learn.Lrate-cor) > 1.0e-6 {
+			t.Errorf("run: %d CT lrate: %g != %g", run, ctpj.Learn.Lrate, cor)
+		}
+		net.InitWts()
+		if ctpj.Learn.Lrate != base {
+			t.Errorf("run: %d CT lrate after InitWts: %g != base: %g", run, ctpj.Learn.Lrate, base)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package envs provides reusable env.Env environments for leabra models.
+
+  - FSAEnv generates sequences from a finite state automaton (FSA) grammar,
+    e.g., the Reber grammar, which can be specified in a JSON or TSV file
+    (see FSASpec), with several encodings of the current state and the valid
+    next states, and a check for whether a model's prediction (e.g., of a deep
+    TRCLayer) matches any valid transition.
+
+  - SocketEnv drives a model from an environment simulated outside of Go
+    (e.g., a game or robotic simulator), exchanging named observation and
+    action tensors and rewards as newline-delimited JSON messages over a
+    Unix or TCP socket (see SockMsg), in lockstep or asynchronously.
+    A reference Python client is in python/sockenv.
+*/
+package envs
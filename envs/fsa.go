@@ -0,0 +1,391 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envs
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/emer/emergent/env"
+	"github.com/emer/emergent/erand"
+	"github.com/emer/etable/etensor"
+)
+
+// FSAEnv generates states in a finite state automaton (FSA) which is a
+// simple form of grammar for creating non-deterministic but still
+// overall structured sequences.  The grammar is configured from an FSASpec,
+// which can be loaded from a JSON or TSV file (see OpenSpec).
+//
+// State elements:
+//   - NNext, NextStates, NextLabels: number, indexes and labels of the valid
+//     transitions from the previous state, with the one actually taken first.
+//   - CurState: one-hot encoding of the current state.
+//   - ValidNext: all states that are valid next states from the current state.
+//   - Label: one-hot encoding of the label of the transition just taken.
+//   - LabelTargs: all labels of valid transitions from the previous state,
+//     i.e., the valid predictions for Label on this step.
+//   - ValidLabels: all labels of valid transitions from the current state,
+//     i.e., the valid predictions for Label on the next step.
+type FSAEnv struct {
+	Nm          string          `desc:"name of this environment"`
+	Dsc         string          `desc:"description of this environment"`
+	Spec        FSASpec         `view:"no-inline" desc:"the grammar specification that the transition matrix is configured from"`
+	TMat        etensor.Float64 `view:"no-inline" desc:"transition matrix, which is a square NxN tensor with outer dim being current state and inner dim having probability of transitioning to that state"`
+	Labels      etensor.String  `desc:"transition labels, one for each transition cell in TMat matrix"`
+	Start       int             `desc:"index of the start state -- states with no transitions out of them are end states, after which the next step starts over here"`
+	AState      env.CurPrvInt   `desc:"automaton state within FSA that we're in"`
+	NNext       etensor.Int     `desc:"number of next states in current state output (scalar)"`
+	NextStates  etensor.Int     `desc:"next states that have non-zero probability, with actual randomly chosen next state at start"`
+	NextLabels  etensor.String  `desc:"transition labels for next states that have non-zero probability, with actual randomly chosen one for next state at start"`
+	CurState    etensor.Float32 `desc:"one-hot encoding of the current state"`
+	ValidNext   etensor.Float32 `desc:"encoding of all valid next states from the current state"`
+	Label       etensor.Float32 `desc:"one-hot encoding of the label of the transition just taken, over Spec.Labels"`
+	LabelTargs  etensor.Float32 `desc:"encoding of all labels of valid transitions from the previous state, over Spec.Labels -- the valid predictions for Label"`
+	ValidLabels etensor.Float32 `desc:"encoding of all labels of valid transitions from the current state, over Spec.Labels -- the valid predictions for the next Label"`
+	Rnd         *rand.Rand      `view:"-" json:"-" desc:"random number generator for choosing transitions -- if nil, the global generator is used"`
+	Run         env.Ctr         `view:"inline" desc:"current run of model as provided during Init"`
+	Epoch       env.Ctr         `view:"inline" desc:"number of times through Seq.Max number of sequences"`
+	Seq         env.Ctr         `view:"inline" desc:"sequence counter within epoch"`
+	Tick        env.Ctr         `view:"inline" desc:"tick counter within sequence"`
+	Trial       env.Ctr         `view:"inline" desc:"trial is the step counter within sequence - how many steps taken within current sequence -- it resets to 0 at start of each sequence"`
+	labelMap    map[string]int
+}
+
+func (ev *FSAEnv) Name() string { return ev.Nm }
+func (ev *FSAEnv) Desc() string { return ev.Dsc }
+
+// OpenSpec opens the grammar spec from a JSON (.json) or TSV file,
+// and configures the environment from it.
+func (ev *FSAEnv) OpenSpec(filename string) error {
+	var fs FSASpec
+	if err := fs.Open(filename); err != nil {
+		return err
+	}
+	return ev.ConfigSpec(&fs)
+}
+
+// ConfigSpec configures the transition matrix and labels from given spec,
+// and initializes the environment.
+func (ev *FSAEnv) ConfigSpec(fs *FSASpec) error {
+	fs.Update()
+	if err := fs.Validate(); err != nil {
+		return err
+	}
+	ev.Spec = *fs
+	ev.InitTMat(len(fs.States))
+	for _, tr := range fs.Trans {
+		ev.SetTMat(fs.StateIdx(tr.From), fs.StateIdx(tr.To), tr.P, tr.Label)
+	}
+	ev.Start = fs.StateIdx(fs.Start)
+	ev.Init(0)
+	return nil
+}
+
+// InitTMat initializes matrix and labels to given size.
+// Use SetTMat to set transitions directly, instead of ConfigSpec.
+func (ev *FSAEnv) InitTMat(nst int) {
+	ev.TMat.SetShape([]int{nst, nst}, nil, []string{"cur", "next"})
+	ev.Labels.SetShape([]int{nst, nst}, nil, []string{"cur", "next"})
+	ev.TMat.SetZeros()
+	ev.Labels.SetZeros()
+	ev.NNext.SetShape([]int{1}, nil, nil)
+	ev.NextStates.SetShape([]int{nst}, nil, nil)
+	ev.NextLabels.SetShape([]int{nst}, nil, nil)
+	ev.CurState.SetShape([]int{nst}, nil, []string{"states"})
+	ev.ValidNext.SetShape([]int{nst}, nil, []string{"states"})
+	if len(ev.Spec.States) != nst {
+		ev.Spec.States = make([]string, nst)
+		for i := range ev.Spec.States {
+			ev.Spec.States[i] = fmt.Sprintf("S%d", i)
+		}
+		ev.Spec.Trans = nil
+		ev.Spec.Start = ev.Spec.States[0]
+	}
+	ev.ConfigLabels()
+}
+
+// ConfigLabels configures the label encodings from Spec.Labels
+func (ev *FSAEnv) ConfigLabels() {
+	nl := len(ev.Spec.Labels)
+	ev.labelMap = make(map[string]int, nl)
+	for i, l := range ev.Spec.Labels {
+		ev.labelMap[l] = i
+	}
+	ev.Label.SetShape([]int{nl}, nil, []string{"labels"})
+	ev.LabelTargs.SetShape([]int{nl}, nil, []string{"labels"})
+	ev.ValidLabels.SetShape([]int{nl}, nil, []string{"labels"})
+}
+
+// SetTMat sets given transition matrix probability and label,
+// adding the label to Spec.Labels if not already present.
+func (ev *FSAEnv) SetTMat(fm, to int, p float64, lbl string) {
+	ev.TMat.Set([]int{fm, to}, p)
+	ev.Labels.Set([]int{fm, to}, lbl)
+	if lbl != "" && ev.Spec.LabelIdx(lbl) < 0 {
+		ev.Spec.Labels = append(ev.Spec.Labels, lbl)
+		ev.ConfigLabels()
+	}
+}
+
+// TMatReber sets the transition matrix to the standard Reber grammar FSA
+func (ev *FSAEnv) TMatReber() {
+	ev.ConfigSpec(ReberSpec())
+}
+
+func (ev *FSAEnv) Validate() error {
+	if ev.TMat.Len() == 0 {
+		return fmt.Errorf("FSAEnv: %v has no transition matrix TMat set", ev.Nm)
+	}
+	return nil
+}
+
+func (ev *FSAEnv) Counters() []env.TimeScales {
+	return []env.TimeScales{env.Run, env.Epoch, env.Sequence, env.Tick, env.Trial}
+}
+
+func (ev *FSAEnv) States() env.Elements {
+	nst := ev.TMat.Dim(0)
+	if nst < 2 {
+		nst = 2 // at least usu
+	}
+	nl := len(ev.Spec.Labels)
+	els := env.Elements{
+		{"NNext", []int{1}, nil},
+		{"NextStates", []int{nst}, []string{"nstates"}},
+		{"NextLabels", []int{nst}, []string{"nstates"}},
+		{"CurState", []int{nst}, []string{"states"}},
+		{"ValidNext", []int{nst}, []string{"states"}},
+		{"Label", []int{nl}, []string{"labels"}},
+		{"LabelTargs", []int{nl}, []string{"labels"}},
+		{"ValidLabels", []int{nl}, []string{"labels"}},
+	}
+	return els
+}
+
+func (ev *FSAEnv) State(element string) etensor.Tensor {
+	switch element {
+	case "NNext":
+		return &ev.NNext
+	case "NextStates":
+		return &ev.NextStates
+	case "NextLabels":
+		return &ev.NextLabels
+	case "CurState":
+		return &ev.CurState
+	case "ValidNext":
+		return &ev.ValidNext
+	case "Label":
+		return &ev.Label
+	case "LabelTargs":
+		return &ev.LabelTargs
+	case "ValidLabels":
+		return &ev.ValidLabels
+	}
+	return nil
+}
+
+func (ev *FSAEnv) Actions() env.Elements {
+	return nil
+}
+
+// String returns the current state as a string
+func (ev *FSAEnv) String() string {
+	nn := ev.NNext.Values[0]
+	lbls := ev.NextLabels.Values[0:nn]
+	return fmt.Sprintf("S_%d_%v", ev.AState.Cur, lbls)
+}
+
+func (ev *FSAEnv) Init(run int) {
+	ev.Run.Scale = env.Run
+	ev.Epoch.Scale = env.Epoch
+	ev.Tick.Scale = env.Tick
+	ev.Trial.Scale = env.Trial
+	ev.Run.Init()
+	ev.Epoch.Init()
+	ev.Seq.Init()
+	ev.Tick.Init()
+	ev.Trial.Init()
+	ev.Run.Cur = run
+	ev.Trial.Cur = -1 // init state -- key so that first Step() = 0
+	ev.AState.Cur = ev.Start
+	ev.AState.Prv = -1
+}
+
+// IsEnd returns true if given state is an end state, with no transitions out of it
+func (ev *FSAEnv) IsEnd(st int) bool {
+	nst := ev.TMat.Dim(0)
+	ri := st * nst
+	for _, p := range ev.TMat.Values[ri : ri+nst] {
+		if p > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// FromState returns the state that transitions are actually made from,
+// for given current state: the Start state if it is an end state (or invalid).
+func (ev *FSAEnv) FromState(st int) int {
+	nst := ev.TMat.Dim(0)
+	if st < 0 || st >= nst || ev.IsEnd(st) {
+		return ev.Start
+	}
+	return st
+}
+
+// pChoose chooses an index according to given probabilities, using Rnd if set
+func (ev *FSAEnv) pChoose(ps []float64) int {
+	if ev.Rnd == nil {
+		return erand.PChoose64(ps)
+	}
+	sum := 0.0
+	for _, p := range ps {
+		sum += p
+	}
+	pv := ev.Rnd.Float64() * sum
+	sum = 0
+	for i, p := range ps {
+		sum += p
+		if pv < sum {
+			return i
+		}
+	}
+	return len(ps) - 1
+}
+
+// NextState sets NextStates including randomly chosen one at start
+func (ev *FSAEnv) NextState() {
+	nst := ev.TMat.Dim(0)
+	ev.AState.Cur = ev.FromState(ev.AState.Cur)
+	ri := ev.AState.Cur * nst
+	ps := ev.TMat.Values[ri : ri+nst]
+	ls := ev.Labels.Values[ri : ri+nst]
+	nxt := ev.pChoose(ps) // next state chosen at random
+	ev.NextStates.Set1D(0, nxt)
+	ev.NextLabels.Set1D(0, ls[nxt])
+	idx := 1
+	for i, p := range ps {
+		if i != nxt && p > 0 {
+			ev.NextStates.Set1D(idx, i)
+			ev.NextLabels.Set1D(idx, ls[i])
+			idx++
+		}
+	}
+	ev.NNext.Set1D(0, idx)
+	ev.AState.Set(nxt)
+	ev.SetEncodings()
+}
+
+// SetEncodings sets the CurState, ValidNext, Label, LabelTargs, and ValidLabels
+// encodings from the current state and transition.
+func (ev *FSAEnv) SetEncodings() {
+	nst := ev.TMat.Dim(0)
+	ev.CurState.SetZeros()
+	ev.ValidNext.SetZeros()
+	ev.Label.SetZeros()
+	ev.LabelTargs.SetZeros()
+	ev.ValidLabels.SetZeros()
+	ev.CurState.Values[ev.AState.Cur] = 1
+	if li, ok := ev.labelMap[ev.NextLabels.Values[0]]; ok {
+		ev.Label.Values[li] = 1
+	}
+	for i := 0; i < ev.NNext.Values[0]; i++ {
+		if li, ok := ev.labelMap[ev.NextLabels.Values[i]]; ok {
+			ev.LabelTargs.Values[li] = 1
+		}
+	}
+	fm := ev.FromState(ev.AState.Cur)
+	ri := fm * nst
+	for i, p := range ev.TMat.Values[ri : ri+nst] {
+		if p <= 0 {
+			continue
+		}
+		ev.ValidNext.Values[i] = 1
+		if li, ok := ev.labelMap[ev.Labels.Values[ri+i]]; ok {
+			ev.ValidLabels.Values[li] = 1
+		}
+	}
+}
+
+// maxIdx returns the index of the max value in given tensor
+func maxIdx(tsr etensor.Tensor) int {
+	mi := -1
+	mx := 0.0
+	for i := 0; i < tsr.Len(); i++ {
+		v := tsr.FloatVal1D(i)
+		if mi < 0 || v > mx {
+			mi = i
+			mx = v
+		}
+	}
+	return mi
+}
+
+// PredMatch returns whether the prediction for the label on the current step,
+// e.g., the minus phase activations (ActM) of a deep TRCLayer driven by the
+// Label input, matches any valid transition from the previous state (i.e., any
+// label in LabelTargs).  The predicted label is the unit with the maximum value,
+// over units in Spec.Labels order, and is returned.
+func (ev *FSAEnv) PredMatch(pred etensor.Tensor) (string, bool) {
+	if pred.Len() != len(ev.Spec.Labels) {
+		return "", false
+	}
+	li := maxIdx(pred)
+	return ev.Spec.Labels[li], ev.LabelTargs.Values[li] > 0
+}
+
+// PredMatchState returns whether the prediction of the current state, over
+// units in state order (e.g., a TRCLayer driven by the CurState input), matches
+// any valid transition from the previous state (i.e., any of NextStates).
+// The predicted state is the unit with the maximum value, and is returned.
+func (ev *FSAEnv) PredMatchState(pred etensor.Tensor) (int, bool) {
+	if pred.Len() != ev.TMat.Dim(0) {
+		return -1, false
+	}
+	si := maxIdx(pred)
+	for i := 0; i < ev.NNext.Values[0]; i++ {
+		if ev.NextStates.Values[i] == si {
+			return si, true
+		}
+	}
+	return si, false
+}
+
+func (ev *FSAEnv) Step() bool {
+	ev.Epoch.Same() // good idea to just reset all non-inner-most counters at start
+	ev.NextState()
+	ev.Trial.Incr()
+	ev.Tick.Incr()
+	if ev.AState.Prv == ev.Start {
+		ev.Tick.Init()
+		if ev.Seq.Incr() {
+			ev.Epoch.Incr()
+		}
+	}
+	return true
+}
+
+func (ev *FSAEnv) Action(element string, input etensor.Tensor) {
+	// nop
+}
+
+func (ev *FSAEnv) Counter(scale env.TimeScales) (cur, prv int, chg bool) {
+	switch scale {
+	case env.Run:
+		return ev.Run.Query()
+	case env.Epoch:
+		return ev.Epoch.Query()
+	case env.Sequence:
+		return ev.Seq.Query()
+	case env.Tick:
+		return ev.Tick.Query()
+	case env.Trial:
+		return ev.Trial.Query()
+	}
+	return -1, -1, false
+}
+
+// Compile-time check that implements Env interface
+var _ env.Env = (*FSAEnv)(nil)
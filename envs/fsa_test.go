@@ -0,0 +1,115 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envs
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/emer/etable/etensor"
+)
+
+func TestFSASpecOpen(t *testing.T) {
+	rb := &FSAEnv{}
+	rb.TMatReber()
+	for _, fn := range []string{"testdata/reber.json", "testdata/reber.tsv"} {
+		ev := &FSAEnv{}
+		if err := ev.OpenSpec(fn); err != nil {
+			t.Fatal(err)
+		}
+		if ev.Spec.Name != "Reber" {
+			t.Errorf("%s: name: %s", fn, ev.Spec.Name)
+		}
+		if ev.TMat.Len() != rb.TMat.Len() {
+			t.Fatalf("%s: TMat size: %d != %d", fn, ev.TMat.Len(), rb.TMat.Len())
+		}
+		for i, p := range ev.TMat.Values {
+			if p != rb.TMat.Values[i] || ev.Labels.Values[i] != rb.Labels.Values[i] {
+				t.Errorf("%s: TMat / Labels diff at: %d", fn, i)
+			}
+		}
+		for i, l := range ev.Spec.Labels {
+			if rb.Spec.Labels[i] != l {
+				t.Errorf("%s: label %d: %s != %s", fn, i, l, rb.Spec.Labels[i])
+			}
+		}
+	}
+
+	var fs FSASpec
+	if err := fs.ReadTSV(strings.NewReader("A\tB\tx\tL\nA\tB\tbad\n")); err == nil {
+		t.Errorf("expected error for bad P")
+	}
+	fs = FSASpec{Start: "Z"}
+	fs.AddTrans("A", "B", 1, "L")
+	fs.Update()
+	if err := fs.Validate(); err == nil {
+		t.Errorf("expected error for missing Start state")
+	}
+}
+
+func TestFSAEnvStep(t *testing.T) {
+	ev := &FSAEnv{}
+	ev.TMatReber()
+	ev.Rnd = rand.New(rand.NewSource(1))
+	ev.Init(0)
+	prvLbl := ""
+	for i := 0; i < 200; i++ {
+		prv := ev.FromState(ev.AState.Cur)
+		ev.Step()
+		cur := ev.AState.Cur
+		lbl := ev.NextLabels.Values[0]
+		if lbl != ev.Labels.Value([]int{prv, cur}) || ev.TMat.Value([]int{prv, cur}) <= 0 {
+			t.Fatalf("step %d: invalid transition: %d -> %d: %s", i, prv, cur, lbl)
+		}
+		if prvLbl == "E" && lbl != "B" {
+			t.Errorf("step %d: sequence should restart with B after E, got: %s", i, lbl)
+		}
+		prvLbl = lbl
+		li := ev.Spec.LabelIdx(lbl)
+		if ev.Label.Values[li] != 1 || ev.LabelTargs.Values[li] != 1 || ev.CurState.Values[cur] != 1 {
+			t.Errorf("step %d: encodings not set for label: %s state: %d", i, lbl, cur)
+		}
+		nt := 0
+		for _, v := range ev.LabelTargs.Values {
+			if v > 0 {
+				nt++
+			}
+		}
+		if nt != ev.NNext.Values[0] {
+			t.Errorf("step %d: LabelTargs: %d != NNext: %d", i, nt, ev.NNext.Values[0])
+		}
+		fm := ev.FromState(cur)
+		for si, v := range ev.ValidNext.Values {
+			if (v > 0) != (ev.TMat.Value([]int{fm, si}) > 0) {
+				t.Errorf("step %d: ValidNext wrong for state: %d", i, si)
+			}
+		}
+
+		pred := etensor.NewFloat32([]int{len(ev.Spec.Labels)}, nil, nil)
+		pred.Values[li] = 0.8
+		if plbl, ok := ev.PredMatch(pred); !ok || plbl != lbl {
+			t.Errorf("step %d: PredMatch of actual label should match: %s", i, plbl)
+		}
+		for ii, v := range ev.LabelTargs.Values {
+			if v == 0 {
+				pred.SetZeros()
+				pred.Values[ii] = 1
+				if _, ok := ev.PredMatch(pred); ok {
+					t.Errorf("step %d: PredMatch of invalid label: %s should not match", i, ev.Spec.Labels[ii])
+				}
+				break
+			}
+		}
+		spred := etensor.NewFloat32([]int{ev.TMat.Dim(0)}, nil, nil)
+		spred.Values[cur] = 1
+		if _, ok := ev.PredMatchState(spred); !ok {
+			t.Errorf("step %d: PredMatchState of actual state should match", i)
+		}
+	}
+	if ev.Seq.Cur == 0 {
+		t.Errorf("no sequences completed")
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envs
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// FSATrans is one transition in a finite state automaton
+type FSATrans struct {
+	From  string  `desc:"name of the state transitioned from"`
+	To    string  `desc:"name of the state transitioned to"`
+	P     float64 `desc:"probability of this transition, relative to the other transitions from the same state"`
+	Label string  `desc:"label for this transition, e.g., the symbol that is emitted"`
+}
+
+// FSASpec specifies a finite state automaton (FSA) grammar in terms of
+// labeled transitions between named states.  It can be loaded from a JSON
+// file with these fields, or from a TSV file with one transition per line,
+// with columns: From, To, P, Label (see ReadTSV for details).
+// States with no transitions out of them are end states: the next
+// step after an end state starts over at the Start state.
+type FSASpec struct {
+	Name   string     `desc:"name of the grammar"`
+	Desc   string     `desc:"description of the grammar"`
+	States []string   `desc:"names of the states, in order, defining the state unit encoding -- if empty, states are added in order of first appearance in Trans"`
+	Start  string     `desc:"name of the start state -- defaults to the first state"`
+	Labels []string   `desc:"transition labels, in order, defining the label unit encoding -- if empty, labels are added in order of first appearance in Trans"`
+	Trans  []FSATrans `desc:"the transitions"`
+}
+
+// StateIdx returns the index of given state name, or -1 if not found
+func (fs *FSASpec) StateIdx(name string) int {
+	for i, s := range fs.States {
+		if s == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// LabelIdx returns the index of given label, or -1 if not found
+func (fs *FSASpec) LabelIdx(lbl string) int {
+	for i, l := range fs.Labels {
+		if l == lbl {
+			return i
+		}
+	}
+	return -1
+}
+
+// AddTrans adds a new transition
+func (fs *FSASpec) AddTrans(from, to string, p float64, lbl string) {
+	fs.Trans = append(fs.Trans, FSATrans{From: from, To: to, P: p, Label: lbl})
+}
+
+// Update adds any states and labels in Trans that are not already in
+// the States and Labels lists, and sets Start to the first state if empty.
+func (fs *FSASpec) Update() {
+	for _, tr := range fs.Trans {
+		if fs.StateIdx(tr.From) < 0 {
+			fs.States = append(fs.States, tr.From)
+		}
+		if fs.StateIdx(tr.To) < 0 {
+			fs.States = append(fs.States, tr.To)
+		}
+		if tr.Label != "" && fs.LabelIdx(tr.Label) < 0 {
+			fs.Labels = append(fs.Labels, tr.Label)
+		}
+	}
+	if fs.Start == "" && len(fs.States) > 0 {
+		fs.Start = fs.States[0]
+	}
+}
+
+// Validate checks that the spec is valid, returning an error if not.
+// Call Update first to add states and labels from the transitions.
+func (fs *FSASpec) Validate() error {
+	if len(fs.States) == 0 || len(fs.Trans) == 0 {
+		return fmt.Errorf("FSASpec: %s has no states or transitions", fs.Name)
+	}
+	if fs.StateIdx(fs.Start) < 0 {
+		return fmt.Errorf("FSASpec: %s Start state: %s not found", fs.Name, fs.Start)
+	}
+	for i, tr := range fs.Trans {
+		if fs.StateIdx(tr.From) < 0 || fs.StateIdx(tr.To) < 0 {
+			return fmt.Errorf("FSASpec: %s transition %d: state not found: %s -> %s", fs.Name, i, tr.From, tr.To)
+		}
+		if tr.P < 0 {
+			return fmt.Errorf("FSASpec: %s transition %d: %s -> %s has negative probability: %g", fs.Name, i, tr.From, tr.To, tr.P)
+		}
+		if tr.Label != "" && fs.LabelIdx(tr.Label) < 0 {
+			return fmt.Errorf("FSASpec: %s transition %d: label: %s not found in Labels", fs.Name, i, tr.Label)
+		}
+	}
+	return nil
+}
+
+// ReadJSON reads the spec from JSON, and calls Update and Validate
+func (fs *FSASpec) ReadJSON(r io.Reader) error {
+	*fs = FSASpec{}
+	if err := json.NewDecoder(r).Decode(fs); err != nil {
+		return err
+	}
+	fs.Update()
+	return fs.Validate()
+}
+
+// ReadTSV reads the transitions from tab-separated values, with columns
+// From, To, P, Label, and calls Update and Validate.  Lines starting with #
+// are comments, except for Name, Desc, Start, States and Labels directives,
+// e.g., "#Labels: B T S X V P E", and a first line that has a non-numeric
+// P value is skipped as a header.
+func (fs *FSASpec) ReadTSV(r io.Reader) error {
+	*fs = FSASpec{}
+	scan := bufio.NewScanner(r)
+	ln := 0
+	first := true
+	for scan.Scan() {
+		ln++
+		line := strings.TrimSpace(scan.Text())
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			fs.tsvDirective(line[1:])
+			continue
+		}
+		flds := strings.Split(line, "\t")
+		if len(flds) < 3 {
+			return fmt.Errorf("FSASpec ReadTSV: line %d: need at least From, To, P columns: %s", ln, line)
+		}
+		p, err := strconv.ParseFloat(strings.TrimSpace(flds[2]), 64)
+		if err != nil {
+			if first {
+				first = false
+				continue // header
+			}
+			return fmt.Errorf("FSASpec ReadTSV: line %d: %v", ln, err)
+		}
+		first = false
+		lbl := ""
+		if len(flds) > 3 {
+			lbl = strings.TrimSpace(flds[3])
+		}
+		fs.AddTrans(strings.TrimSpace(flds[0]), strings.TrimSpace(flds[1]), p, lbl)
+	}
+	if err := scan.Err(); err != nil {
+		return err
+	}
+	fs.Update()
+	return fs.Validate()
+}
+
+// tsvDirective sets spec fields from a TSV comment line of the form
+// Key: value, for keys Name, Desc, Start, States, Labels -- States and
+// Labels are separated by spaces or tabs.  Other comments are ignored.
+func (fs *FSASpec) tsvDirective(line string) {
+	ci := strings.Index(line, ":")
+	if ci < 0 {
+		return
+	}
+	val := strings.TrimSpace(line[ci+1:])
+	switch strings.TrimSpace(line[:ci]) {
+	case "Name":
+		fs.Name = val
+	case "Desc":
+		fs.Desc = val
+	case "Start":
+		fs.Start = val
+	case "States":
+		fs.States = strings.Fields(val)
+	case "Labels":
+		fs.Labels = strings.Fields(val)
+	}
+}
+
+// OpenJSON opens the spec from a JSON file
+func (fs *FSASpec) OpenJSON(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return fs.ReadJSON(f)
+}
+
+// OpenTSV opens the spec from a TSV file -- the Name is set to the file name
+// without extension if not set by a Name directive
+func (fs *FSASpec) OpenTSV(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	err = fs.ReadTSV(f)
+	if fs.Name == "" {
+		fs.Name = strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+	}
+	return err
+}
+
+// Open opens the spec from a JSON (.json) or TSV (any other extension) file
+func (fs *FSASpec) Open(filename string) error {
+	if strings.ToLower(filepath.Ext(filename)) == ".json" {
+		return fs.OpenJSON(filename)
+	}
+	return fs.OpenTSV(filename)
+}
+
+// SaveJSON saves the spec to a JSON file
+func (fs *FSASpec) SaveJSON(filename string) error {
+	b, err := json.MarshalIndent(fs, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, b, 0644)
+}
+
+// ReberSpec returns the spec for the standard Reber grammar, with the
+// start state S0 emitting B, and end state S7 reached after E.
+func ReberSpec() *FSASpec {
+	fs := &FSASpec{Name: "Reber", Desc: "standard Reber grammar"}
+	fs.Labels = []string{"B", "T", "S", "X", "V", "P", "E"}
+	fs.AddTrans("S0", "S1", 1, "B")
+	fs.AddTrans("S1", "S2", 0.5, "T")
+	fs.AddTrans("S1", "S3", 0.5, "P")
+	fs.AddTrans("S2", "S2", 0.5, "S")
+	fs.AddTrans("S2", "S4", 0.5, "X")
+	fs.AddTrans("S3", "S3", 0.5, "T")
+	fs.AddTrans("S3", "S5", 0.5, "V")
+	fs.AddTrans("S4", "S6", 0.5, "S")
+	fs.AddTrans("S4", "S3", 0.5, "X")
+	fs.AddTrans("S5", "S6", 0.5, "V")
+	fs.AddTrans("S5", "S4", 0.5, "P")
+	fs.AddTrans("S6", "S7", 1, "E")
+	fs.Update()
+	return fs
+}
@@ -0,0 +1,346 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envs
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"sync"
+
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/env"
+	"github.com/emer/etable/etensor"
+)
+
+// SocketEnv is an env.Env for an environment that is simulated outside of Go
+// (e.g., a game or robotic simulator), which communicates over a Unix or TCP
+// socket using a simple protocol of newline-delimited JSON messages (see SockMsg).
+// On each Step, it sends the actions taken by the model (set by Action, or
+// read from the ActLays layers by SendActions) and receives the next named
+// observation tensors, which are available from State, along with the Reward,
+// available as Reward and as the "Reward" State, and Done for the end of an episode.
+//
+// In Lockstep mode (default), Step sends the actions and waits for the observation
+// that results from them.  Otherwise the external simulator runs asynchronously,
+// sending observations whenever it wants, and Step uses the most recent observation,
+// with the Reward summed over all observations since the last Step.
+//
+// By default it connects to the external simulator at Addr, which acts as the server.
+// If Listen is on, it instead listens at Addr and accepts a connection from it,
+// e.g., using the reference Python client in python/sockenv.
+type SocketEnv struct {
+	Nm       string                      `desc:"name of this environment"`
+	Dsc      string                      `desc:"description of this environment"`
+	Network  string                      `desc:"network type: unix or tcp"`
+	Addr     string                      `desc:"socket address: file path for unix, host:port for tcp"`
+	Listen   bool                        `desc:"listen at Addr for a connection from the external simulator, instead of connecting to it"`
+	Lockstep bool                        `def:"true" desc:"wait for the observation resulting from the actions on each Step -- otherwise use the latest observation received"`
+	ActLays  []string                    `desc:"names of layers to send actions from with SendActions, using the layer name as the action name"`
+	ActVar   string                      `def:"ActM" desc:"unit variable to send as the action from ActLays"`
+	Obs      map[string]*etensor.Float32 `desc:"current observation tensors, by name"`
+	Reward   float32                     `inactive:"+" desc:"reward for the previous action"`
+	Done     bool                        `inactive:"+" desc:"true if the current observation is the end of an episode -- the next Step starts a new episode"`
+	RewTsr   etensor.Float32             `view:"-" desc:"Reward as a tensor, for State"`
+	Run      env.Ctr                     `view:"inline" desc:"current run of model as provided during Init"`
+	Epoch    env.Ctr                     `view:"inline" desc:"number of times through Trial.Max number of steps"`
+	Episode  env.Ctr                     `view:"inline" desc:"episode counter over the run, incremented after each Done observation"`
+	Trial    env.Ctr                     `view:"inline" desc:"step counter within epoch"`
+	Conn     *SockConn                   `view:"-" desc:"the connection to the external simulator"`
+	Err      error                       `view:"-" desc:"last error in communicating with the external simulator -- Step returns false if set"`
+	actions  map[string]*SockTensor
+	reset    bool
+	mu       sync.Mutex
+	cond     *sync.Cond
+	pending  *SockMsg
+	readErr  error
+	readDone chan struct{}
+}
+
+func (ev *SocketEnv) Name() string { return ev.Nm }
+func (ev *SocketEnv) Desc() string { return ev.Dsc }
+
+// Defaults sets default parameters
+func (ev *SocketEnv) Defaults() {
+	ev.Network = "unix"
+	ev.Lockstep = true
+	ev.ActVar = "ActM"
+}
+
+func (ev *SocketEnv) Validate() error {
+	if ev.Network != "unix" && ev.Network != "tcp" {
+		return fmt.Errorf("SocketEnv: %v Network: %q must be unix or tcp", ev.Nm, ev.Network)
+	}
+	if ev.Addr == "" {
+		return fmt.Errorf("SocketEnv: %v has no Addr set", ev.Nm)
+	}
+	return nil
+}
+
+// Connect connects to the external simulator, or if Listen is on,
+// listens for and accepts a connection from it.  It is called automatically
+// by Init if not already connected.
+func (ev *SocketEnv) Connect() error {
+	if err := ev.Validate(); err != nil {
+		return err
+	}
+	var conn net.Conn
+	var err error
+	if ev.Listen {
+		var ln net.Listener
+		ln, err = net.Listen(ev.Network, ev.Addr)
+		if err != nil {
+			return err
+		}
+		conn, err = ln.Accept()
+		ln.Close()
+	} else {
+		conn, err = net.Dial(ev.Network, ev.Addr)
+	}
+	if err != nil {
+		return err
+	}
+	ev.Conn = NewSockConn(conn)
+	ev.mu.Lock()
+	if ev.cond == nil {
+		ev.cond = sync.NewCond(&ev.mu)
+	}
+	ev.pending = nil
+	ev.readErr = nil
+	ev.mu.Unlock()
+	if !ev.Lockstep {
+		ev.readDone = make(chan struct{})
+		go ev.readLoop(ev.Conn, ev.readDone)
+	}
+	return nil
+}
+
+// Close sends SockClose to the external simulator and closes the connection,
+// waiting for the background readLoop to exit when not in Lockstep mode.
+func (ev *SocketEnv) Close() error {
+	if ev.Conn == nil {
+		return nil
+	}
+	ev.Conn.Send(&SockMsg{Type: SockClose})
+	err := ev.Conn.Close()
+	if ev.readDone != nil {
+		<-ev.readDone
+		ev.readDone = nil
+	}
+	ev.mu.Lock()
+	ev.pending = nil
+	ev.readErr = nil
+	ev.mu.Unlock()
+	ev.Conn = nil
+	return err
+}
+
+func (ev *SocketEnv) State(element string) etensor.Tensor {
+	if element == "Reward" {
+		return &ev.RewTsr
+	}
+	if tsr, ok := ev.Obs[element]; ok {
+		return tsr
+	}
+	return nil
+}
+
+// Init is called to restart environment -- connects if not already connected,
+// and sends SockReset with the run number.  The initial observation
+// is received on the first Step.
+func (ev *SocketEnv) Init(run int) {
+	ev.Run.Scale = env.Run
+	ev.Epoch.Scale = env.Epoch
+	ev.Episode.Scale = env.Sequence
+	ev.Trial.Scale = env.Trial
+	ev.Run.Init()
+	ev.Epoch.Init()
+	ev.Episode.Init()
+	ev.Trial.Init()
+	ev.Run.Cur = run
+	ev.Trial.Cur = -1 // init state -- key so that first Step() = 0
+	ev.Reward = 0
+	ev.Done = false
+	ev.RewTsr.SetShape([]int{1}, nil, nil)
+	ev.RewTsr.Values[0] = 0
+	ev.actions = nil
+	ev.Err = nil
+	if ev.cond != nil {
+		ev.mu.Lock()
+		ev.pending = nil // discard observations from before the reset
+		ev.mu.Unlock()
+	}
+	if ev.Conn == nil {
+		if ev.Err = ev.Connect(); ev.Err != nil {
+			log.Printf("SocketEnv: %v Init: %v\n", ev.Nm, ev.Err)
+			return
+		}
+	}
+	if ev.Err = ev.Conn.Send(&SockMsg{Type: SockReset, Run: run}); ev.Err != nil {
+		log.Printf("SocketEnv: %v Init: %v\n", ev.Nm, ev.Err)
+		return
+	}
+	ev.reset = true
+}
+
+// Step sends the actions set since the last Step (none after Init) and
+// gets the next observation.  Returns false if there is a communication
+// error, which is logged and recorded in Err.
+func (ev *SocketEnv) Step() bool {
+	if ev.Err != nil {
+		return false
+	}
+	ev.Epoch.Same() // good idea to just reset all non-inner-most counters at start
+	ev.Episode.Same()
+	if ev.Done {
+		ev.Episode.Incr()
+	}
+	if !ev.reset {
+		ev.Err = ev.Conn.Send(&SockMsg{Type: SockStep, Actions: ev.actions})
+	}
+	ev.reset = false
+	ev.actions = nil
+	var msg *SockMsg
+	if ev.Err == nil {
+		msg, ev.Err = ev.recvObs()
+	}
+	if ev.Err == nil {
+		ev.Err = ev.setObs(msg)
+	}
+	if ev.Err != nil {
+		log.Printf("SocketEnv: %v Step: %v\n", ev.Nm, ev.Err)
+		return false
+	}
+	if ev.Trial.Incr() { // true if wraps around Max back to 0
+		ev.Epoch.Incr()
+	}
+	return true
+}
+
+// recvObs returns the next observation: the reply in Lockstep mode,
+// and otherwise the accumulated pending observations, waiting for at least one.
+func (ev *SocketEnv) recvObs() (*SockMsg, error) {
+	if ev.Lockstep {
+		for {
+			msg, err := ev.Conn.Recv()
+			if err != nil {
+				return nil, err
+			}
+			if msg.Type == SockClose {
+				return nil, errors.New("external simulator closed the connection")
+			}
+			if msg.Type == SockObs {
+				return msg, nil
+			}
+		}
+	}
+	ev.mu.Lock()
+	defer ev.mu.Unlock()
+	for ev.pending == nil && ev.readErr == nil {
+		ev.cond.Wait()
+	}
+	msg := ev.pending
+	ev.pending = nil
+	if msg == nil {
+		return nil, ev.readErr
+	}
+	return msg, nil
+}
+
+// readLoop receives observations from given connection in the background
+// when not in Lockstep mode, accumulating the Reward and Done since the last Step.
+// It closes done when it exits, on an error or the connection being closed.
+func (ev *SocketEnv) readLoop(conn *SockConn, done chan struct{}) {
+	defer close(done)
+	for {
+		msg, err := conn.Recv()
+		if err == nil && msg.Type == SockClose {
+			err = errors.New("external simulator closed the connection")
+		}
+		ev.mu.Lock()
+		if err != nil {
+			ev.readErr = err
+			ev.cond.Broadcast()
+			ev.mu.Unlock()
+			return
+		}
+		if msg.Type == SockObs {
+			if ev.pending != nil {
+				msg.Reward += ev.pending.Reward
+				msg.Done = msg.Done || ev.pending.Done
+			}
+			ev.pending = msg
+			ev.cond.Broadcast()
+		}
+		ev.mu.Unlock()
+	}
+}
+
+// setObs sets the observation tensors, Reward and Done from given message
+func (ev *SocketEnv) setObs(msg *SockMsg) error {
+	if ev.Obs == nil {
+		ev.Obs = make(map[string]*etensor.Float32)
+	}
+	for nm, st := range msg.Obs {
+		tsr, ok := ev.Obs[nm]
+		if !ok {
+			tsr = &etensor.Float32{}
+			ev.Obs[nm] = tsr
+		}
+		if err := st.ToTensor(tsr); err != nil {
+			return fmt.Errorf("observation %s: %v", nm, err)
+		}
+	}
+	ev.Reward = msg.Reward
+	ev.RewTsr.Values[0] = msg.Reward
+	ev.Done = msg.Done
+	return nil
+}
+
+// Action sets the action of given name to be sent on the next Step
+func (ev *SocketEnv) Action(element string, input etensor.Tensor) {
+	if ev.actions == nil {
+		ev.actions = make(map[string]*SockTensor)
+	}
+	st := &SockTensor{}
+	st.SetFmTensor(input)
+	ev.actions[element] = st
+}
+
+// SendActions sets the actions to be sent on the next Step from the
+// ActVar variable of the ActLays layers in given network,
+// using the layer names as action names -- call after the minus phase.
+func (ev *SocketEnv) SendActions(net emer.Network) error {
+	for _, lnm := range ev.ActLays {
+		ly, err := net.LayerByNameTry(lnm)
+		if err != nil {
+			return err
+		}
+		tsr := etensor.NewFloat32(ly.Shape().Shapes(), nil, nil)
+		if err := ly.UnitValsTensor(tsr, ev.ActVar); err != nil {
+			return err
+		}
+		ev.Action(lnm, tsr)
+	}
+	return nil
+}
+
+func (ev *SocketEnv) Counter(scale env.TimeScales) (cur, prv int, chg bool) {
+	switch scale {
+	case env.Run:
+		return ev.Run.Query()
+	case env.Epoch:
+		return ev.Epoch.Query()
+	case env.Sequence:
+		return ev.Episode.Query()
+	case env.Trial:
+		return ev.Trial.Query()
+	}
+	return -1, -1, false
+}
+
+// Compile-time check that implements Env interface
+var _ env.Env = (*SocketEnv)(nil)
@@ -0,0 +1,296 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envs
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/env"
+	"github.com/emer/etable/etensor"
+)
+
+// fakeSim is an external simulator for testing SocketEnv: a track of NPos
+// positions, with observation Pos as a one-hot encoding of the position,
+// and action Move, which moves right if Move[1] > Move[0], and otherwise left.
+// Reaching the end gives a reward of 1 and ends the episode.
+type fakeSim struct {
+	NPos  int
+	Pos   int
+	Run   int
+	Moves [][]float32
+}
+
+func (fs *fakeSim) obs(rew float32, done bool) *SockMsg {
+	pos := &SockTensor{Shape: []int{1, fs.NPos}, Values: make([]float32, fs.NPos)}
+	pos.Values[fs.Pos] = 1
+	return &SockMsg{Type: SockObs, Obs: map[string]*SockTensor{"Pos": pos}, Reward: rew, Done: done}
+}
+
+// Serve runs the lockstep protocol on given connection until close
+func (fs *fakeSim) Serve(conn net.Conn) {
+	sc := NewSockConn(conn)
+	defer sc.Close()
+	for {
+		msg, err := sc.Recv()
+		if err != nil || msg.Type == SockClose {
+			return
+		}
+		switch msg.Type {
+		case SockReset:
+			fs.Run = msg.Run
+			fs.Pos = 0
+			sc.Send(fs.obs(0, false))
+		case SockStep:
+			if fs.Pos == fs.NPos-1 { // episode ended
+				fs.Pos = 0
+				sc.Send(fs.obs(0, false))
+				continue
+			}
+			mv := msg.Actions["Move"]
+			fs.Moves = append(fs.Moves, mv.Values)
+			if mv.Values[1] > mv.Values[0] {
+				fs.Pos++
+			} else if fs.Pos > 0 {
+				fs.Pos--
+			}
+			if fs.Pos == fs.NPos-1 {
+				sc.Send(fs.obs(1, true))
+			} else {
+				sc.Send(fs.obs(0, false))
+			}
+		}
+	}
+}
+
+// listenFake starts the fakeSim serving one connection on a new listener
+func listenFake(t *testing.T, fs *fakeSim, network, addr string) net.Listener {
+	ln, err := net.Listen(network, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		fs.Serve(conn)
+	}()
+	return ln
+}
+
+func tempSock(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "sockenv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(dir, "env.sock"), func() { os.RemoveAll(dir) }
+}
+
+var moveRight = etensor.NewFloat32Shape(etensor.NewShape([]int{1, 2}, nil, nil), []float32{0, 1})
+
+// walk checks a lockstep episode of moving right along the fakeSim track
+func walk(t *testing.T, ev *SocketEnv, fs *fakeSim) {
+	ev.Init(2)
+	for step := 0; step < fs.NPos; step++ {
+		if !ev.Step() {
+			t.Fatalf("step %d failed: %v", step, ev.Err)
+		}
+		pos := ev.State("Pos").(*etensor.Float32)
+		if pos.Values[step] != 1 {
+			t.Errorf("step %d Pos: %v", step, pos.Values)
+		}
+		last := step == fs.NPos-1
+		if ev.Done != last || (ev.Reward == 1) != last {
+			t.Errorf("step %d Done: %v Reward: %g", step, ev.Done, ev.Reward)
+		}
+		if rt := ev.State("Reward").(*etensor.Float32); rt.Values[0] != ev.Reward {
+			t.Errorf("step %d Reward State: %g != %g", step, rt.Values[0], ev.Reward)
+		}
+		ev.Action("Move", moveRight)
+	}
+	if !ev.Step() {
+		t.Fatalf("new episode step failed: %v", ev.Err)
+	}
+	if pos := ev.State("Pos").(*etensor.Float32); pos.Values[0] != 1 || ev.Done {
+		t.Errorf("new episode Pos: %v Done: %v", pos.Values, ev.Done)
+	}
+	if ep := env.CounterCur(ev, env.Sequence); ep != 1 || !env.CounterChg(ev, env.Sequence) {
+		t.Errorf("Episode counter: %d after Done", ep)
+	}
+	if fs.Run != 2 {
+		t.Errorf("fake sim Run: %d != 2", fs.Run)
+	}
+	if ev.State("NotThere") != nil {
+		t.Errorf("State for unknown element is not nil")
+	}
+	ev.Close()
+}
+
+func TestSocketEnvUnix(t *testing.T) {
+	addr, cleanup := tempSock(t)
+	defer cleanup()
+	fs := &fakeSim{NPos: 4}
+	ln := listenFake(t, fs, "unix", addr)
+	defer ln.Close()
+	ev := &SocketEnv{Nm: "Unix", Addr: addr}
+	ev.Defaults()
+	walk(t, ev, fs)
+	if len(fs.Moves) != fs.NPos-1 {
+		t.Errorf("fake sim received %d moves != %d", len(fs.Moves), fs.NPos-1)
+	}
+}
+
+func TestSocketEnvTCP(t *testing.T) {
+	fs := &fakeSim{NPos: 3}
+	ln := listenFake(t, fs, "tcp", "127.0.0.1:0")
+	defer ln.Close()
+	ev := &SocketEnv{Nm: "TCP", Addr: ln.Addr().String()}
+	ev.Defaults()
+	ev.Network = "tcp"
+	walk(t, ev, fs)
+}
+
+// TestSocketEnvListen checks that the env can accept a connection from the external simulator
+func TestSocketEnvListen(t *testing.T) {
+	addr, cleanup := tempSock(t)
+	defer cleanup()
+	fs := &fakeSim{NPos: 3}
+	go func() {
+		for i := 0; i < 100; i++ {
+			conn, err := net.Dial("unix", addr)
+			if err == nil {
+				fs.Serve(conn)
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	ev := &SocketEnv{Nm: "Listen", Addr: addr, Listen: true}
+	ev.Defaults()
+	walk(t, ev, fs)
+}
+
+// TestSocketEnvAsync checks that observations are accumulated when not in Lockstep mode
+func TestSocketEnvAsync(t *testing.T) {
+	addr, cleanup := tempSock(t)
+	defer cleanup()
+	ln, err := net.Listen("unix", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	fs := &fakeSim{NPos: 5}
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		sc := NewSockConn(conn)
+		defer sc.Close()
+		sc.Recv() // reset
+		for fs.Pos = 1; fs.Pos < 4; fs.Pos++ {
+			sc.Send(fs.obs(1, fs.Pos == 2))
+		}
+		sc.Recv() // wait for close
+	}()
+	ev := &SocketEnv{Nm: "Async", Addr: addr}
+	ev.Defaults()
+	ev.Lockstep = false
+	ev.Init(0)
+	if ev.Err != nil {
+		t.Fatal(ev.Err)
+	}
+	for i := 0; i < 100; i++ { // wait for all observations to be received
+		ev.mu.Lock()
+		rew := float32(0)
+		if ev.pending != nil {
+			rew = ev.pending.Reward
+		}
+		ev.mu.Unlock()
+		if rew == 3 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !ev.Step() {
+		t.Fatal(ev.Err)
+	}
+	if pos := ev.State("Pos").(*etensor.Float32); pos.Values[3] != 1 {
+		t.Errorf("Pos: %v is not the latest observation", pos.Values)
+	}
+	if ev.Reward != 3 || !ev.Done {
+		t.Errorf("Reward: %g != 3 or Done: %v not accumulated", ev.Reward, ev.Done)
+	}
+	ev.Close()
+}
+
+// TestSocketEnvAsyncReconnect checks that a new session after Close is not
+// affected by the read loop of the previous one, when not in Lockstep mode
+func TestSocketEnvAsyncReconnect(t *testing.T) {
+	addr, cleanup := tempSock(t)
+	defer cleanup()
+	ln, err := net.Listen("unix", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	fs := &fakeSim{NPos: 3}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go fs.Serve(conn)
+		}
+	}()
+	ev := &SocketEnv{Nm: "AsyncReconnect", Addr: addr}
+	ev.Defaults()
+	ev.Lockstep = false
+	for sess := 0; sess < 3; sess++ {
+		ev.Init(sess)
+		if !ev.Step() {
+			t.Fatalf("session %d first Step failed: %v", sess, ev.Err)
+		}
+		if pos := ev.State("Pos").(*etensor.Float32); pos.Values[0] != 1 {
+			t.Errorf("session %d Pos: %v is not the reset observation", sess, pos.Values)
+		}
+		if err := ev.Close(); err != nil {
+			t.Errorf("session %d Close: %v", sess, err)
+		}
+	}
+}
+
+func TestSendActions(t *testing.T) {
+	net := &leabra.Network{}
+	net.InitName(net, "SendActions")
+	ly := net.AddLayer2D("Move", 1, 2, emer.Hidden).(*leabra.Layer)
+	net.Defaults()
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	ly.Neurons[0].ActM = 0.2
+	ly.Neurons[1].ActM = 0.9
+	ev := &SocketEnv{ActLays: []string{"Move"}}
+	ev.Defaults()
+	if err := ev.SendActions(net); err != nil {
+		t.Fatal(err)
+	}
+	mv := ev.actions["Move"]
+	if len(mv.Shape) != 2 || mv.Shape[1] != 2 || mv.Values[0] != 0.2 || mv.Values[1] != 0.9 {
+		t.Errorf("Move action: %v %v", mv.Shape, mv.Values)
+	}
+	ev.ActLays = []string{"NoLayer"}
+	if err := ev.SendActions(net); err == nil {
+		t.Errorf("no error for missing layer")
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envs
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/emer/etable/etensor"
+)
+
+// SocketEnv protocol message types
+const (
+	// SockReset is sent by the model to start a new run, with the Run number.
+	// The external simulator replies with the initial SockObs.
+	SockReset = "reset"
+
+	// SockStep is sent by the model with the Actions taken on the current observation.
+	// In lockstep mode, the external simulator replies with the next SockObs.
+	SockStep = "step"
+
+	// SockObs is sent by the external simulator with the observation Obs tensors,
+	// the Reward for the previous action, and Done if the episode is over
+	SockObs = "obs"
+
+	// SockClose is sent by the model when it is done, before closing the connection
+	SockClose = "close"
+)
+
+// SockTensor is the encoding of a tensor in the SocketEnv protocol:
+// the shape, with the outermost dimension first, and the values in row-major order.
+type SockTensor struct {
+	Shape  []int     `json:"shape"`
+	Values []float32 `json:"values"`
+}
+
+// SetFmTensor sets the shape and values from given tensor
+func (st *SockTensor) SetFmTensor(tsr etensor.Tensor) {
+	st.Shape = append(st.Shape[:0], tsr.Shapes()...)
+	n := tsr.Len()
+	if cap(st.Values) < n {
+		st.Values = make([]float32, n)
+	}
+	st.Values = st.Values[:n]
+	for i := range st.Values {
+		st.Values[i] = float32(tsr.FloatVal1D(i))
+	}
+}
+
+// ToTensor sets given tensor to the shape and values, returning an error
+// if the number of values does not match the shape
+func (st *SockTensor) ToTensor(tsr *etensor.Float32) error {
+	n := 1
+	for _, d := range st.Shape {
+		n *= d
+	}
+	if n != len(st.Values) {
+		return fmt.Errorf("SockTensor: shape: %v does not match number of values: %d", st.Shape, len(st.Values))
+	}
+	tsr.SetShape(st.Shape, nil, nil)
+	copy(tsr.Values, st.Values)
+	return nil
+}
+
+// SockMsg is one message in the SocketEnv protocol, which is sent as one
+// line of JSON.  Type is one of SockReset, SockStep, SockObs or SockClose,
+// and determines which of the other fields are used.
+type SockMsg struct {
+	Type    string                 `json:"type"`
+	Run     int                    `json:"run,omitempty"`
+	Actions map[string]*SockTensor `json:"actions,omitempty"`
+	Obs     map[string]*SockTensor `json:"obs,omitempty"`
+	Reward  float32                `json:"reward,omitempty"`
+	Done    bool                   `json:"done,omitempty"`
+}
+
+// SockConn sends and receives SockMsg messages over a connection
+type SockConn struct {
+	Conn net.Conn
+	enc  *json.Encoder
+	dec  *json.Decoder
+}
+
+// NewSockConn returns a new SockConn for given connection
+func NewSockConn(conn net.Conn) *SockConn {
+	sc := &SockConn{Conn: conn}
+	sc.enc = json.NewEncoder(conn) // Encode writes a newline after each message
+	sc.dec = json.NewDecoder(bufio.NewReader(conn))
+	return sc
+}
+
+// Send sends given message
+func (sc *SockConn) Send(msg *SockMsg) error {
+	return sc.enc.Encode(msg)
+}
+
+// Recv receives the next message, blocking until it arrives
+func (sc *SockConn) Recv() (*SockMsg, error) {
+	msg := &SockMsg{}
+	err := sc.dec.Decode(msg)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// Close closes the connection
+func (sc *SockConn) Close() error {
+	return sc.Conn.Close()
+}
@@ -0,0 +1,622 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// agate runs a headless demo of agate NMDA-maintained working memory with
+// output-gating driven pulse clearing, on a store / ignore / recall task.
+// Each item is held in its own stripe of the Mnt MaintLayer by recurrent
+// NMDA currents, and is produced by the Out OutLayer on Recall, which then
+// clears Mnt via OutLayer.PulseClear, putting it into a GABA-B refractory state.
+// The basal ganglia gating is hardwired here: the Sim turns on the Input -> Mnt
+// projection on Store trials, and the Mnt -> Out projection on Recall trials,
+// standing in for the BG / VThal disinhibition that would be learned in a full model.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+
+	"github.com/ccnlab/leabrax/agate"
+	"github.com/ccnlab/leabrax/glong"
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/env"
+	"github.com/emer/emergent/params"
+	"github.com/emer/emergent/prjn"
+	"github.com/emer/emergent/relpos"
+	"github.com/emer/etable/etable"
+	"github.com/emer/etable/etensor"
+)
+
+func main() {
+	TheSim.New()
+	TheSim.Config()
+	TheSim.CmdArgs()
+}
+
+// ParamSets is the default set of parameters -- Base is always applied, and others can be optionally
+// selected to apply on top of that
+var ParamSets = params.Sets{
+	{Name: "Base", Desc: "these are the best params", Sheets: params.Sheets{
+		"Network": &params.Sheet{
+			{Sel: "#Mnt", Desc: "stronger NMDA for robust maintenance in small stripes",
+				Params: params.Params{
+					"Layer.NMDA.Gbar": "0.04",
+				}},
+			{Sel: "#MntToMnt", Desc: "recurrent NMDA maintenance projection",
+				Params: params.Params{
+					"Prjn.WtScale.Abs": "2",
+				}},
+		},
+	}},
+	{Name: "NoNMDA", Desc: "lesion the NMDA maintenance currents", Sheets: params.Sheets{
+		"Network": &params.Sheet{
+			{Sel: "#Mnt", Desc: "no NMDA",
+				Params: params.Params{
+					"Layer.NMDA.Gbar": "0",
+				}},
+		},
+	}},
+	{Name: "NoClear", Desc: "Out never clears Mnt", Sheets: params.Sheets{
+		"Network": &params.Sheet{
+			{Sel: "#Out", Desc: "threshold can never be reached",
+				Params: params.Params{
+					"Layer.Out.ResetThr": "2",
+				}},
+		},
+	}},
+}
+
+// Sim encapsulates the entire simulation model, and we define all the
+// functionality as methods on this struct.  This structure keeps all relevant
+// state information organized and available without having to pass everything around
+// as arguments to methods, and provides the core GUI interface (note the view tags
+// for the fields which provide hints to how things should be displayed).
+type Sim struct {
+	Net          *agate.Network `desc:"the network -- click to view / edit parameters for layers, prjns, etc"`
+	Params       params.Sets    `desc:"full collection of param sets"`
+	ParamSet     string         `desc:"which set of *additional* parameters to use -- always applies Base and optionaly this next if set -- can use multiple names separated by spaces (don't put spaces in ParamSet names!)"`
+	Tag          string         `desc:"extra tag string to add to any file names output from sim (e.g., weights files, log files, params for run)"`
+	NItems       int            `desc:"number of items, each with its own stripe"`
+	NUnitsY      int            `desc:"number of units per stripe in Y dim"`
+	NUnitsX      int            `desc:"number of units per stripe in X dim"`
+	ActThr       float32        `desc:"threshold on stripe max activation for counting an item as held in Mnt or produced by Out"`
+	MaxRuns      int            `desc:"maximum number of model runs to perform"`
+	MaxEpcs      int            `desc:"maximum number of epochs to run per model run"`
+	RndSeed      int64          `desc:"the current random seed"`
+	TrainEnv     StoreRecallEnv `desc:"Training environment -- contains everything about iterating over input / output patterns over training"`
+	Time         leabra.Time    `desc:"leabra timing parameters and state"`
+	TrnTrlLog    *etable.Table  `view:"no-inline" desc:"trial-level log data"`
+	TrnEpcLog    *etable.Table  `view:"no-inline" desc:"epoch-level log data"`
+	LogSetParams bool           `view:"-" desc:"if true, print message for all params that are set"`
+
+	// statistics: note use float64 as that is best for etable.Table
+	TrlMnt       int     `inactive:"+" desc:"item held in Mnt at the end of the current trial (stripe with max activation above ActThr), -1 if none"`
+	TrlNMnt      int     `inactive:"+" desc:"number of Mnt stripes active above ActThr at the end of the current trial"`
+	TrlOut       int     `inactive:"+" desc:"item produced by Out on the current trial (stripe with max AlphaMax above ActThr), -1 if none"`
+	TrlCor       float64 `inactive:"+" desc:"1 if the current trial was correct: Mnt holds only the Stored item on Store and Ignore trials, and on Recall trials Out produces the Target and Mnt is cleared"`
+	EpcPctCor    float64 `inactive:"+" desc:"last epoch's proportion of trials correct"`
+	EpcPctMaint  float64 `inactive:"+" desc:"last epoch's proportion of Ignore trials correct -- maintenance through distractors"`
+	EpcPctRecall float64 `inactive:"+" desc:"last epoch's proportion of Recall trials correct"`
+	SumCor       float64 `view:"-" inactive:"+" desc:"sum to increment as we go through epoch"`
+	SumMaint     float64 `view:"-" inactive:"+" desc:"sum to increment as we go through epoch"`
+	NMaint       float64 `view:"-" inactive:"+" desc:"count to increment as we go through epoch"`
+	SumRecall    float64 `view:"-" inactive:"+" desc:"sum to increment as we go through epoch"`
+	NRecall      float64 `view:"-" inactive:"+" desc:"count to increment as we go through epoch"`
+
+	// internal state - view:"-"
+	StopNow    bool     `view:"-" desc:"flag to stop running"`
+	TrnEpcFile *os.File `view:"-" desc:"log file"`
+}
+
+// TheSim is the overall state for this simulation
+var TheSim Sim
+
+// New creates new blank elements and initializes defaults
+func (ss *Sim) New() {
+	ss.Net = &agate.Network{}
+	ss.TrnTrlLog = &etable.Table{}
+	ss.TrnEpcLog = &etable.Table{}
+	ss.Params = ParamSets
+	ss.NItems = 3
+	ss.NUnitsY = 2
+	ss.NUnitsX = 2
+	ss.ActThr = 0.5
+	ss.RndSeed = 1
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////
+// 		Configs
+
+// Config configures all the elements using the standard functions
+func (ss *Sim) Config() {
+	ss.ConfigEnv()
+	ss.ConfigNet(ss.Net)
+	ss.ConfigTrnTrlLog(ss.TrnTrlLog)
+	ss.ConfigTrnEpcLog(ss.TrnEpcLog)
+}
+
+func (ss *Sim) ConfigEnv() {
+	if ss.MaxRuns == 0 { // allow user override
+		ss.MaxRuns = 1
+	}
+	if ss.MaxEpcs == 0 { // allow user override
+		ss.MaxEpcs = 5
+	}
+
+	ss.TrainEnv.Nm = "TrainEnv"
+	ss.TrainEnv.Dsc = "store / ignore / recall task params and state"
+	ss.TrainEnv.Config(ss.NItems, ss.NUnitsY, ss.NUnitsX, 20)
+	ss.TrainEnv.Validate()
+	ss.TrainEnv.Run.Max = ss.MaxRuns // note: we are not setting epoch max -- do that manually
+
+	ss.TrainEnv.Init(0)
+}
+
+func (ss *Sim) ConfigNet(net *agate.Network) {
+	net.InitName(net, "AGate")
+	lnet := &net.Network.Network
+	ni := ss.NItems
+
+	inp := net.AddLayer4D("Input", 1, ni, ss.NUnitsY, ss.NUnitsX, emer.Input)
+	mnt := agate.AddMaintLayer(lnet, "Mnt", 1, ni, ss.NUnitsY, ss.NUnitsX)
+	out := agate.AddOutLayer(lnet, "Out", 1, ni, ss.NUnitsY, ss.NUnitsX)
+	mnt.SetRelPos(relpos.Rel{Rel: relpos.Above, Other: inp.Name(), YAlign: relpos.Front, XAlign: relpos.Left})
+	out.SetRelPos(relpos.Rel{Rel: relpos.RightOf, Other: mnt.Name(), YAlign: relpos.Front, Space: 2})
+
+	one2one := prjn.NewOneToOne()
+	pj := net.ConnectLayers(inp, mnt, one2one, emer.Forward)
+	pj.SetClass("InputToMnt")
+	pj = net.ConnectLayers(mnt, out, one2one, emer.Forward)
+	pj.SetClass("MntToOut")
+	mnt.InterInhib.Lays.Add(out.Name())
+	out.Out.ClearLays.Add(mnt.Name())
+
+	net.Defaults()
+	ss.SetParams("Network", ss.LogSetParams) // only set Network params
+	err := net.Build()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	net.InitWts()
+	ss.Gate(Ignore)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 	    Init, utils
+
+// Init restarts the run, and initializes everything, including network weights
+// and resets the epoch log table
+func (ss *Sim) Init() {
+	rand.Seed(ss.RndSeed)
+	ss.ConfigEnv()
+	ss.StopNow = false
+	ss.SetParams("", ss.LogSetParams) // all sheets
+	ss.NewRun()
+}
+
+// Counters returns a string of the current counter state
+func (ss *Sim) Counters() string {
+	return fmt.Sprintf("Run:\t%d\tEpoch:\t%d\tTrial:\t%d\tCycle:\t%d\tName:\t%v\t\t\t", ss.TrainEnv.Run.Cur, ss.TrainEnv.Epoch.Cur, ss.TrainEnv.Trial.Cur, ss.Time.Cycle, ss.TrainEnv.String())
+}
+
+// MntLay returns the Mnt MaintLayer
+func (ss *Sim) MntLay() *agate.MaintLayer {
+	return ss.Net.LayerByName("Mnt").(*agate.MaintLayer)
+}
+
+// OutLay returns the Out OutLayer
+func (ss *Sim) OutLay() *agate.OutLayer {
+	return ss.Net.LayerByName("Out").(*agate.OutLayer)
+}
+
+// StripeAlphaMax returns the max AlphaMax over the units in given stripe of layer
+func StripeAlphaMax(ly *glong.Layer, stripe int) float32 {
+	pl := &ly.Pools[stripe+1]
+	mx := float32(0)
+	for ni := pl.StIdx; ni < pl.EdIdx; ni++ {
+		if am := ly.GlNeurs[ni].AlphaMax; am > mx {
+			mx = am
+		}
+	}
+	return mx
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 	    Running the Network, starting bottom-up..
+
+// Gate sets the hardwired BG gating for given instruction:
+// the Input -> Mnt projection is on only for Store (input gating),
+// and the Mnt -> Out projection only for Recall (output gating).
+func (ss *Sim) Gate(instr Instrs) {
+	ss.Net.LayerByName("Mnt").RecvPrjns().SendName("Input").SetOff(instr != Store)
+	ss.Net.LayerByName("Out").RecvPrjns().SendName("Mnt").SetOff(instr != Recall)
+}
+
+// AlphaCyc runs one alpha-cycle (100 msec, 4 quarters) of processing.
+// External inputs must have already been applied prior to calling,
+// using ApplyInputs method.  There is no learning in this model.
+func (ss *Sim) AlphaCyc() {
+	ss.Net.AlphaCycInit()
+	ss.Time.AlphaCycStart()
+	for qtr := 0; qtr < 4; qtr++ {
+		for cyc := 0; cyc < ss.Time.CycPerQtr; cyc++ {
+			ss.Net.Cycle(&ss.Time)
+			ss.Time.CycleInc()
+		}
+		ss.Net.QuarterFinal(&ss.Time)
+		ss.Time.QuarterInc()
+	}
+}
+
+// ApplyInputs applies the current env Input pattern to the Input layer,
+// and sets the gating for the current instruction.  Unlike most models,
+// activations are NOT initialized, as Mnt must maintain across trials.
+func (ss *Sim) ApplyInputs(en *StoreRecallEnv) {
+	inp := ss.Net.LayerByName("Input").(leabra.LeabraLayer).AsLeabra()
+	inp.ApplyExt(&en.Input)
+	ss.Gate(en.Instr)
+}
+
+// TrainTrial runs one trial of the task, and updates the stats.
+func (ss *Sim) TrainTrial() {
+	ss.TrainEnv.Step() // the Env encapsulates and manages all counter state
+
+	// Key to query counters FIRST because current state is in NEXT epoch
+	// if epoch counter has changed
+	epc, _, chg := ss.TrainEnv.Counter(env.Epoch)
+	if chg {
+		ss.LogTrnEpc(ss.TrnEpcLog)
+		if epc >= ss.MaxEpcs { // done with training..
+			ss.RunEnd()
+			if ss.TrainEnv.Run.Incr() { // we are done!
+				ss.StopNow = true
+				return
+			}
+			ss.NewRun()
+			return
+		}
+	}
+
+	ss.ApplyInputs(&ss.TrainEnv)
+	ss.AlphaCyc()
+	ss.TrialStats(true)
+	ss.LogTrnTrl(ss.TrnTrlLog)
+}
+
+// RunEnd is called at the end of a run -- save weights, record final log, etc here
+func (ss *Sim) RunEnd() {
+}
+
+// NewRun intializes a new run of the model, using the TrainEnv.Run counter
+// for the new run value
+func (ss *Sim) NewRun() {
+	run := ss.TrainEnv.Run.Cur
+	ss.TrainEnv.Init(run)
+	ss.Time.Reset()
+	ss.Net.InitWts()
+	ss.Net.InitActs()
+	ss.InitStats()
+	ss.TrnTrlLog.SetNumRows(0)
+	ss.TrnEpcLog.SetNumRows(0)
+}
+
+// InitStats initializes all the statistics, especially important for the
+// cumulative epoch stats -- called at start of new run
+func (ss *Sim) InitStats() {
+	ss.SumCor = 0
+	ss.SumMaint = 0
+	ss.NMaint = 0
+	ss.SumRecall = 0
+	ss.NRecall = 0
+	ss.TrlMnt = -1
+	ss.TrlNMnt = 0
+	ss.TrlOut = -1
+	ss.EpcPctCor = 0
+	ss.EpcPctMaint = 0
+	ss.EpcPctRecall = 0
+}
+
+// StripeStats returns the most active stripe (-1 if none above ActThr)
+// and the number of stripes above ActThr, for given stripe activations.
+func (ss *Sim) StripeStats(acts []float32) (max, n int) {
+	max = -1
+	for si, act := range acts {
+		if act <= ss.ActThr {
+			continue
+		}
+		n++
+		if max < 0 || act > acts[max] {
+			max = si
+		}
+	}
+	return
+}
+
+// TrialStats computes the trial-level statistics and adds them to the epoch accumulators if
+// accum is true.
+func (ss *Sim) TrialStats(accum bool) {
+	mnt := ss.MntLay()
+	out := ss.OutLay()
+	macts := make([]float32, ss.NItems)
+	oacts := make([]float32, ss.NItems)
+	for si := 0; si < ss.NItems; si++ {
+		macts[si] = mnt.Pools[si+1].Inhib.Act.Max
+		oacts[si] = StripeAlphaMax(&out.Layer, si)
+	}
+	ss.TrlMnt, ss.TrlNMnt = ss.StripeStats(macts)
+	ss.TrlOut, _ = ss.StripeStats(oacts)
+
+	ev := &ss.TrainEnv
+	cor := false
+	switch ev.Instr {
+	case Store, Ignore:
+		cor = ss.TrlNMnt == 1 && ss.TrlMnt == ev.Stored && ss.TrlOut < 0
+	case Recall:
+		cor = ss.TrlNMnt == 0 && ss.TrlOut == ev.Target
+	}
+	ss.TrlCor = 0
+	if cor {
+		ss.TrlCor = 1
+	}
+	if !accum {
+		return
+	}
+	ss.SumCor += ss.TrlCor
+	switch ev.Instr {
+	case Ignore:
+		ss.SumMaint += ss.TrlCor
+		ss.NMaint++
+	case Recall:
+		ss.SumRecall += ss.TrlCor
+		ss.NRecall++
+	}
+}
+
+// TrainEpoch runs trials for remainder of this epoch
+func (ss *Sim) TrainEpoch() {
+	curEpc := ss.TrainEnv.Epoch.Cur
+	for {
+		ss.TrainTrial()
+		if ss.TrainEnv.Epoch.Cur != curEpc {
+			break
+		}
+	}
+}
+
+// TrainRun runs trials for remainder of run
+func (ss *Sim) TrainRun() {
+	curRun := ss.TrainEnv.Run.Cur
+	for {
+		ss.TrainTrial()
+		if ss.TrainEnv.Run.Cur != curRun {
+			break
+		}
+	}
+}
+
+// Train runs the full set of runs from this point onward
+func (ss *Sim) Train() {
+	ss.StopNow = false
+	for {
+		ss.TrainTrial()
+		if ss.StopNow {
+			break
+		}
+	}
+}
+
+/////////////////////////////////////////////////////////////////////////
+//   Params setting
+
+// ParamsName returns name of current set of parameters
+func (ss *Sim) ParamsName() string {
+	if ss.ParamSet == "" {
+		return "Base"
+	}
+	return ss.ParamSet
+}
+
+// SetParams sets the params for "Base" and then current ParamSet.
+// If sheet is empty, then it applies all avail sheets (e.g., Network, Sim)
+// otherwise just the named sheet
+// if setMsg = true then we output a message for each param that was set.
+func (ss *Sim) SetParams(sheet string, setMsg bool) error {
+	if sheet == "" {
+		// this is important for catching typos and ensuring that all sheets can be used
+		ss.Params.ValidateSheets([]string{"Network", "Sim"})
+	}
+	err := ss.SetParamsSet("Base", sheet, setMsg)
+	if ss.ParamSet != "" && ss.ParamSet != "Base" {
+		err = ss.SetParamsSet(ss.ParamSet, sheet, setMsg)
+	}
+	return err
+}
+
+// SetParamsSet sets the params for given params.Set name.
+// If sheet is empty, then it applies all avail sheets (e.g., Network, Sim)
+// otherwise just the named sheet
+// if setMsg = true then we output a message for each param that was set.
+func (ss *Sim) SetParamsSet(setNm string, sheet string, setMsg bool) error {
+	pset, err := ss.Params.SetByNameTry(setNm)
+	if err != nil {
+		return err
+	}
+	if sheet == "" || sheet == "Network" {
+		netp, ok := pset.Sheets["Network"]
+		if ok {
+			ss.Net.ApplyParams(netp, setMsg)
+		}
+	}
+
+	if sheet == "" || sheet == "Sim" {
+		simp, ok := pset.Sheets["Sim"]
+		if ok {
+			simp.Apply(ss, setMsg)
+		}
+	}
+	return err
+}
+
+//////////////////////////////////////////////
+//  TrnTrlLog
+
+// LogTrnTrl adds data from current trial to the TrnTrlLog table.
+func (ss *Sim) LogTrnTrl(dt *etable.Table) {
+	row := dt.Rows
+	dt.SetNumRows(row + 1)
+
+	ev := &ss.TrainEnv
+	dt.SetCellFloat("Run", row, float64(ev.Run.Cur))
+	dt.SetCellFloat("Epoch", row, float64(ev.Epoch.Cur))
+	dt.SetCellFloat("Trial", row, float64(ev.Trial.Cur))
+	dt.SetCellString("Instr", row, ev.Instr.String())
+	dt.SetCellFloat("Item", row, float64(ev.Item))
+	dt.SetCellFloat("Stored", row, float64(ev.Stored))
+	dt.SetCellFloat("Target", row, float64(ev.Target))
+	dt.SetCellFloat("Mnt", row, float64(ss.TrlMnt))
+	dt.SetCellFloat("NMnt", row, float64(ss.TrlNMnt))
+	dt.SetCellFloat("Out", row, float64(ss.TrlOut))
+	dt.SetCellFloat("Cor", row, ss.TrlCor)
+}
+
+func (ss *Sim) ConfigTrnTrlLog(dt *etable.Table) {
+	dt.SetMetaData("name", "TrnTrlLog")
+	dt.SetMetaData("desc", "Record of working memory state over trials")
+	dt.SetMetaData("read-only", "true")
+	dt.SetMetaData("precision", "4")
+
+	sch := etable.Schema{
+		{"Run", etensor.INT64, nil, nil},
+		{"Epoch", etensor.INT64, nil, nil},
+		{"Trial", etensor.INT64, nil, nil},
+		{"Instr", etensor.STRING, nil, nil},
+		{"Item", etensor.INT64, nil, nil},
+		{"Stored", etensor.INT64, nil, nil},
+		{"Target", etensor.INT64, nil, nil},
+		{"Mnt", etensor.INT64, nil, nil},
+		{"NMnt", etensor.INT64, nil, nil},
+		{"Out", etensor.INT64, nil, nil},
+		{"Cor", etensor.FLOAT64, nil, nil},
+	}
+	dt.SetFromSchema(sch, 0)
+}
+
+//////////////////////////////////////////////
+//  TrnEpcLog
+
+// RunName returns a name for this run that combines Tag and Params -- add this to
+// any file names that are saved.
+func (ss *Sim) RunName() string {
+	if ss.Tag != "" {
+		return ss.Tag + "_" + ss.ParamsName()
+	}
+	return ss.ParamsName()
+}
+
+// LogFileName returns default log file name
+func (ss *Sim) LogFileName(lognm string) string {
+	return ss.Net.Nm + "_" + ss.RunName() + "_" + lognm + ".tsv"
+}
+
+// LogTrnEpc adds data from current epoch to the TrnEpcLog table.
+// computes epoch averages prior to logging.
+func (ss *Sim) LogTrnEpc(dt *etable.Table) {
+	row := dt.Rows
+	dt.SetNumRows(row + 1)
+
+	epc := ss.TrainEnv.Epoch.Prv         // this is triggered by increment so use previous value
+	nt := float64(ss.TrainEnv.Trial.Max) // number of trials in view
+
+	ss.EpcPctCor = ss.SumCor / nt
+	ss.EpcPctMaint = 0
+	if ss.NMaint > 0 {
+		ss.EpcPctMaint = ss.SumMaint / ss.NMaint
+	}
+	ss.EpcPctRecall = 0
+	if ss.NRecall > 0 {
+		ss.EpcPctRecall = ss.SumRecall / ss.NRecall
+	}
+	ss.SumCor = 0
+	ss.SumMaint = 0
+	ss.NMaint = 0
+	ss.SumRecall = 0
+	ss.NRecall = 0
+
+	dt.SetCellFloat("Run", row, float64(ss.TrainEnv.Run.Cur))
+	dt.SetCellFloat("Epoch", row, float64(epc))
+	dt.SetCellFloat("PctCor", row, ss.EpcPctCor)
+	dt.SetCellFloat("PctMaint", row, ss.EpcPctMaint)
+	dt.SetCellFloat("PctRecall", row, ss.EpcPctRecall)
+
+	if ss.TrnEpcFile != nil {
+		if ss.TrainEnv.Run.Cur == 0 && epc == 0 {
+			dt.WriteCSVHeaders(ss.TrnEpcFile, etable.Tab)
+		}
+		dt.WriteCSVRow(ss.TrnEpcFile, row, etable.Tab)
+	}
+}
+
+func (ss *Sim) ConfigTrnEpcLog(dt *etable.Table) {
+	dt.SetMetaData("name", "TrnEpcLog")
+	dt.SetMetaData("desc", "Record of performance over epochs")
+	dt.SetMetaData("read-only", "true")
+	dt.SetMetaData("precision", "4")
+
+	sch := etable.Schema{
+		{"Run", etensor.INT64, nil, nil},
+		{"Epoch", etensor.INT64, nil, nil},
+		{"PctCor", etensor.FLOAT64, nil, nil},
+		{"PctMaint", etensor.FLOAT64, nil, nil},
+		{"PctRecall", etensor.FLOAT64, nil, nil},
+	}
+	dt.SetFromSchema(sch, 0)
+}
+
+// CmdArgs processes the command-line arguments and runs the model
+func (ss *Sim) CmdArgs() {
+	var saveEpcLog bool
+	var note string
+	flag.StringVar(&ss.ParamSet, "params", "", "ParamSet name to use -- must be valid name as listed in compiled-in params or loaded params")
+	flag.StringVar(&ss.Tag, "tag", "", "extra tag to add to file names saved from this run")
+	flag.StringVar(&note, "note", "", "user note -- describe the run params etc")
+	flag.IntVar(&ss.MaxRuns, "runs", 1, "number of runs to do")
+	flag.IntVar(&ss.MaxEpcs, "epcs", 5, "number of epochs per run")
+	flag.Int64Var(&ss.RndSeed, "seed", 1, "random seed")
+	flag.BoolVar(&ss.LogSetParams, "setparams", false, "if true, print a record of each parameter that is set")
+	flag.BoolVar(&saveEpcLog, "epclog", false, "if true, save epoch log to file")
+	flag.Parse()
+	ss.Init()
+
+	if note != "" {
+		fmt.Printf("note: %s\n", note)
+	}
+	if ss.ParamSet != "" {
+		fmt.Printf("Using ParamSet: %s\n", ss.ParamSet)
+	}
+
+	if saveEpcLog {
+		var err error
+		fnm := ss.LogFileName("epc")
+		ss.TrnEpcFile, err = os.Create(fnm)
+		if err != nil {
+			log.Println(err)
+			ss.TrnEpcFile = nil
+		} else {
+			fmt.Printf("Saving epoch log to: %s\n", fnm)
+			defer ss.TrnEpcFile.Close()
+		}
+	}
+	fmt.Printf("Running %d Runs\n", ss.MaxRuns)
+	ss.Train()
+	for row := 0; row < ss.TrnEpcLog.Rows; row++ {
+		fmt.Printf("Run: %d\tEpoch: %d\tPctCor: %.3f\tPctMaint: %.3f\tPctRecall: %.3f\n",
+			int(ss.TrnEpcLog.CellFloat("Run", row)), int(ss.TrnEpcLog.CellFloat("Epoch", row)),
+			ss.TrnEpcLog.CellFloat("PctCor", row), ss.TrnEpcLog.CellFloat("PctMaint", row),
+			ss.TrnEpcLog.CellFloat("PctRecall", row))
+	}
+}
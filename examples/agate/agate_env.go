@@ -0,0 +1,203 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/emer/emergent/env"
+	"github.com/emer/etable/etensor"
+)
+
+// Instrs are the instructions on each trial of the StoreRecallEnv
+type Instrs int
+
+const (
+	// Store the stimulus in working memory, replacing anything held there
+	Store Instrs = iota
+
+	// Ignore the stimulus, which is a distractor, and keep maintaining
+	Ignore
+
+	// Recall the stored item to the output, and clear working memory
+	Recall
+
+	InstrsN
+)
+
+var InstrNames = []string{"Store", "Ignore", "Recall"}
+
+func (it Instrs) String() string {
+	if it < 0 || it >= InstrsN {
+		return fmt.Sprintf("Instrs(%d)", int(it))
+	}
+	return InstrNames[it]
+}
+
+// StoreRecallEnv is a store / ignore / recall working memory task,
+// a simplified version of the 1-2-AX task.  Each sequence starts with a
+// Store trial presenting an item to be held in working memory, followed by
+// a random number (MinIgnore..MaxIgnore) of Ignore trials presenting other
+// (distractor) items, and ends with a Recall trial with no stimulus, on which the
+// stored item must be produced as output and then cleared from working memory.
+// Each item is represented by a separate stripe (pool) in the Input.
+type StoreRecallEnv struct {
+	Nm        string          `desc:"name of this environment"`
+	Dsc       string          `desc:"description of this environment"`
+	NItems    int             `desc:"number of distinct items, each represented by an Input stripe"`
+	NUnitsY   int             `desc:"number of units per stripe in Y dim"`
+	NUnitsX   int             `desc:"number of units per stripe in X dim"`
+	MinIgnore int             `desc:"minimum number of Ignore trials in each sequence"`
+	MaxIgnore int             `desc:"maximum number of Ignore trials in each sequence"`
+	Instr     Instrs          `desc:"instruction on the current trial"`
+	Item      int             `desc:"item presented on the current trial, -1 if none (on Recall trials)"`
+	Stored    int             `desc:"item that should be held in working memory at the end of the current trial -- -1 if none, including after Recall"`
+	Target    int             `desc:"item that should be produced as output on the current trial -- the previously Stored item on Recall trials, -1 otherwise"`
+	NIgnore   int             `desc:"number of Ignore trials in the current sequence"`
+	SeqTrial  int             `desc:"trial within the current sequence, 0 = Store"`
+	Input     etensor.Float32 `desc:"stimulus input pattern, with one stripe per item: [1, NItems, NUnitsY, NUnitsX]"`
+	Run       env.Ctr         `view:"inline" desc:"current run of model as provided during Init"`
+	Epoch     env.Ctr         `view:"inline" desc:"number of times through Trial.Max number of trials"`
+	Seq       env.Ctr         `view:"inline" desc:"sequence counter over the run -- sequences can span epochs"`
+	Trial     env.Ctr         `view:"inline" desc:"trial counter within epoch"`
+}
+
+func (ev *StoreRecallEnv) Name() string { return ev.Nm }
+func (ev *StoreRecallEnv) Desc() string { return ev.Dsc }
+
+// Config sets the number of items and units per stripe, the number of trials
+// per epoch, and configures the states
+func (ev *StoreRecallEnv) Config(nitems, nUnitsY, nUnitsX, ntrls int) {
+	ev.NItems = nitems
+	ev.NUnitsY = nUnitsY
+	ev.NUnitsX = nUnitsX
+	ev.Trial.Max = ntrls
+	if ev.MaxIgnore == 0 {
+		ev.MinIgnore = 1
+		ev.MaxIgnore = 3
+	}
+	ev.Input.SetShape([]int{1, nitems, nUnitsY, nUnitsX}, nil, []string{"PY", "PX", "NY", "NX"})
+}
+
+func (ev *StoreRecallEnv) Validate() error {
+	if ev.NItems < 2 {
+		return fmt.Errorf("StoreRecallEnv: %v has NItems < 2 -- need to Config", ev.Nm)
+	}
+	if ev.MinIgnore < 0 || ev.MaxIgnore < ev.MinIgnore {
+		return fmt.Errorf("StoreRecallEnv: %v MinIgnore: %d, MaxIgnore: %d are not a valid range", ev.Nm, ev.MinIgnore, ev.MaxIgnore)
+	}
+	return nil
+}
+
+func (ev *StoreRecallEnv) State(element string) etensor.Tensor {
+	switch element {
+	case "Input":
+		return &ev.Input
+	}
+	return nil
+}
+
+// String returns the current state as a string
+func (ev *StoreRecallEnv) String() string {
+	if ev.Instr == Recall {
+		return fmt.Sprintf("%s_%d", ev.Instr, ev.Target)
+	}
+	return fmt.Sprintf("%s_%d", ev.Instr, ev.Item)
+}
+
+// Init is called to restart environment
+func (ev *StoreRecallEnv) Init(run int) {
+	ev.Run.Scale = env.Run
+	ev.Epoch.Scale = env.Epoch
+	ev.Seq.Scale = env.Sequence
+	ev.Trial.Scale = env.Trial
+	ev.Run.Init()
+	ev.Epoch.Init()
+	ev.Seq.Init()
+	ev.Trial.Init()
+	ev.Run.Cur = run
+	ev.Trial.Cur = -1 // init state -- key so that first Step() = 0
+	ev.Seq.Cur = -1
+	ev.Instr = Recall // so first Step starts a new sequence
+	ev.Item = -1
+	ev.Stored = -1
+	ev.Target = -1
+}
+
+// SetInput sets the Input pattern to present given item (none if < 0)
+func (ev *StoreRecallEnv) SetInput(item int) {
+	ev.Input.SetZeros()
+	if item < 0 {
+		return
+	}
+	nu := ev.NUnitsY * ev.NUnitsX
+	for i := 0; i < nu; i++ {
+		ev.Input.Values[item*nu+i] = 1
+	}
+}
+
+// NextTrial advances to the next trial within the sequence,
+// starting a new one after a Recall trial.
+func (ev *StoreRecallEnv) NextTrial() {
+	if ev.Instr == Recall {
+		ev.Seq.Incr()
+		ev.SeqTrial = 0
+		ev.NIgnore = ev.MinIgnore + rand.Intn(ev.MaxIgnore-ev.MinIgnore+1)
+		ev.Instr = Store
+		ev.Item = rand.Intn(ev.NItems)
+		ev.Stored = ev.Item
+		ev.Target = -1
+		ev.SetInput(ev.Item)
+		return
+	}
+	ev.SeqTrial++
+	if ev.SeqTrial > ev.NIgnore {
+		ev.Instr = Recall
+		ev.Item = -1
+		ev.Target = ev.Stored
+		ev.Stored = -1
+		ev.SetInput(-1)
+		return
+	}
+	ev.Instr = Ignore
+	ev.Item = rand.Intn(ev.NItems - 1) // any item other than the Stored one
+	if ev.Item >= ev.Stored {
+		ev.Item++
+	}
+	ev.SetInput(ev.Item)
+}
+
+// Step is called to advance the environment state
+func (ev *StoreRecallEnv) Step() bool {
+	ev.Epoch.Same() // good idea to just reset all non-inner-most counters at start
+	ev.Seq.Same()
+	ev.NextTrial()
+	if ev.Trial.Incr() { // true if wraps around Max back to 0
+		ev.Epoch.Incr()
+	}
+	return true
+}
+
+func (ev *StoreRecallEnv) Action(element string, input etensor.Tensor) {
+	// nop
+}
+
+func (ev *StoreRecallEnv) Counter(scale env.TimeScales) (cur, prv int, chg bool) {
+	switch scale {
+	case env.Run:
+		return ev.Run.Query()
+	case env.Epoch:
+		return ev.Epoch.Query()
+	case env.Sequence:
+		return ev.Seq.Query()
+	case env.Trial:
+		return ev.Trial.Query()
+	}
+	return -1, -1, false
+}
+
+// Compile-time check that implements Env interface
+var _ env.Env = (*StoreRecallEnv)(nil)
@@ -0,0 +1,78 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+)
+
+func newTestSim(paramSet string) *Sim {
+	ss := &Sim{}
+	ss.New()
+	ss.MaxEpcs = 3
+	ss.Config()
+	ss.ParamSet = paramSet
+	ss.Init()
+	ss.Train()
+	return ss
+}
+
+// epcAvg returns the average of given TrnEpcLog column over epochs
+func epcAvg(ss *Sim, col string) float64 {
+	dt := ss.TrnEpcLog
+	sum := 0.0
+	for row := 0; row < dt.Rows; row++ {
+		sum += dt.CellFloat(col, row)
+	}
+	return sum / float64(dt.Rows)
+}
+
+// TestStoreRecall checks that items are maintained through distractors
+// and recalled, with working memory cleared after recall.
+func TestStoreRecall(t *testing.T) {
+	ss := newTestSim("")
+	if ss.TrnEpcLog.Rows != ss.MaxEpcs {
+		t.Fatalf("TrnEpcLog rows: %d != MaxEpcs: %d", ss.TrnEpcLog.Rows, ss.MaxEpcs)
+	}
+	for _, col := range []string{"PctCor", "PctMaint", "PctRecall"} {
+		if avg := epcAvg(ss, col); avg < 1 {
+			t.Errorf("%s: %g < 1", col, avg)
+		}
+	}
+	dt := ss.TrnTrlLog
+	for row := 0; row < dt.Rows; row++ {
+		if dt.CellString("Instr", row) != "Recall" {
+			continue
+		}
+		if out, tgt := dt.CellFloat("Out", row), dt.CellFloat("Target", row); out != tgt {
+			t.Errorf("row %d: Recall Out: %g != Target: %g", row, out, tgt)
+		}
+		if nm := dt.CellFloat("NMnt", row); nm != 0 {
+			t.Errorf("row %d: Mnt not cleared after Recall: %g stripes active", row, nm)
+		}
+	}
+}
+
+// TestNoNMDA checks that maintenance depends on the NMDA currents.
+func TestNoNMDA(t *testing.T) {
+	ss := newTestSim("NoNMDA")
+	if avg := epcAvg(ss, "PctMaint"); avg > 0 {
+		t.Errorf("PctMaint without NMDA: %g > 0", avg)
+	}
+	if avg := epcAvg(ss, "PctRecall"); avg > 0 {
+		t.Errorf("PctRecall without NMDA: %g > 0", avg)
+	}
+}
+
+// TestNoClear checks that recall depends on Out clearing Mnt.
+func TestNoClear(t *testing.T) {
+	ss := newTestSim("NoClear")
+	if avg := epcAvg(ss, "PctMaint"); avg > 0.9 {
+		t.Errorf("PctMaint without clearing: %g > 0.9 -- items should accumulate across sequences", avg)
+	}
+	if avg := epcAvg(ss, "PctRecall"); avg > 0 {
+		t.Errorf("PctRecall without clearing: %g > 0", avg)
+	}
+}
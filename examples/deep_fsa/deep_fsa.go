@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/ccnlab/leabrax/deep"
+	"github.com/ccnlab/leabrax/envs"
 	"github.com/ccnlab/leabrax/leabra"
 	"github.com/emer/emergent/emer"
 	"github.com/emer/emergent/env"
@@ -39,7 +40,9 @@ import (
 
 func main() {
 	TheSim.New()
-	TheSim.Config()
+	if err := TheSim.Config(); err != nil {
+		log.Fatalln(err)
+	}
 	if len(os.Args) > 1 {
 		TheSim.CmdArgs() // simple assumption is that any args = no gui -- could add explicit arg if you want
 	} else {
@@ -139,12 +142,6 @@ var ParamSets = params.Sets{
 	}},
 }
 
-// InputNames are names of input letters
-var InputNames = []string{"B", "T", "S", "X", "V", "P", "E"}
-
-// InputNameMap has indexes of InputNames
-var InputNameMap map[string]int
-
 // Sim encapsulates the entire simulation model, and we define all the
 // functionality as methods on this struct.  This structure keeps all relevant
 // state information organized and available without having to pass everything around
@@ -166,8 +163,9 @@ type Sim struct {
 	MaxRuns      int               `desc:"maximum number of model runs to perform"`
 	MaxEpcs      int               `desc:"maximum number of epochs to run per model run"`
 	NZeroStop    int               `desc:"if a positive number, training will stop after this many epochs with zero SSE"`
-	TrainEnv     FSAEnv            `desc:"Training environment -- contains everything about iterating over input / output patterns over training"`
-	TestEnv      FSAEnv            `desc:"Testing environment -- manages iterating over testing"`
+	Grammar      string            `desc:"if set, the grammar is loaded from this JSON or TSV grammar spec file (see envs.FSASpec), instead of using the standard Reber grammar"`
+	TrainEnv     envs.FSAEnv       `desc:"Training environment -- contains everything about iterating over input / output patterns over training"`
+	TestEnv      envs.FSAEnv       `desc:"Testing environment -- manages iterating over testing"`
 	Time         leabra.Time       `desc:"leabra timing parameters and state"`
 	ViewOn       bool              `desc:"whether to update the network view while running"`
 	TrainUpdt    leabra.TimeScales `desc:"at what time scale to update the display during training?  Anything longer than Epoch updates at Epoch in this model"`
@@ -238,29 +236,27 @@ func (ss *Sim) New() {
 	ss.TestUpdt = leabra.Cycle
 	ss.TestInterval = 500
 	ss.LayStatNms = []string{"HiddenP", "Hidden"}
-	if InputNameMap == nil {
-		InputNameMap = make(map[string]int, len(InputNames))
-		for i, nm := range InputNames {
-			InputNameMap[nm] = i
-		}
-	}
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////
 // 		Configs
 
-// Config configures all the elements using the standard functions
-func (ss *Sim) Config() {
-	ss.ConfigEnv()
+// Config configures all the elements using the standard functions.
+// Returns an error if the environments could not be configured.
+func (ss *Sim) Config() error {
+	if err := ss.ConfigEnv(); err != nil {
+		return err
+	}
 	ss.ConfigNet(ss.Net)
 	ss.ConfigTrnEpcLog(ss.TrnEpcLog)
 	ss.ConfigTstEpcLog(ss.TstEpcLog)
 	ss.ConfigTstTrlLog(ss.TstTrlLog)
 	ss.ConfigTstCycLog(ss.TstCycLog)
 	ss.ConfigRunLog(ss.RunLog)
+	return nil
 }
 
-func (ss *Sim) ConfigEnv() {
+func (ss *Sim) ConfigEnv() error {
 	if ss.MaxRuns == 0 { // allow user override
 		ss.MaxRuns = 10
 	}
@@ -272,29 +268,47 @@ func (ss *Sim) ConfigEnv() {
 	ss.TrainEnv.Nm = "TrainEnv"
 	ss.TrainEnv.Dsc = "training params and state"
 	ss.TrainEnv.Seq.Max = 25 // 25 sequences per epoch training
-	ss.TrainEnv.TMatReber()
+	if err := ss.ConfigGrammar(&ss.TrainEnv); err != nil {
+		return err
+	}
 	ss.TrainEnv.Validate()
 	ss.TrainEnv.Run.Max = ss.MaxRuns // note: we are not setting epoch max -- do that manually
 
 	ss.TestEnv.Nm = "TestEnv"
 	ss.TestEnv.Dsc = "testing params and state"
 	ss.TestEnv.Seq.Max = 10
-	ss.TestEnv.TMatReber() // todo: random
+	if err := ss.ConfigGrammar(&ss.TestEnv); err != nil { // todo: random
+		return err
+	}
 	ss.TestEnv.Validate()
 
 	ss.TrainEnv.Init(0)
 	ss.TestEnv.Init(0)
+	return nil
+}
+
+// ConfigGrammar configures the grammar for given env, from the Grammar
+// file if set, otherwise the standard Reber grammar.  An error opening
+// the Grammar file is returned, not replaced by the Reber grammar,
+// as the network layers are sized from the grammar labels.
+func (ss *Sim) ConfigGrammar(ev *envs.FSAEnv) error {
+	if ss.Grammar == "" {
+		ev.TMatReber()
+		return nil
+	}
+	return ev.OpenSpec(ss.Grammar)
 }
 
 func (ss *Sim) ConfigNet(net *deep.Network) {
 	net.InitName(net, "DeepFSA")
-	in := net.AddLayer2D("Input", 1, 7, emer.Input)
+	nlbl := len(ss.TrainEnv.Spec.Labels)
+	in := net.AddLayer2D("Input", 1, nlbl, emer.Input)
 	hid, hidct, hidp := net.AddDeep2D("Hidden", 8, 8)
 
 	hidp.Shape().CopyShape(in.Shape())
 	hidp.(*deep.TRCLayer).Drivers.Add("Input")
 
-	trg := net.AddLayer2D("Targets", 1, 7, emer.Input) // just for visualization
+	trg := net.AddLayer2D("Targets", 1, nlbl, emer.Input) // just for visualization
 
 	in.SetClass("Input")
 	hidp.SetClass("Input")
@@ -445,30 +459,12 @@ func (ss *Sim) ApplyInputs(en env.Env) {
 	ss.Net.InitExt() // clear any existing inputs -- not strictly necessary if always
 	// going to the same layers, but good practice and cheap anyway
 
-	// just using direct access to state here
+	// Input is the label of the current transition, and Targets are all
+	// the valid labels that could have been predicted
 	in := ss.Net.LayerByName("Input").(leabra.LeabraLayer).AsLeabra()
 	trg := ss.Net.LayerByName("Targets").(leabra.LeabraLayer).AsLeabra()
-	clrmsk, setmsk, _ := in.ApplyExtFlags()
-	fsenv := en.(*FSAEnv)
-	ns := fsenv.NNext.Values[0]
-	for i := 0; i < ns; i++ {
-		lbl := fsenv.NextLabels.Values[i]
-		li, ok := InputNameMap[lbl]
-		if !ok {
-			log.Printf("Input label: %v not found in InputNames list of labels\n", lbl)
-			continue
-		}
-		if i == 0 {
-			inr := &in.Neurons[li]
-			inr.Ext = 1
-			inr.ClearMask(clrmsk)
-			inr.SetMask(setmsk)
-		}
-		inr := &trg.Neurons[li]
-		inr.Ext = 1
-		inr.ClearMask(clrmsk)
-		inr.SetMask(setmsk)
-	}
+	in.ApplyExt(en.State("Label"))
+	trg.ApplyExt(en.State("LabelTargs"))
 }
 
 // TrainTrial runs one trial of training using TrainEnv
@@ -1213,10 +1209,11 @@ func (ss *Sim) ConfigNetView(nv *netview.NetView) {
 	// nv.Scene().Camera.Pose.Pos.Set(0, 1.5, 3.0) // more "head on" than default which is more "top down"
 	// nv.Scene().Camera.LookAt(mat32.Vec3{0, 0, 0}, mat32.Vec3{0, 1, 0})
 
-	nv.ConfigLabels(InputNames)
+	lbls := ss.TrainEnv.Spec.Labels
+	nv.ConfigLabels(lbls)
 
 	ly := nv.LayerByName("Targets")
-	for li, lnm := range InputNames {
+	for li, lnm := range lbls {
 		lbl := nv.LabelByName(lnm)
 		lbl.Pose = ly.Pose
 		lbl.Pose.Pos.Y += .2
@@ -179,7 +179,7 @@ var ParamSets = params.Sets{
 // as arguments to methods, and provides the core GUI interface (note the view tags
 // for the fields which provide hints to how things should be displayed).
 type Sim struct {
-	Net          *leabra.Network          `view:"no-inline"`
+	Net          *hip.Network             `view:"no-inline"`
 	TrainAB      *etable.Table            `view:"no-inline" desc:"AB training patterns to use"`
 	TrainAC      *etable.Table            `view:"no-inline" desc:"AC training patterns to use"`
 	TestAB       *etable.Table            `view:"no-inline" desc:"AB testing patterns to use"`
@@ -271,7 +271,7 @@ var TheSim Sim
 
 // New creates new blank elements and initializes defaults
 func (ss *Sim) New() {
-	ss.Net = &leabra.Network{}
+	ss.Net = &hip.Network{}
 	ss.TrainAB = &etable.Table{}
 	ss.TrainAC = &etable.Table{}
 	ss.TestAB = &etable.Table{}
@@ -350,7 +350,7 @@ func (ss *Sim) SetEnv(trainAC bool) {
 	ss.TrainEnv.Init(0)
 }
 
-func (ss *Sim) ConfigNet(net *leabra.Network) {
+func (ss *Sim) ConfigNet(net *hip.Network) {
 	net.InitName(net, "Hip")
 	in := net.AddLayer4D("Input", 6, 2, 3, 4, emer.Input)
 	ecin := net.AddLayer4D("ECin", 6, 2, 3, 4, emer.Hidden)
@@ -488,21 +488,11 @@ func (ss *Sim) AlphaCyc(train bool) {
 		ss.Net.WtFmDWt()
 	}
 
-	ca1 := ss.Net.LayerByName("CA1").(leabra.LeabraLayer).AsLeabra()
-	ca3 := ss.Net.LayerByName("CA3").(leabra.LeabraLayer).AsLeabra()
 	ecin := ss.Net.LayerByName("ECin").(leabra.LeabraLayer).AsLeabra()
 	ecout := ss.Net.LayerByName("ECout").(leabra.LeabraLayer).AsLeabra()
-	ca1FmECin := ca1.RcvPrjns.SendName("ECin").(*hip.EcCa1Prjn)
-	ca1FmCa3 := ca1.RcvPrjns.SendName("CA3").(*hip.CHLPrjn)
-	ca3FmDg := ca3.RcvPrjns.SendName("DG").(leabra.LeabraPrjn).AsLeabra()
 
-	// First Quarter: CA1 is driven by ECin, not by CA3 recall
-	// (which is not really active yet anyway)
-	ca1FmECin.WtScale.Abs = 1
-	ca1FmCa3.WtScale.Abs = 0
-
-	dgwtscale := ca3FmDg.WtScale.Rel
-	ca3FmDg.WtScale.Rel = 0 // turn off DG input to CA3 in first quarter
+	// the CA1 and CA3 projection scales for each quarter are set by ss.Net.Theta
+	ss.Net.Theta.Testing = !train
 
 	if train {
 		ecout.SetType(emer.Target) // clamp a plus phase during testing
@@ -533,27 +523,9 @@ func (ss *Sim) AlphaCyc(train bool) {
 				}
 			}
 		}
-		switch qtr + 1 {
-		case 1: // Second, Third Quarters: CA1 is driven by CA3 recall
-			ca1FmECin.WtScale.Abs = 0
-			ca1FmCa3.WtScale.Abs = 1
-			if train {
-				ca3FmDg.WtScale.Rel = dgwtscale // restore after 1st quarter
-			} else {
-				ca3FmDg.WtScale.Rel = 1 // significantly weaker for recall
-			}
-			ss.Net.GScaleFmAvgAct() // update computed scaling factors
-			ss.Net.InitGInc()       // scaling params change, so need to recompute all netins
-		case 3: // Fourth Quarter: CA1 back to ECin drive only
-			ca1FmECin.WtScale.Abs = 1
-			ca1FmCa3.WtScale.Abs = 0
-			ss.Net.GScaleFmAvgAct() // update computed scaling factors
-			ss.Net.InitGInc()       // scaling params change, so need to recompute all netins
-
-			if train { // clamp ECout from ECin
-				ecin.UnitVals(&ss.TmpVals, "Act")
-				ecout.ApplyExt1D32(ss.TmpVals)
-			}
+		if qtr+1 == 3 && train { // clamp ECout from ECin for the Fourth Quarter
+			ecin.UnitVals(&ss.TmpVals, "Act")
+			ecout.ApplyExt1D32(ss.TmpVals)
 		}
 		ss.Net.QuarterFinal(&ss.Time)
 		if qtr+1 == 3 {
@@ -572,9 +544,6 @@ func (ss *Sim) AlphaCyc(train bool) {
 		}
 	}
 
-	ca3FmDg.WtScale.Rel = dgwtscale // restore
-	ca1FmCa3.WtScale.Abs = 1
-
 	if train {
 		ss.Net.DWt()
 	}
@@ -695,68 +664,19 @@ func (ss *Sim) InitStats() {
 	ss.EpcCosDiff = 0
 }
 
-// MemStats computes ActM vs. Target on ECout with binary counts
+// MemStats computes ActM vs. Target on ECout with binary counts (see hip.RecallStats)
 // must be called at end of 3rd quarter so that Targ values are
 // for the entire full pattern as opposed to the plus-phase target
 // values clamped from ECin activations
 func (ss *Sim) MemStats(train bool) {
 	ecout := ss.Net.LayerByName("ECout").(leabra.LeabraLayer).AsLeabra()
 	ecin := ss.Net.LayerByName("ECin").(leabra.LeabraLayer).AsLeabra()
-	nn := ecout.Shape().Len()
-	trgOnWasOffAll := 0.0 // all units
-	trgOnWasOffCmp := 0.0 // only those that required completion, missing in ECin
-	trgOffWasOn := 0.0    // should have been off
-	cmpN := 0.0           // completion target
-	trgOnN := 0.0
-	trgOffN := 0.0
-	actMi, _ := ecout.UnitVarIdx("ActM")
-	targi, _ := ecout.UnitVarIdx("Targ")
-	actQ1i, _ := ecout.UnitVarIdx("ActQ1")
-	for ni := 0; ni < nn; ni++ {
-		actm := ecout.UnitVal1D(actMi, ni)
-		trg := ecout.UnitVal1D(targi, ni) // full pattern target
-		inact := ecin.UnitVal1D(actQ1i, ni)
-		if trg < 0.5 { // trgOff
-			trgOffN += 1
-			if actm > 0.5 {
-				trgOffWasOn += 1
-			}
-		} else { // trgOn
-			trgOnN += 1
-			if inact < 0.5 { // missing in ECin -- completion target
-				cmpN += 1
-				if actm < 0.5 {
-					trgOnWasOffAll += 1
-					trgOnWasOffCmp += 1
-				}
-			} else {
-				if actm < 0.5 {
-					trgOnWasOffAll += 1
-				}
-			}
-		}
-	}
-	trgOnWasOffAll /= trgOnN
-	trgOffWasOn /= trgOffN
-	if train { // no cmp
-		if trgOnWasOffAll < ss.MemThr && trgOffWasOn < ss.MemThr {
-			ss.Mem = 1
-		} else {
-			ss.Mem = 0
-		}
-	} else { // test
-		if cmpN > 0 { // should be
-			trgOnWasOffCmp /= cmpN
-			if trgOnWasOffCmp < ss.MemThr && trgOffWasOn < ss.MemThr {
-				ss.Mem = 1
-			} else {
-				ss.Mem = 0
-			}
-		}
-	}
-	ss.TrgOnWasOffAll = trgOnWasOffAll
-	ss.TrgOnWasOffCmp = trgOnWasOffCmp
-	ss.TrgOffWasOn = trgOffWasOn
+	var rs hip.RecallStats
+	rs.Compute(ecin, ecout, ss.MemThr, !train)
+	ss.Mem = rs.Mem
+	ss.TrgOnWasOffAll = rs.TrgOnWasOffAll
+	ss.TrgOnWasOffCmp = rs.TrgOnWasOffCmp
+	ss.TrgOffWasOn = rs.TrgOffWasOn
 }
 
 // TrialStats computes the trial-level statistics and adds them to the epoch accumulators if
@@ -6,7 +6,7 @@
 
 # hip project
 
-from leabra import go, leabra, emer, relpos, eplot, env, agg, patgen, prjn, etable, efile, split, etensor, params, netview, rand, erand, gi, giv, pygiv, pyparams, mat32, hip
+from leabra import go, leabra, emer, relpos, eplot, env, agg, patgen, prjn, etable, efile, split, etensor, params, netview, rand, erand, gi, giv, pygiv, pyparams, mat32, hip, netbuild
 
 import importlib as il  #il.reload(ra25) -- doesn't seem to work for reasons unknown
 import io, sys, getopt
@@ -132,7 +132,7 @@ class Sim(pygiv.ClassViewObj):
 
     def __init__(self):
         super(Sim, self).__init__()
-        self.Net = leabra.Network()
+        self.Net = hip.Network()
         self.SetTags("Net", 'view:"no-inline"')
         self.TrainAB = etable.Table()
         self.SetTags("TrainAB", 'view:"no-inline" desc:"AB training patterns to use"')
@@ -344,12 +344,13 @@ class Sim(pygiv.ClassViewObj):
 
     def ConfigNet(ss, net):
         net.InitName(net, "Hip")
-        inp = net.AddLayer4D("Input", 6, 2, 3, 4, emer.Input)
-        ecin = net.AddLayer4D("ECin", 6, 2, 3, 4, emer.Hidden)
-        ecout = net.AddLayer4D("ECout", 6, 2, 3, 4, emer.Target)
-        ca1 = net.AddLayer4D("CA1", 6, 2, 4, 10, emer.Hidden)
-        dg = net.AddLayer2D("DG", 25, 25, emer.Hidden)
-        ca3 = net.AddLayer2D("CA3", 30, 10, emer.Hidden)
+        nb = netbuild.NewNetBuilder(net)
+        inp = nb.AddLayer4D("Input", 6, 2, 3, 4, emer.Input)
+        ecin = nb.AddLayer4D("ECin", 6, 2, 3, 4, emer.Hidden)
+        ecout = nb.AddLayer4D("ECout", 6, 2, 3, 4, emer.Target)
+        ca1 = nb.AddLayer4D("CA1", 6, 2, 4, 10, emer.Hidden)
+        dg = nb.AddLayer2D("DG", 25, 25, emer.Hidden)
+        ca3 = nb.AddLayer2D("CA3", 30, 10, emer.Hidden)
 
         ecin.SetClass("EC")
         ecout.SetClass("EC")
@@ -364,37 +365,37 @@ class Sim(pygiv.ClassViewObj):
         pool1to1 = prjn.NewPoolOneToOne()
         full = prjn.NewFull()
 
-        net.ConnectLayers(inp, ecin, onetoone, emer.Forward)
-        net.ConnectLayers(ecout, ecin, onetoone, emer.Back)
+        nb.ConnectLayers(inp, ecin, onetoone, emer.Forward)
+        nb.ConnectLayers(ecout, ecin, onetoone, emer.Back)
 
         # EC <-> CA1 encoder pathways
-        pj = net.ConnectLayersPrjn(ecin, ca1, pool1to1, emer.Forward, hip.EcCa1Prjn())
+        pj = nb.ConnectLayersPrjn(ecin, ca1, pool1to1, emer.Forward, hip.EcCa1Prjn())
         pj.SetClass("EcCa1Prjn")
-        pj = net.ConnectLayersPrjn(ca1, ecout, pool1to1, emer.Forward, hip.EcCa1Prjn())
+        pj = nb.ConnectLayersPrjn(ca1, ecout, pool1to1, emer.Forward, hip.EcCa1Prjn())
         pj.SetClass("EcCa1Prjn")
-        pj = net.ConnectLayersPrjn(ecout, ca1, pool1to1, emer.Back, hip.EcCa1Prjn())
+        pj = nb.ConnectLayersPrjn(ecout, ca1, pool1to1, emer.Back, hip.EcCa1Prjn())
         pj.SetClass("EcCa1Prjn")
 
         # Perforant pathway
         ppath = prjn.NewUnifRnd()
         ppath.PCon = 0.25
 
-        pj = net.ConnectLayersPrjn(ecin, dg, ppath, emer.Forward, hip.CHLPrjn())
+        pj = nb.ConnectLayersPrjn(ecin, dg, ppath, emer.Forward, hip.CHLPrjn())
         pj.SetClass("HippoCHL")
 
-        pj = net.ConnectLayersPrjn(ecin, ca3, ppath, emer.Forward, hip.EcCa1Prjn())
+        pj = nb.ConnectLayersPrjn(ecin, ca3, ppath, emer.Forward, hip.EcCa1Prjn())
         pj.SetClass("PPath")
-        pj = net.ConnectLayersPrjn(ca3, ca3, full, emer.Lateral, hip.EcCa1Prjn())
+        pj = nb.ConnectLayersPrjn(ca3, ca3, full, emer.Lateral, hip.EcCa1Prjn())
         pj.SetClass("PPath")
 
         # Mossy fibers
         mossy = prjn.NewUnifRnd()
         mossy.PCon = 0.02
-        pj = net.ConnectLayersPrjn(dg, ca3, mossy, emer.Forward, hip.CHLPrjn()) # no learning
+        pj = nb.ConnectLayersPrjn(dg, ca3, mossy, emer.Forward, hip.CHLPrjn()) # no learning
         pj.SetClass("HippoCHL")
 
         # Schafer collaterals
-        pj = net.ConnectLayersPrjn(ca3, ca1, full, emer.Forward, hip.CHLPrjn())
+        pj = nb.ConnectLayersPrjn(ca3, ca1, full, emer.Forward, hip.CHLPrjn())
         pj.SetClass("HippoCHL")
 
         # using 3 threads total
@@ -470,21 +471,11 @@ class Sim(pygiv.ClassViewObj):
         if train:
             ss.Net.WtFmDWt()
 
-        ca1 = leabra.Layer(ss.Net.LayerByName("CA1"))
-        ca3 = leabra.Layer(ss.Net.LayerByName("CA3"))
         ecin = leabra.Layer(ss.Net.LayerByName("ECin"))
         ecout = leabra.Layer(ss.Net.LayerByName("ECout"))
-        ca1FmECin = hip.EcCa1Prjn(ca1.RcvPrjns.SendName("ECin"))
-        ca1FmCa3 = hip.CHLPrjn(ca1.RcvPrjns.SendName("CA3"))
-        ca3FmDg = leabra.Prjn(ca3.RcvPrjns.SendName("DG"))
 
-        # First Quarter: CA1 is driven by ECin, not by CA3 recall
-        # (which is not really active yet anyway)
-        ca1FmECin.WtScale.Abs = 1
-        ca1FmCa3.WtScale.Abs = 0
-
-        dgwtscale = ca3FmDg.WtScale.Rel
-        ca3FmDg.WtScale.Rel = 0 # turn off DG input to CA3 in first quarter
+        # the CA1 and CA3 projection scales for each quarter are set by ss.Net.Theta
+        ss.Net.Theta.Testing = not train
 
         if train:
             ecout.SetType(emer.Target) # clamp a plus phase during testing
@@ -508,25 +499,9 @@ class Sim(pygiv.ClassViewObj):
                     if viewUpdt == leabra.FastSpike:
                         if (cyc+1)%10 == 0:
                             ss.UpdateView(train)
-            if qtr+1 == 1: # Second, Third Quarters: CA1 is driven by CA3 recall
-                ca1FmECin.WtScale.Abs = 0
-                ca1FmCa3.WtScale.Abs = 1
-                if train:
-                    ca3FmDg.WtScale.Rel = dgwtscale # restore after 1st quarter
-                else:
-                    ca3FmDg.WtScale.Rel = 1 # significantly weaker for recall
-
-                ss.Net.GScaleFmAvgAct() # update computed scaling factors
-                ss.Net.InitGInc()       # scaling params change, so need to recompute all netins
-            if qtr+1 == 3: # Fourth Quarter: CA1 back to ECin drive only
-                ca1FmECin.WtScale.Abs = 1
-                ca1FmCa3.WtScale.Abs = 0
-                ss.Net.GScaleFmAvgAct() # update computed scaling factors
-                ss.Net.InitGInc()       # scaling params change, so need to recompute all netins
-
-                if train: # clamp ECout from ECin
-                    ecin.UnitVals(ss.TmpVals, "Act")
-                    ecout.ApplyExt1D32(ss.TmpVals)
+            if qtr+1 == 3 and train: # clamp ECout from ECin for the Fourth Quarter
+                ecin.UnitVals(ss.TmpVals, "Act")
+                ecout.ApplyExt1D32(ss.TmpVals)
             ss.Net.QuarterFinal(ss.Time)
             if qtr+1 == 3:
                 ss.MemStats(train) # must come after QuarterFinal
@@ -539,9 +514,6 @@ class Sim(pygiv.ClassViewObj):
                     if qtr >= 2:
                         ss.UpdateView(train)
 
-        ca3FmDg.WtScale.Rel = dgwtscale # restore
-        ca1FmCa3.WtScale.Abs = 1
-
         if train:
             ss.Net.DWt()
         if ss.ViewOn and viewUpdt == leabra.AlphaCycle:
@@ -656,58 +628,19 @@ class Sim(pygiv.ClassViewObj):
 
     def MemStats(ss, train):
         """
-        MemStats computes ActM vs. Target on ECout with binary counts
+        MemStats computes ActM vs. Target on ECout with binary counts (see hip.RecallStats)
         must be called at end of 3rd quarter so that Targ values are
         for the entire full pattern as opposed to the plus-phase target
         values clamped from ECin activations
         """
         ecout = leabra.Layer(ss.Net.LayerByName("ECout"))
         ecin = leabra.Layer(ss.Net.LayerByName("ECin"))
-        nn = ecout.Shape().Len()
-        trgOnWasOffAll = 0.0
-        trgOnWasOffCmp = 0.0
-        trgOffWasOn = 0.0 # should have been off
-        cmpN = 0.0        # completion target
-        trgOnN = 0.0
-        trgOffN = 0.0
-        actMi = ecout.UnitVarIdx("ActM")
-        targi = ecout.UnitVarIdx("Targ")
-        actQ1i = ecout.UnitVarIdx("ActQ1")
-        for ni in range(nn):
-            actm = ecout.UnitVal1D(actMi, ni)
-            trg = ecout.UnitVal1D(targi, ni) # full pattern target
-            inact = ecin.UnitVal1D(actQ1i, ni)
-            if trg < 0.5: # trgOff
-                trgOffN += 1
-                if actm > 0.5:
-                    trgOffWasOn += 1
-            else: # trgOn
-                trgOnN += 1
-                if inact < 0.5: # missing in ECin -- completion target
-                    cmpN += 1
-                    if actm < 0.5:
-                        trgOnWasOffAll += 1
-                        trgOnWasOffCmp += 1
-                else:
-                    if actm < 0.5:
-                        trgOnWasOffAll += 1
-        trgOnWasOffAll /= trgOnN
-        trgOffWasOn /= trgOffN
-        if train: # no cmp
-            if trgOnWasOffAll < ss.MemThr and trgOffWasOn < ss.MemThr:
-                ss.Mem = 1
-            else:
-                ss.Mem = 0
-        else: # test
-            if cmpN > 0: # should be
-                trgOnWasOffCmp /= cmpN
-                if trgOnWasOffCmp < ss.MemThr and trgOffWasOn < ss.MemThr:
-                    ss.Mem = 1
-                else:
-                    ss.Mem = 0
-        ss.TrgOnWasOffAll = trgOnWasOffAll
-        ss.TrgOnWasOffCmp = trgOnWasOffCmp
-        ss.TrgOffWasOn = trgOffWasOn
+        rs = hip.RecallStats()
+        rs.Compute(ecin, ecout, ss.MemThr, not train)
+        ss.Mem = rs.Mem
+        ss.TrgOnWasOffAll = rs.TrgOnWasOffAll
+        ss.TrgOnWasOffCmp = rs.TrgOnWasOffCmp
+        ss.TrgOffWasOn = rs.TrgOffWasOn
 
     def TrialStats(ss, accum):
         """
@@ -43,6 +43,15 @@ var ParamSets = params.Sets{
 					//"Prjn.Learn.XCal.SetLLrn": "true", // bcm now avail, comment out = default LLrn
 					//"Prjn.Learn.XCal.LLrn":    "0",    // 0 = turn off BCM, must with SetLLrn = true
 				}},
+			{Sel: ".CA3Prjn", Desc: "perforant path and CA3 recurrents with DG-driven error-driven learning, if HipParams.CA3Prjn",
+				Params: params.Params{
+					"Prjn.CHL.Hebb":          "0.01",
+					"Prjn.CHL.SAvgCor":       "0.4",
+					"Prjn.Learn.Lrate":       "0.15",
+					"Prjn.Learn.Momentum.On": "false",
+					"Prjn.Learn.Norm.On":     "false",
+					"Prjn.Learn.WtBal.On":    "true",
+				}},
 			{Sel: "#CA1ToECout", Desc: "extra strong from CA1 to ECout",
 				Params: params.Params{
 					"Prjn.WtScale.Abs": "4.0", // 4 > 6 > 2 (fails)
@@ -269,4 +278,32 @@ var ParamSets = params.Sets{
 				}},
 		},
 	}},
+	{Name: "CA3ErrOn", Desc: "error-driven CA3 learning from DG-driven plus phase", Sheets: params.Sheets{
+		"Hip": &params.Sheet{
+			{Sel: "HipParams", Desc: "use CA3Prjn",
+				Params: params.Params{
+					"HipParams.CA3Prjn": "true",
+				}},
+		},
+		"Network": &params.Sheet{
+			{Sel: ".CA3Prjn", Desc: "DG error on",
+				Params: params.Params{
+					"Prjn.CA3Err.On": "true",
+				}},
+		},
+	}},
+	{Name: "CA3ErrOff", Desc: "CA3 learning by CHL only: hebbian-dominated, as DG drives both phases", Sheets: params.Sheets{
+		"Hip": &params.Sheet{
+			{Sel: "HipParams", Desc: "use CA3Prjn",
+				Params: params.Params{
+					"HipParams.CA3Prjn": "true",
+				}},
+		},
+		"Network": &params.Sheet{
+			{Sel: ".CA3Prjn", Desc: "DG error off",
+				Params: params.Params{
+					"Prjn.CA3Err.On": "false",
+				}},
+		},
+	}},
 }
@@ -82,6 +82,7 @@ type HipParams struct {
 	ECPctAct     float32    `desc:"percent activation in EC pool"`
 	MossyDel     float32    `desc:"delta in mossy effective strength between minus and plus phase"`
 	MossyDelTest float32    `desc:"delta in mossy strength for testing (relative to base param)"`
+	CA3Prjn      bool       `desc:"use hip.CA3Prjn for ECin -> CA3 and CA3 -> CA3, with error-driven learning from the DG-driven plus phase according to its CA3Err params, instead of EcCa1Prjn"`
 }
 
 func (hp *HipParams) Update() {
@@ -104,7 +105,7 @@ type PatParams struct {
 // as arguments to methods, and provides the core GUI interface (note the view tags
 // for the fields which provide hints to how things should be displayed).
 type Sim struct {
-	Net          *leabra.Network          `view:"no-inline"`
+	Net          *hip.Network             `view:"no-inline"`
 	Hip          HipParams                `desc:"hippocampus sizing parameters"`
 	Pat          PatParams                `desc:"parameters for the input patterns"`
 	PoolVocab    patgen.Vocab             `view:"no-inline" desc:"pool patterns vocabulary"`
@@ -208,7 +209,7 @@ var TheSim Sim
 
 // New creates new blank elements and initializes defaults
 func (ss *Sim) New() {
-	ss.Net = &leabra.Network{}
+	ss.Net = &hip.Network{}
 	ss.PoolVocab = patgen.Vocab{}
 	ss.TrainAB = &etable.Table{}
 	ss.TrainAC = &etable.Table{}
@@ -331,7 +332,7 @@ func (ss *Sim) SetEnv(trainAC bool) {
 	ss.TrainEnv.Init(ss.BatchRun)
 }
 
-func (ss *Sim) ConfigNet(net *leabra.Network) {
+func (ss *Sim) ConfigNet(net *hip.Network) {
 	net.InitName(net, "Hip_bench")
 	hp := &ss.Hip
 	in := net.AddLayer4D("Input", hp.ECSize.Y, hp.ECSize.X, hp.ECPool.Y, hp.ECPool.X, emer.Input)
@@ -374,7 +375,12 @@ func (ss *Sim) ConfigNet(net *leabra.Network) {
 	pj = net.ConnectLayersPrjn(ecin, dg, ppathDG, emer.Forward, &hip.CHLPrjn{})
 	pj.SetClass("HippoCHL")
 
-	if true { // toggle for bcm vs. ppath, zycyc: must use false for orig_param, true for def_param
+	if hp.CA3Prjn { // DG-driven error-driven learning, blended with CHL hebbian
+		pj = net.ConnectLayersPrjn(ecin, ca3, ppathCA3, emer.Forward, &hip.CA3Prjn{})
+		pj.SetClass("CA3Prjn")
+		pj = net.ConnectLayersPrjn(ca3, ca3, full, emer.Lateral, &hip.CA3Prjn{})
+		pj.SetClass("CA3Prjn")
+	} else if true { // toggle for bcm vs. ppath, zycyc: must use false for orig_param, true for def_param
 		pj = net.ConnectLayersPrjn(ecin, ca3, ppathCA3, emer.Forward, &hip.EcCa1Prjn{})
 		pj.SetClass("PPath")
 		pj = net.ConnectLayersPrjn(ca3, ca3, full, emer.Lateral, &hip.EcCa1Prjn{})
@@ -414,6 +420,12 @@ func (ss *Sim) ConfigNet(net *leabra.Network) {
 	// and thus removes error-driven learning -- but stats are still computed.
 
 	net.Defaults()
+	// mossy fiber input to CA3 is reduced relative to its base strength in Q1,
+	// and in Q2-4 during testing
+	mossyDel := hip.PrjnScale{Send: "DG", Recv: "CA3", Rel: true, Val: -ss.Hip.MossyDel, Del: true}
+	net.Theta.SetScale(mossyDel, 0)
+	mossyDel.Val = -ss.Hip.MossyDelTest
+	net.Theta.SetTestScale(mossyDel, 1, 2, 3)
 	ss.SetParams("Network", ss.LogSetParams) // only set Network params
 	err := net.Build()
 	if err != nil {
@@ -426,7 +438,7 @@ func (ss *Sim) ConfigNet(net *leabra.Network) {
 func (ss *Sim) ReConfigNet() {
 	ss.Update()
 	ss.ConfigPats()
-	ss.Net = &leabra.Network{} // start over with new network
+	ss.Net = &hip.Network{} // start over with new network
 	ss.ConfigNet(ss.Net)
 	if ss.NetView != nil {
 		ss.NetView.SetNet(ss.Net)
@@ -498,24 +510,11 @@ func (ss *Sim) AlphaCyc(train bool) {
 		ss.Net.WtFmDWt()
 	}
 
-	ca1 := ss.Net.LayerByName("CA1").(leabra.LeabraLayer).AsLeabra()
-	ca3 := ss.Net.LayerByName("CA3").(leabra.LeabraLayer).AsLeabra()
-	input := ss.Net.LayerByName("Input").(leabra.LeabraLayer).AsLeabra()
 	ecin := ss.Net.LayerByName("ECin").(leabra.LeabraLayer).AsLeabra()
 	ecout := ss.Net.LayerByName("ECout").(leabra.LeabraLayer).AsLeabra()
-	ca1FmECin := ca1.RcvPrjns.SendName("ECin").(leabra.LeabraPrjn).AsLeabra()
-	ca1FmCa3 := ca1.RcvPrjns.SendName("CA3").(leabra.LeabraPrjn).AsLeabra()
-	ca3FmDg := ca3.RcvPrjns.SendName("DG").(leabra.LeabraPrjn).AsLeabra()
-	_ = ecin
-	_ = input
 
-	// First Quarter: CA1 is driven by ECin, not by CA3 recall
-	// (which is not really active yet anyway)
-	ca1FmECin.WtScale.Abs = 1
-	ca1FmCa3.WtScale.Abs = 0
-
-	dgwtscale := ca3FmDg.WtScale.Rel
-	ca3FmDg.WtScale.Rel = dgwtscale - ss.Hip.MossyDel // 0 for the first quarter, comment out if testing in orig, zycyc
+	// the CA1 and CA3 projection scales for each quarter are set by ss.Net.Theta
+	ss.Net.Theta.Testing = !train
 
 	if train {
 		ecout.SetType(emer.Target) // clamp a plus phase during testing
@@ -546,27 +545,9 @@ func (ss *Sim) AlphaCyc(train bool) {
 				}
 			}
 		}
-		switch qtr + 1 {
-		case 1: // Second, Third Quarters: CA1 is driven by CA3 recall
-			ca1FmECin.WtScale.Abs = 0
-			ca1FmCa3.WtScale.Abs = 1
-			//ca3FmDg.WtScale.Rel = dgwtscale //zycyc, orig
-			if train { // def
-				ca3FmDg.WtScale.Rel = dgwtscale
-			} else {
-				ca3FmDg.WtScale.Rel = dgwtscale - ss.Hip.MossyDelTest // testing
-			}
-			ss.Net.GScaleFmAvgAct() // update computed scaling factors
-			ss.Net.InitGInc()       // scaling params change, so need to recompute all netins
-		case 3: // Fourth Quarter: CA1 back to ECin drive only
-			ca1FmECin.WtScale.Abs = 1
-			ca1FmCa3.WtScale.Abs = 0
-			ss.Net.GScaleFmAvgAct() // update computed scaling factors
-			ss.Net.InitGInc()       // scaling params change, so need to recompute all netins
-			if train {              // clamp ECout from ECin
-				ecin.UnitVals(&ss.TmpVals, "Act") // note: could use input instead -- not much diff
-				ecout.ApplyExt1D32(ss.TmpVals)
-			}
+		if qtr+1 == 3 && train { // clamp ECout from ECin for the Fourth Quarter
+			ecin.UnitVals(&ss.TmpVals, "Act") // note: could use input instead -- not much diff
+			ecout.ApplyExt1D32(ss.TmpVals)
 		}
 		ss.Net.QuarterFinal(&ss.Time)
 		if qtr+1 == 3 {
@@ -585,9 +566,6 @@ func (ss *Sim) AlphaCyc(train bool) {
 		}
 	}
 
-	ca3FmDg.WtScale.Rel = dgwtscale // restore
-	ca1FmCa3.WtScale.Abs = 1
-
 	if train {
 		ss.Net.DWt()
 	}
@@ -753,68 +731,19 @@ func (ss *Sim) InitStats() {
 	ss.EpcCosDiff = 0
 }
 
-// MemStats computes ActM vs. Target on ECout with binary counts
+// MemStats computes ActM vs. Target on ECout with binary counts (see hip.RecallStats)
 // must be called at end of 3rd quarter so that Targ values are
 // for the entire full pattern as opposed to the plus-phase target
 // values clamped from ECin activations
 func (ss *Sim) MemStats(train bool) {
 	ecout := ss.Net.LayerByName("ECout").(leabra.LeabraLayer).AsLeabra()
 	ecin := ss.Net.LayerByName("ECin").(leabra.LeabraLayer).AsLeabra()
-	nn := ecout.Shape().Len()
-	trgOnWasOffAll := 0.0 // all units
-	trgOnWasOffCmp := 0.0 // only those that required completion, missing in ECin
-	trgOffWasOn := 0.0    // should have been off
-	cmpN := 0.0           // completion target
-	trgOnN := 0.0
-	trgOffN := 0.0
-	actMi, _ := ecout.UnitVarIdx("ActM")
-	targi, _ := ecout.UnitVarIdx("Targ")
-	actQ1i, _ := ecout.UnitVarIdx("ActQ1")
-	for ni := 0; ni < nn; ni++ {
-		actm := ecout.UnitVal1D(actMi, ni)
-		trg := ecout.UnitVal1D(targi, ni) // full pattern target
-		inact := ecin.UnitVal1D(actQ1i, ni)
-		if trg < 0.5 { // trgOff
-			trgOffN += 1
-			if actm > 0.5 {
-				trgOffWasOn += 1
-			}
-		} else { // trgOn
-			trgOnN += 1
-			if inact < 0.5 { // missing in ECin -- completion target
-				cmpN += 1
-				if actm < 0.5 {
-					trgOnWasOffAll += 1
-					trgOnWasOffCmp += 1
-				}
-			} else {
-				if actm < 0.5 {
-					trgOnWasOffAll += 1
-				}
-			}
-		}
-	}
-	trgOnWasOffAll /= trgOnN
-	trgOffWasOn /= trgOffN
-	if train { // no cmp
-		if trgOnWasOffAll < ss.MemThr && trgOffWasOn < ss.MemThr {
-			ss.Mem = 1
-		} else {
-			ss.Mem = 0
-		}
-	} else { // test
-		if cmpN > 0 { // should be
-			trgOnWasOffCmp /= cmpN
-			if trgOnWasOffCmp < ss.MemThr && trgOffWasOn < ss.MemThr {
-				ss.Mem = 1
-			} else {
-				ss.Mem = 0
-			}
-		}
-	}
-	ss.TrgOnWasOffAll = trgOnWasOffAll
-	ss.TrgOnWasOffCmp = trgOnWasOffCmp
-	ss.TrgOffWasOn = trgOffWasOn
+	var rs hip.RecallStats
+	rs.Compute(ecin, ecout, ss.MemThr, !train)
+	ss.Mem = rs.Mem
+	ss.TrgOnWasOffAll = rs.TrgOnWasOffAll
+	ss.TrgOnWasOffCmp = rs.TrgOnWasOffCmp
+	ss.TrgOffWasOn = rs.TrgOffWasOn
 }
 
 // TrialStats computes the trial-level statistics and adds them to the epoch accumulators if
@@ -899,7 +828,7 @@ func (ss *Sim) SaveWeights(filename gi.FileName) {
 }
 
 // SetDgCa3Off sets the DG and CA3 layers off (or on)
-func (ss *Sim) SetDgCa3Off(net *leabra.Network, off bool) {
+func (ss *Sim) SetDgCa3Off(net *hip.Network, off bool) {
 	ca3 := net.LayerByName("CA3").(leabra.LeabraLayer).AsLeabra()
 	dg := net.LayerByName("DG").(leabra.LeabraLayer).AsLeabra()
 	ca3.Off = off
@@ -1559,6 +1488,9 @@ func (ss *Sim) LogTstEpc(dt *etable.Table) {
 	dt.SetCellString("Params", row, params)
 	dt.SetCellString("NetSize", row, spltparams[0])
 	dt.SetCellString("ListSize", row, spltparams[1])
+	if len(CondLoopParams) > 0 {
+		dt.SetCellString("Condition", row, spltparams[2])
+	}
 	dt.SetCellFloat("Epoch", row, float64(epc))
 	dt.SetCellFloat("PerTrlMSec", row, ss.EpcPerTrlMSec)
 	dt.SetCellFloat("SSE", row, agg.Sum(tix, "SSE")[0])
@@ -1637,6 +1569,7 @@ func (ss *Sim) ConfigTstEpcLog(dt *etable.Table) {
 		{"Params", etensor.STRING, nil, nil},
 		{"NetSize", etensor.STRING, nil, nil},
 		{"ListSize", etensor.STRING, nil, nil},
+		{"Condition", etensor.STRING, nil, nil},
 		{"Epoch", etensor.INT64, nil, nil},
 		{"PerTrlMSec", etensor.FLOAT64, nil, nil},
 		{"SSE", etensor.FLOAT64, nil, nil},
@@ -1778,6 +1711,9 @@ func (ss *Sim) LogRun(dt *etable.Table) {
 	dt.SetCellString("Params", row, params)
 	dt.SetCellString("NetSize", row, spltparams[0])
 	dt.SetCellString("ListSize", row, spltparams[1])
+	if len(CondLoopParams) > 0 {
+		dt.SetCellString("Condition", row, spltparams[2])
+	}
 	dt.SetCellFloat("NEpochs", row, float64(ss.TstEpcLog.Rows))
 	dt.SetCellFloat("FirstZero", row, float64(fzero))
 	dt.SetCellFloat("SSE", row, agg.Mean(epcix, "SSE")[0])
@@ -1824,6 +1760,7 @@ func (ss *Sim) ConfigRunLog(dt *etable.Table) {
 		{"Params", etensor.STRING, nil, nil},
 		{"NetSize", etensor.STRING, nil, nil},
 		{"ListSize", etensor.STRING, nil, nil},
+		{"Condition", etensor.STRING, nil, nil},
 		{"NEpochs", etensor.FLOAT64, nil, nil},
 		{"FirstZero", etensor.FLOAT64, nil, nil},
 		{"SSE", etensor.FLOAT64, nil, nil},
@@ -1884,8 +1821,7 @@ func (ss *Sim) LogRunStats() {
 	dt := ss.RunLog
 	runix := etable.NewIdxView(dt)
 	//spl := split.GroupBy(runix, []string{"Params"})
-	spl := split.GroupBy(runix, []string{"NetSize", "ListSize"})
-	//spl := split.GroupBy(runix, []string{"NetSize", "ListSize", "Condition"}) // zycyc
+	spl := split.GroupBy(runix, []string{"NetSize", "ListSize", "Condition"})
 	for _, tn := range ss.TstNms {
 		nm := tn + " " + "Mem"
 		split.Desc(spl, nm)
@@ -1911,7 +1847,9 @@ func (ss *Sim) ConfigRunStatsPlot(plt *eplot.Plot2D, dt *etable.Table, plotidx i
 	//plt.Params.XAxisCol = "Params"
 	plt.Params.XAxisCol = "ListSize"
 	plt.Params.LegendCol = "NetSize"
-	//plt.Params.LegendCol = "Condition" // zycyc
+	if len(CondLoopParams) > 0 {
+		plt.Params.LegendCol = "Condition"
+	}
 	plt.SetTable(dt)
 
 	//plt.Params.BarWidth = 10
@@ -2258,25 +2196,47 @@ var OuterLoopParams = []string{"MedHip", "BigHip"}
 //var InnerLoopParams = []string{"List020", "List040"}
 var InnerLoopParams = []string{"List020", "List040", "List060", "List080", "List100"}
 
-// TwoFactorRun runs outer-loop crossed with inner-loop params
+// CondLoopParams are the conditions to compare within each outer x inner loop
+// cell, logged in the Condition column -- empty for no comparison
+var CondLoopParams []string
+
+// CA3ErrConds compare memory capacity with error-driven CA3 learning
+// (hip.CA3Prjn) on vs. off
+var CA3ErrConds = []string{"CA3ErrOn", "CA3ErrOff"}
+
+// TwoFactorRun runs outer-loop crossed with inner-loop params,
+// and with each of the CondLoopParams if set
 func (ss *Sim) TwoFactorRun() {
 	tag := ss.Tag
 	usetag := tag
 	if usetag != "" {
 		usetag += "_"
 	}
+	conds := CondLoopParams
+	if len(conds) == 0 {
+		conds = []string{""}
+	}
 	for _, otf := range OuterLoopParams {
 		for _, inf := range InnerLoopParams {
-			ss.Tag = usetag + otf + "_" + inf
-			rand.Seed(ss.RndSeed + int64(ss.BatchRun)) // TODO: non-parallel running should resemble parallel running results, now not
-			ss.SetParamsSet(otf, "", ss.LogSetParams)
-			ss.SetParamsSet(inf, "", ss.LogSetParams)
-			ss.ReConfigNet() // note: this applies Base params to Network
-			ss.ConfigEnv()
-			ss.StopNow = false
-			ss.PreTrain() // zycyc
-			ss.NewRun()
-			ss.Train()
+			for _, cond := range conds {
+				ss.Tag = usetag + otf + "_" + inf
+				rand.Seed(ss.RndSeed + int64(ss.BatchRun)) // TODO: non-parallel running should resemble parallel running results, now not
+				ss.SetParamsSet(otf, "", ss.LogSetParams)
+				ss.SetParamsSet(inf, "", ss.LogSetParams)
+				if cond != "" {
+					ss.Tag += "_" + cond
+					ss.SetParamsSet(cond, "Hip", ss.LogSetParams)
+				}
+				ss.ReConfigNet() // note: this applies Base params to Network
+				if cond != "" {
+					ss.SetParamsSet(cond, "Network", ss.LogSetParams)
+				}
+				ss.ConfigEnv()
+				ss.StopNow = false
+				ss.PreTrain() // zycyc
+				ss.NewRun()
+				ss.Train()
+			}
 		}
 	}
 	ss.Tag = tag
@@ -2288,6 +2248,7 @@ func (ss *Sim) CmdArgs() {
 	var saveEpcLog bool
 	var saveRunLog bool
 	var note string
+	var ca3err bool
 	flag.StringVar(&ss.ParamSet, "params", "", "ParamSet name to use -- must be valid name as listed in compiled-in params or loaded params")
 	flag.StringVar(&ss.Tag, "tag", "", "extra tag to add to file names saved from this run")
 	flag.StringVar(&note, "note", "", "user note -- describe the run params etc")
@@ -2299,7 +2260,11 @@ func (ss *Sim) CmdArgs() {
 	flag.BoolVar(&saveEpcLog, "epclog", true, "if true, save train epoch log to file")
 	flag.BoolVar(&saveRunLog, "runlog", true, "if true, save run epoch log to file")
 	flag.BoolVar(&nogui, "nogui", true, "if not passing any other args and want to run nogui, use nogui")
+	flag.BoolVar(&ca3err, "ca3err", false, "if true, compare memory capacity with error-driven CA3 learning (hip.CA3Prjn) on vs. off")
 	flag.Parse()
+	if ca3err {
+		CondLoopParams = CA3ErrConds
+	}
 	ss.Init()
 
 	if note != "" {
@@ -6,7 +6,7 @@
 
 # hip project
 
-from leabra import go, leabra, emer, relpos, eplot, env, agg, patgen, prjn, etable, efile, split, etensor, params, netview, rand, erand, gi, giv, pygiv, pyparams, mat32, hip, evec, simat, metric
+from leabra import go, leabra, emer, relpos, eplot, env, agg, patgen, prjn, etable, efile, split, etensor, params, netview, rand, erand, gi, giv, pygiv, pyparams, mat32, hip, evec, simat, metric, netbuild
 
 import importlib as il  #il.reload(ra25) -- doesn't seem to work for reasons unknown
 import io, sys, getopt
@@ -167,6 +167,8 @@ class HipParams(pygiv.ClassViewObj):
         self.SetTags("MossyDel", 'desc:"delta in mossy effective strength between minus and plus phase"')
         self.MossyDelTest = float()
         self.SetTags("MossyDelTest", 'desc:"delta in mossy strength for testing (relative to base param)"')
+        self.CA3Prjn = False
+        self.SetTags("CA3Prjn", 'desc:"use hip.CA3Prjn for ECin -> CA3 and CA3 -> CA3, with error-driven learning from the DG-driven plus phase according to its CA3Err params, instead of EcCa1Prjn"')
 
     def Update(hp):
         hp.DGSize.X = int(float(hp.CA3Size.X) * hp.DGRatio)
@@ -227,7 +229,7 @@ class Sim(pygiv.ClassViewObj):
 
     def __init__(self):
         super(Sim, self).__init__()
-        self.Net = leabra.Network()
+        self.Net = hip.Network()
         self.SetTags("Net", 'view:"no-inline"')
         self.Hip = HipParams()
         self.SetTags("Hip", 'desc:"hippocampus sizing parameters"')
@@ -470,13 +472,14 @@ class Sim(pygiv.ClassViewObj):
 
     def ConfigNet(ss, net):
         net.InitName(net, "Hip_bench")
+        nb = netbuild.NewNetBuilder(net)
         hp = ss.Hip
-        inl = net.AddLayer4D("Input", hp.ECSize.Y, hp.ECSize.X, hp.ECPool.Y, hp.ECPool.X, emer.Input)
-        ecin = net.AddLayer4D("ECin", hp.ECSize.Y, hp.ECSize.X, hp.ECPool.Y, hp.ECPool.X, emer.Hidden)
-        ecout = net.AddLayer4D("ECout", hp.ECSize.Y, hp.ECSize.X, hp.ECPool.Y, hp.ECPool.X, emer.Target)
-        ca1 = net.AddLayer4D("CA1", hp.ECSize.Y, hp.ECSize.X, hp.CA1Pool.Y, hp.CA1Pool.X, emer.Hidden)
-        dg = net.AddLayer2D("DG", hp.DGSize.Y, hp.DGSize.X, emer.Hidden)
-        ca3 = net.AddLayer2D("CA3", hp.CA3Size.Y, hp.CA3Size.X, emer.Hidden)
+        inl = nb.AddLayer4D("Input", hp.ECSize.Y, hp.ECSize.X, hp.ECPool.Y, hp.ECPool.X, emer.Input)
+        ecin = nb.AddLayer4D("ECin", hp.ECSize.Y, hp.ECSize.X, hp.ECPool.Y, hp.ECPool.X, emer.Hidden)
+        ecout = nb.AddLayer4D("ECout", hp.ECSize.Y, hp.ECSize.X, hp.ECPool.Y, hp.ECPool.X, emer.Target)
+        ca1 = nb.AddLayer4D("CA1", hp.ECSize.Y, hp.ECSize.X, hp.CA1Pool.Y, hp.CA1Pool.X, emer.Hidden)
+        dg = nb.AddLayer2D("DG", hp.DGSize.Y, hp.DGSize.X, emer.Hidden)
+        ca3 = nb.AddLayer2D("CA3", hp.CA3Size.Y, hp.CA3Size.X, emer.Hidden)
 
         ecin.SetClass("EC")
         ecout.SetClass("EC")
@@ -491,15 +494,15 @@ class Sim(pygiv.ClassViewObj):
         pool1to1 = prjn.NewPoolOneToOne()
         full = prjn.NewFull()
 
-        net.ConnectLayers(inl, ecin, onetoone, emer.Forward)
-        net.ConnectLayers(ecout, ecin, onetoone, emer.Back)
+        nb.ConnectLayers(inl, ecin, onetoone, emer.Forward)
+        nb.ConnectLayers(ecout, ecin, onetoone, emer.Back)
 
         # EC <-> CA1 encoder pathways
-        pj = net.ConnectLayersPrjn(ecin, ca1, pool1to1, emer.Forward, hip.EcCa1Prjn())
+        pj = nb.ConnectLayersPrjn(ecin, ca1, pool1to1, emer.Forward, hip.EcCa1Prjn())
         pj.SetClass("EcCa1Prjn")
-        pj = net.ConnectLayersPrjn(ca1, ecout, pool1to1, emer.Forward, hip.EcCa1Prjn())
+        pj = nb.ConnectLayersPrjn(ca1, ecout, pool1to1, emer.Forward, hip.EcCa1Prjn())
         pj.SetClass("EcCa1Prjn")
-        pj = net.ConnectLayersPrjn(ecout, ca1, pool1to1, emer.Back, hip.EcCa1Prjn())
+        pj = nb.ConnectLayersPrjn(ecout, ca1, pool1to1, emer.Back, hip.EcCa1Prjn())
         pj.SetClass("EcCa1Prjn")
 
         # Perforant pathway
@@ -508,34 +511,39 @@ class Sim(pygiv.ClassViewObj):
         ppathCA3 = prjn.NewUnifRnd()
         ppathCA3.PCon = hp.CA3PCon
 
-        pj = net.ConnectLayersPrjn(ecin, dg, ppathDG, emer.Forward, hip.CHLPrjn())
+        pj = nb.ConnectLayersPrjn(ecin, dg, ppathDG, emer.Forward, hip.CHLPrjn())
         pj.SetClass("HippoCHL")
 
-        if True: # toggle for bcm vs. ppath
-            pj = net.ConnectLayersPrjn(ecin, ca3, ppathCA3, emer.Forward, hip.EcCa1Prjn())
+        if hp.CA3Prjn: # DG-driven error-driven learning, blended with CHL hebbian
+            pj = nb.ConnectLayersPrjn(ecin, ca3, ppathCA3, emer.Forward, hip.CA3Prjn())
+            pj.SetClass("CA3Prjn")
+            pj = nb.ConnectLayersPrjn(ca3, ca3, full, emer.Lateral, hip.CA3Prjn())
+            pj.SetClass("CA3Prjn")
+        elif True: # toggle for bcm vs. ppath
+            pj = nb.ConnectLayersPrjn(ecin, ca3, ppathCA3, emer.Forward, hip.EcCa1Prjn())
             pj.SetClass("PPath")
-            pj = net.ConnectLayersPrjn(ca3, ca3, full, emer.Lateral, hip.EcCa1Prjn())
+            pj = nb.ConnectLayersPrjn(ca3, ca3, full, emer.Lateral, hip.EcCa1Prjn())
             pj.SetClass("PPath")
         else:
             # so far, this is sig worse, even with error-driven MinusQ1 case (which is better than off)
-            pj = net.ConnectLayersPrjn(ecin, ca3, ppathCA3, emer.Forward, hip.CHLPrjn())
+            pj = nb.ConnectLayersPrjn(ecin, ca3, ppathCA3, emer.Forward, hip.CHLPrjn())
             pj.SetClass("PPath")
-            pj = net.ConnectLayersPrjn(ca3, ca3, full, emer.Lateral, hip.CHLPrjn())
+            pj = nb.ConnectLayersPrjn(ca3, ca3, full, emer.Lateral, hip.CHLPrjn())
             pj.SetClass("PPath")
 
         # always use this for now:
         if True:
-            pj = net.ConnectLayersPrjn(ca3, ca1, full, emer.Forward, hip.CHLPrjn())
+            pj = nb.ConnectLayersPrjn(ca3, ca1, full, emer.Forward, hip.CHLPrjn())
             pj.SetClass("HippoCHL")
         else:
             # note: this requires lrate = 1.0 or maybe 1.2, doesn't work *nearly* as well
-            pj = net.ConnectLayers(ca3, ca1, full, emer.Forward) # default con
+            pj = nb.ConnectLayers(ca3, ca1, full, emer.Forward) # default con
             # pj.SetClass("HippoCHL")
 
         # Mossy fibers
         mossy = prjn.NewUnifRnd()
         mossy.PCon = hp.MossyPCon
-        pj = net.ConnectLayersPrjn(dg, ca3, mossy, emer.Forward, hip.CHLPrjn()) # no learning
+        pj = nb.ConnectLayersPrjn(dg, ca3, mossy, emer.Forward, hip.CHLPrjn()) # no learning
         pj.SetClass("HippoCHL")
 
         # using 4 threads total (rest on 0)
@@ -549,13 +557,19 @@ class Sim(pygiv.ClassViewObj):
         # and thus removes error-driven learning -- but stats are still computed.
 
         net.Defaults()
+        # mossy fiber input to CA3 is reduced relative to its base strength in Q1,
+        # and in Q2-4 during testing
+        mossyDel = hip.PrjnScale(Send="DG", Recv="CA3", Rel=True, Val=-ss.Hip.MossyDel, Del=True)
+        net.Theta.SetScale(mossyDel, 0)
+        mossyDel.Val = -ss.Hip.MossyDelTest
+        net.Theta.SetTestScale(mossyDel, 1, 2, 3)
         ss.SetParams("Network", ss.LogSetParams) # only set Network params
         net.Build()
         net.InitWts()
 
     def ReConfigNet(ss):
         ss.ConfigPats()
-        ss.Net = leabra.Network() # start over with new network
+        ss.Net = hip.Network() # start over with new network
         ss.ConfigNet(ss.Net)
         if ss.NetView != 0:
             ss.NetView.SetNet(ss.Net)
@@ -618,21 +632,11 @@ class Sim(pygiv.ClassViewObj):
         if train:
             ss.Net.WtFmDWt()
 
-        ca1 = leabra.Layer(ss.Net.LayerByName("CA1"))
-        ca3 = leabra.Layer(ss.Net.LayerByName("CA3"))
         ecin = leabra.Layer(ss.Net.LayerByName("ECin"))
         ecout = leabra.Layer(ss.Net.LayerByName("ECout"))
-        ca1FmECin = hip.EcCa1Prjn(ca1.RcvPrjns.SendName("ECin"))
-        ca1FmCa3 = hip.CHLPrjn(ca1.RcvPrjns.SendName("CA3"))
-        ca3FmDg = leabra.Prjn(ca3.RcvPrjns.SendName("DG"))
 
-        # First Quarter: CA1 is driven by ECin, not by CA3 recall
-        # (which is not really active yet anyway)
-        ca1FmECin.WtScale.Abs = 1
-        ca1FmCa3.WtScale.Abs = 0
-
-        dgwtscale = ca3FmDg.WtScale.Rel
-        ca3FmDg.WtScale.Rel = dgwtscale - ss.Hip.MossyDel
+        # the CA1 and CA3 projection scales for each quarter are set by ss.Net.Theta
+        ss.Net.Theta.Testing = not train
 
         if train:
             ecout.SetType(emer.Target) # clamp a plus phase during testing
@@ -656,24 +660,9 @@ class Sim(pygiv.ClassViewObj):
                     if viewUpdt == leabra.FastSpike:
                         if (cyc+1)%10 == 0:
                             ss.UpdateView(train)
-            if qtr == 1: # Second, Third Quarters: CA1 is driven by CA3 recall
-                ca1FmECin.WtScale.Abs = 0
-                ca1FmCa3.WtScale.Abs = 1
-                if train:
-                    ca3FmDg.WtScale.Rel = dgwtscale
-                else:
-                    ca3FmDg.WtScale.Rel = dgwtscale - ss.Hip.MossyDelTest # testing
-
-                ss.Net.GScaleFmAvgAct() # update computed scaling factors
-                ss.Net.InitGInc()       # scaling params change, so need to recompute all netins
-            if qtr == 3: # Fourth Quarter: CA1 back to ECin drive only
-                ca1FmECin.WtScale.Abs = 1
-                ca1FmCa3.WtScale.Abs = 0
-                ss.Net.GScaleFmAvgAct() # update computed scaling factors
-                ss.Net.InitGInc()       # scaling params change, so need to recompute all netins
-                if train:               # clamp ECout from ECin
-                    ecin.UnitVals(ss.TmpVals, "Act") # note: could use input instead -- not much diff
-                    ecout.ApplyExt1D32(ss.TmpVals)
+            if qtr+1 == 3 and train: # clamp ECout from ECin for the Fourth Quarter
+                ecin.UnitVals(ss.TmpVals, "Act") # note: could use input instead -- not much diff
+                ecout.ApplyExt1D32(ss.TmpVals)
             ss.Net.QuarterFinal(ss.Time)
             if qtr+1 == 3:
                 ss.MemStats(train) # must come after QuarterFinal
@@ -686,9 +675,6 @@ class Sim(pygiv.ClassViewObj):
                     if qtr >= 2:
                         ss.UpdateView(train)
 
-        ca3FmDg.WtScale.Rel = dgwtscale # restore
-        ca1FmCa3.WtScale.Abs = 1
-
         if train:
             ss.Net.DWt()
         if ss.ViewOn and viewUpdt == leabra.AlphaCycle:
@@ -837,58 +823,19 @@ class Sim(pygiv.ClassViewObj):
 
     def MemStats(ss, train):
         """
-        MemStats computes ActM vs. Target on ECout with binary counts
+        MemStats computes ActM vs. Target on ECout with binary counts (see hip.RecallStats)
         must be called at end of 3rd quarter so that Targ values are
         for the entire full pattern as opposed to the plus-phase target
         values clamped from ECin activations
         """
         ecout = leabra.Layer(ss.Net.LayerByName("ECout"))
         ecin = leabra.Layer(ss.Net.LayerByName("ECin"))
-        nn = ecout.Shape().Len()
-        trgOnWasOffAll = 0.0
-        trgOnWasOffCmp = 0.0
-        trgOffWasOn = 0.0 # should have been off
-        cmpN = 0.0        # completion target
-        trgOnN = 0.0
-        trgOffN = 0.0
-        actMi = ecout.UnitVarIdx("ActM")
-        targi = ecout.UnitVarIdx("Targ")
-        actQ1i = ecout.UnitVarIdx("ActQ1")
-        for ni in range(nn):
-            actm = ecout.UnitVal1D(actMi, ni)
-            trg = ecout.UnitVal1D(targi, ni) # full pattern target
-            inact = ecin.UnitVal1D(actQ1i, ni)
-            if trg < 0.5: # trgOff
-                trgOffN += 1
-                if actm > 0.5:
-                    trgOffWasOn += 1
-            else: # trgOn
-                trgOnN += 1
-                if inact < 0.5: # missing in ECin -- completion target
-                    cmpN += 1
-                    if actm < 0.5:
-                        trgOnWasOffAll += 1
-                        trgOnWasOffCmp += 1
-                else:
-                    if actm < 0.5:
-                        trgOnWasOffAll += 1
-        trgOnWasOffAll /= trgOnN
-        trgOffWasOn /= trgOffN
-        if train: # no cmp
-            if trgOnWasOffAll < ss.MemThr and trgOffWasOn < ss.MemThr:
-                ss.Mem = 1
-            else:
-                ss.Mem = 0
-        else: # test
-            if cmpN > 0: # should be
-                trgOnWasOffCmp /= cmpN
-                if trgOnWasOffCmp < ss.MemThr and trgOffWasOn < ss.MemThr:
-                    ss.Mem = 1
-                else:
-                    ss.Mem = 0
-        ss.TrgOnWasOffAll = trgOnWasOffAll
-        ss.TrgOnWasOffCmp = trgOnWasOffCmp
-        ss.TrgOffWasOn = trgOffWasOn
+        rs = hip.RecallStats()
+        rs.Compute(ecin, ecout, ss.MemThr, not train)
+        ss.Mem = rs.Mem
+        ss.TrgOnWasOffAll = rs.TrgOnWasOffAll
+        ss.TrgOnWasOffCmp = rs.TrgOnWasOffCmp
+        ss.TrgOffWasOn = rs.TrgOffWasOn
 
     def TrialStats(ss, accum):
         """
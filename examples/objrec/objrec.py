@@ -13,7 +13,7 @@
 # recognition that is invariant to changes in position, size, etc of retinal
 # input images.
 
-from leabra import go, leabra, emer, relpos, eplot, env, agg, patgen, prjn, etable, efile, split, etensor, etview, params, netview, rand, erand, gi, giv, pygiv, pyparams, mat32, actrf
+from leabra import go, leabra, emer, relpos, eplot, env, agg, patgen, prjn, etable, efile, split, etensor, etview, params, netview, rand, erand, gi, giv, pygiv, pyparams, mat32, actrf, netbuild
 
 import importlib as il
 import io, sys, getopt
@@ -334,14 +334,15 @@ class Sim(pygiv.ClassViewObj):
 
     def ConfigNet(ss, net):
         net.InitName(net, "Objrec")
-        v1 = net.AddLayer4D("V1", 10, 10, 5, 4, emer.Input)
-        v4 = net.AddLayer4D("V4", 5, 5, 7, 7, emer.Hidden)
-        it = net.AddLayer2D("IT", 10, 10, emer.Hidden)
-        out = net.AddLayer2D("Output", 4, 5, emer.Target)
-
-        net.ConnectLayers(v1, v4, ss.V1V4Prjn, emer.Forward)
-        net.BidirConnectLayersPy(v4, it, prjn.NewFull())
-        net.BidirConnectLayersPy(it, out, prjn.NewFull())
+        nb = netbuild.NewNetBuilder(net)
+        v1 = nb.AddLayer4D("V1", 10, 10, 5, 4, emer.Input)
+        v4 = nb.AddLayer4D("V4", 5, 5, 7, 7, emer.Hidden)
+        it = nb.AddLayer2D("IT", 10, 10, emer.Hidden)
+        out = nb.AddLayer2D("Output", 4, 5, emer.Target)
+
+        nb.ConnectLayers(v1, v4, ss.V1V4Prjn, emer.Forward)
+        nb.BidirConnectLayers(v4, it, prjn.NewFull())
+        nb.BidirConnectLayers(it, out, prjn.NewFull())
         
         v4IT = v4.SendPrjns().RecvName("IT")
         itOut = it.SendPrjns().RecvName("Output")
@@ -0,0 +1,601 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// pcore runs a headless pallidal core (pcore) basal ganglia model on a simple
+// N-alternative action selection task, with reward-driven Matrix learning.
+// Each alternative is represented by a separate BG pool, driven by the
+// ACC input layer, and the action is selected by gating the corresponding
+// VThal pool.  The selected action is rewarded or punished on a subsequent
+// outcome trial, which delivers dopamine to the Matrix layers.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/ccnlab/leabrax/pcore"
+	"github.com/ccnlab/leabrax/rl"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/env"
+	"github.com/emer/emergent/params"
+	"github.com/emer/emergent/prjn"
+	"github.com/emer/emergent/relpos"
+	"github.com/emer/etable/etable"
+	"github.com/emer/etable/etensor"
+)
+
+func main() {
+	TheSim.New()
+	TheSim.Config()
+	TheSim.CmdArgs()
+}
+
+// ParamSets is the default set of parameters -- Base is always applied, and others can be optionally
+// selected to apply on top of that
+var ParamSets = params.Sets{
+	{Name: "Base", Desc: "these are the best params", Sheets: params.Sheets{
+		"Network": &params.Sheet{
+			{Sel: "Layer", Desc: "generic params for all layers: lower gain, slower, soft clamp",
+				Params: params.Params{
+					"Layer.Inhib.Layer.On": "false",
+					"Layer.Act.XX1.Gain":   "20",
+				}},
+			{Sel: "MatrixLayer", Desc: "",
+				Params: params.Params{
+					"Layer.Inhib.Layer.On": "true",
+				}},
+			{Sel: ".ACCToMtx", Desc: "ACC drives Matrix Go and NoGo",
+				Params: params.Params{
+					"Prjn.Learn.Lrate": "0.1",
+					"Prjn.WtInit.Mean": "0.5",
+					"Prjn.WtInit.Var":  "0.05",
+				}},
+			{Sel: ".ACCToSTN", Desc: "ACC drives STN -- sum over all alternatives provides the conflict signal",
+				Params: params.Params{
+					"Prjn.WtInit.Mean": "0.9",
+					"Prjn.WtInit.Var":  "0",
+					"Prjn.Learn.Learn": "false",
+					"Prjn.WtScale.Abs": "2",
+				}},
+		},
+	}},
+}
+
+// Sim encapsulates the entire simulation model, and we define all the
+// functionality as methods on this struct.  This structure keeps all relevant
+// state information organized and available without having to pass everything around
+// as arguments to methods, and provides the core GUI interface (note the view tags
+// for the fields which provide hints to how things should be displayed).
+type Sim struct {
+	Net          *pcore.Network      `desc:"the network -- click to view / edit parameters for layers, prjns, etc"`
+	Params       params.Sets         `desc:"full collection of param sets"`
+	ParamSet     string              `desc:"which set of *additional* parameters to use -- always applies Base and optionaly this next if set -- can use multiple names separated by spaces (don't put spaces in ParamSet names!)"`
+	Tag          string              `desc:"extra tag string to add to any file names output from sim (e.g., weights files, log files, params for run)"`
+	NPools       int                 `desc:"number of action alternatives, each with its own BG pool"`
+	NUnitsY      int                 `desc:"number of Matrix units per pool in Y dim"`
+	NUnitsX      int                 `desc:"number of Matrix units per pool in X dim"`
+	MaxRuns      int                 `desc:"maximum number of model runs to perform"`
+	MaxEpcs      int                 `desc:"maximum number of epochs to run per model run"`
+	RndSeed      int64               `desc:"the current random seed"`
+	TrainEnv     ActSelEnv           `desc:"Training environment -- contains everything about iterating over input / output patterns over training"`
+	Time         leabra.Time         `desc:"leabra timing parameters and state"`
+	GateMon      pcore.GatingMonitor `desc:"monitors VThal gating on each gating trial"`
+	TrnTrlLog    *etable.Table       `view:"no-inline" desc:"training trial-level log data, including gating results"`
+	TrnEpcLog    *etable.Table       `view:"no-inline" desc:"training epoch-level log data"`
+	LogSetParams bool                `view:"-" desc:"if true, print message for all params that are set"`
+
+	// statistics: note use float64 as that is best for etable.Table
+	TrlChoice   int       `inactive:"+" desc:"action selected on current trial, -1 if no pool gated"`
+	TrlGateCyc  int       `inactive:"+" desc:"cycle on which the selected VThal pool first exceeded the gating threshold on current trial, -1 if no gating"`
+	TrlRew      float64   `inactive:"+" desc:"reward outcome on current trial"`
+	TrlCor      float64   `inactive:"+" desc:"1 if the choice on current trial was correct: selecting the Target when offered, and not selecting any other alternative"`
+	TrlThalMax  []float32 `inactive:"+" desc:"maximum VThal activation for each pool over the gating trial"`
+	EpcPctCor   float64   `inactive:"+" desc:"last epoch's proportion of trials with a correct choice"`
+	EpcPctGated float64   `inactive:"+" desc:"last epoch's proportion of trials on which any action was selected"`
+	EpcGateCyc  float64   `inactive:"+" desc:"last epoch's average gating cycle, over gated trials"`
+	SumCor      float64   `view:"-" inactive:"+" desc:"sum to increment as we go through epoch"`
+	SumGated    float64   `view:"-" inactive:"+" desc:"sum to increment as we go through epoch"`
+	SumGateCyc  float64   `view:"-" inactive:"+" desc:"sum to increment as we go through epoch"`
+
+	// internal state - view:"-"
+	StopNow    bool     `view:"-" desc:"flag to stop running"`
+	TrnEpcFile *os.File `view:"-" desc:"log file"`
+}
+
+// TheSim is the overall state for this simulation
+var TheSim Sim
+
+// New creates new blank elements and initializes defaults
+func (ss *Sim) New() {
+	ss.Net = &pcore.Network{}
+	ss.TrnTrlLog = &etable.Table{}
+	ss.TrnEpcLog = &etable.Table{}
+	ss.Params = ParamSets
+	ss.NPools = 4
+	ss.NUnitsY = 2
+	ss.NUnitsX = 2
+	ss.RndSeed = 1
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////
+// 		Configs
+
+// Config configures all the elements using the standard functions
+func (ss *Sim) Config() {
+	ss.ConfigEnv()
+	ss.ConfigNet(ss.Net)
+	ss.ConfigTrnTrlLog(ss.TrnTrlLog)
+	ss.ConfigTrnEpcLog(ss.TrnEpcLog)
+}
+
+func (ss *Sim) ConfigEnv() {
+	if ss.MaxRuns == 0 { // allow user override
+		ss.MaxRuns = 1
+	}
+	if ss.MaxEpcs == 0 { // allow user override
+		ss.MaxEpcs = 10
+	}
+
+	ss.TrainEnv.Nm = "TrainEnv"
+	ss.TrainEnv.Dsc = "training params and state"
+	ss.TrainEnv.Config(ss.NPools, 20)
+	ss.TrainEnv.Validate()
+	ss.TrainEnv.Run.Max = ss.MaxRuns // note: we are not setting epoch max -- do that manually
+
+	ss.TrainEnv.Init(0)
+}
+
+func (ss *Sim) ConfigNet(net *pcore.Network) {
+	net.InitName(net, "PCore")
+	np := ss.NPools
+	space := float32(2)
+
+	snc := rl.AddClampDaLayer(&net.Network, "SNc")
+	mtxGo, mtxNo, cin, _, _, _, stnp, stns, _, vthal := net.AddBG("", 1, np, ss.NUnitsY, ss.NUnitsX, space)
+	cin.(*pcore.CINLayer).RewLays.Add(snc.Name())
+
+	acc := net.AddLayer4D("ACC", 1, np, 1, 1, emer.Input)
+	acc.SetRelPos(relpos.Rel{Rel: relpos.Above, Other: mtxGo.Name(), YAlign: relpos.Front, XAlign: relpos.Left, YOffset: 1})
+	snc.SetRelPos(relpos.Rel{Rel: relpos.RightOf, Other: acc.Name(), YAlign: relpos.Front, Space: space})
+
+	pone2one := prjn.NewPoolOneToOne()
+	full := prjn.NewFull()
+
+	pj := net.ConnectToMatrix(acc, mtxGo, pone2one)
+	pj.SetClass("ACCToMtx")
+	pj = net.ConnectToMatrix(acc, mtxNo, pone2one)
+	pj.SetClass("ACCToMtx")
+	pj = net.ConnectLayers(acc, stnp, full, emer.Forward)
+	pj.SetClass("ACCToSTN")
+	pj = net.ConnectLayers(acc, stns, full, emer.Forward)
+	pj.SetClass("ACCToSTN")
+	pj = net.ConnectLayers(acc, vthal, pone2one, emer.Forward)
+	pj.SetClass("ACCToVThal")
+
+	snc.SendDA.AddAllBut(net, nil)
+
+	net.Defaults()
+	ss.SetParams("Network", ss.LogSetParams) // only set Network params
+	err := net.Build()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	net.InitWts()
+	ss.GateMon.Config(vthal.(*pcore.VThalLayer))
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 	    Init, utils
+
+// Init restarts the run, and initializes everything, including network weights
+// and resets the epoch log table
+func (ss *Sim) Init() {
+	rand.Seed(ss.RndSeed)
+	ss.ConfigEnv()
+	ss.StopNow = false
+	ss.SetParams("", ss.LogSetParams) // all sheets
+	ss.NewRun()
+}
+
+// Counters returns a string of the current counter state
+func (ss *Sim) Counters() string {
+	return fmt.Sprintf("Run:\t%d\tEpoch:\t%d\tTrial:\t%d\tCycle:\t%d\tName:\t%v\t\t\t", ss.TrainEnv.Run.Cur, ss.TrainEnv.Epoch.Cur, ss.TrainEnv.Trial.Cur, ss.Time.Cycle, ss.TrainEnv.String())
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 	    Running the Network, starting bottom-up..
+
+// AlphaCyc runs one alpha-cycle (100 msec, 4 quarters) of processing.
+// External inputs must have already been applied prior to calling,
+// using ApplyInputs method.  If train is true, then learning DWt
+// is computed at the end of the trial.  If gate is true, the gating
+// results are recorded by GateMon, and in the trial stats.
+func (ss *Sim) AlphaCyc(train, gate bool) {
+	if gate {
+		ss.GateMon.Init()
+	}
+	if train {
+		ss.Net.WtFmDWt()
+	}
+
+	ss.Net.AlphaCycInit()
+	ss.Time.AlphaCycStart()
+	for qtr := 0; qtr < 4; qtr++ {
+		for cyc := 0; cyc < ss.Time.CycPerQtr; cyc++ {
+			ss.Net.Cycle(&ss.Time)
+			if gate {
+				ss.GateMon.Cycle(&ss.Time)
+			}
+			ss.Time.CycleInc()
+		}
+		ss.Net.QuarterFinal(&ss.Time)
+		ss.Time.QuarterInc()
+	}
+	if gate {
+		// the chosen action is the first VThal pool to gate
+		ss.GateMon.TrialEnd()
+		ss.TrlChoice = ss.GateMon.Pool
+		ss.TrlGateCyc = ss.GateMon.RT
+		ss.TrlThalMax = append(ss.TrlThalMax[:0], ss.GateMon.MaxAct...)
+	}
+
+	if train {
+		ss.Net.DWt()
+	}
+}
+
+// ApplyInputs applies given drives to the ACC layer, and given dopamine
+// value to the SNc layer.  nil drives turns the ACC input off.
+func (ss *Sim) ApplyInputs(drives etensor.Tensor, da float32) {
+	ss.Net.InitActs() // each trial starts from the tonic baseline state, with no inputs
+
+	acc := ss.Net.LayerByName("ACC").(leabra.LeabraLayer).AsLeabra()
+	if drives != nil {
+		acc.ApplyExt(drives)
+	}
+	snc := ss.Net.LayerByName("SNc").(leabra.LeabraLayer).AsLeabra()
+	snc.ApplyExt1D32([]float32{da})
+}
+
+// GateTrial runs a gating trial with given drives for each alternative, with no
+// dopamine, and returns the selected action (-1 if none).
+// If train is true, the Matrix synaptic traces are updated for later
+// dopamine-driven learning on the outcome trial.
+func (ss *Sim) GateTrial(drives etensor.Tensor, train bool) int {
+	ss.ApplyInputs(drives, 0)
+	ss.AlphaCyc(train, true)
+	return ss.TrlChoice
+}
+
+// OutcomeTrial runs an outcome trial delivering given reward value as
+// dopamine, with no ACC input, which drives learning in the Matrix
+// layers based on the traces established on the prior gating trial.
+func (ss *Sim) OutcomeTrial(rew float32) {
+	ss.ApplyInputs(nil, rew)
+	ss.AlphaCyc(true, false)
+}
+
+// TrainTrial runs one gating trial followed by its outcome trial, with
+// learning, and updates the stats.
+func (ss *Sim) TrainTrial() {
+	ss.TrainEnv.Step() // the Env encapsulates and manages all counter state
+
+	// Key to query counters FIRST because current state is in NEXT epoch
+	// if epoch counter has changed
+	epc, _, chg := ss.TrainEnv.Counter(env.Epoch)
+	if chg {
+		ss.LogTrnEpc(ss.TrnEpcLog)
+		if epc >= ss.MaxEpcs { // done with training..
+			ss.RunEnd()
+			if ss.TrainEnv.Run.Incr() { // we are done!
+				ss.StopNow = true
+				return
+			}
+			ss.NewRun()
+			return
+		}
+	}
+
+	choice := ss.GateTrial(&ss.TrainEnv.Drives, true)
+	ss.TrainEnv.Action("Choice", etensor.NewFloat64Shape(etensor.NewShape([]int{1}, nil, nil), []float64{float64(choice)}))
+	ss.TrlRew = float64(ss.TrainEnv.Rew)
+	ss.OutcomeTrial(ss.TrainEnv.Rew)
+	ss.TrialStats(true)
+	ss.LogTrnTrl(ss.TrnTrlLog)
+}
+
+// RunEnd is called at the end of a run -- save weights, record final log, etc here
+func (ss *Sim) RunEnd() {
+}
+
+// NewRun intializes a new run of the model, using the TrainEnv.Run counter
+// for the new run value
+func (ss *Sim) NewRun() {
+	run := ss.TrainEnv.Run.Cur
+	ss.TrainEnv.Init(run)
+	ss.Time.Reset()
+	ss.Net.InitWts()
+	ss.InitStats()
+	ss.TrnTrlLog.SetNumRows(0)
+	ss.TrnEpcLog.SetNumRows(0)
+}
+
+// InitStats initializes all the statistics, especially important for the
+// cumulative epoch stats -- called at start of new run
+func (ss *Sim) InitStats() {
+	ss.SumCor = 0
+	ss.SumGated = 0
+	ss.SumGateCyc = 0
+	ss.TrlChoice = -1
+	ss.TrlGateCyc = -1
+	ss.EpcPctCor = 0
+	ss.EpcPctGated = 0
+	ss.EpcGateCyc = 0
+}
+
+// TrialStats computes the trial-level statistics and adds them to the epoch accumulators if
+// accum is true.
+func (ss *Sim) TrialStats(accum bool) {
+	ss.TrlCor = 0
+	if ss.TrainEnv.IsCorrect() {
+		ss.TrlCor = 1
+	}
+	if !accum {
+		return
+	}
+	ss.SumCor += ss.TrlCor
+	if ss.TrlChoice >= 0 {
+		ss.SumGated++
+		ss.SumGateCyc += float64(ss.TrlGateCyc)
+	}
+}
+
+// TrainEpoch runs training trials for remainder of this epoch
+func (ss *Sim) TrainEpoch() {
+	curEpc := ss.TrainEnv.Epoch.Cur
+	for {
+		ss.TrainTrial()
+		if ss.TrainEnv.Epoch.Cur != curEpc {
+			break
+		}
+	}
+}
+
+// TrainRun runs training trials for remainder of run
+func (ss *Sim) TrainRun() {
+	curRun := ss.TrainEnv.Run.Cur
+	for {
+		ss.TrainTrial()
+		if ss.TrainEnv.Run.Cur != curRun {
+			break
+		}
+	}
+}
+
+// Train runs the full training from this point onward
+func (ss *Sim) Train() {
+	ss.StopNow = false
+	for {
+		ss.TrainTrial()
+		if ss.StopNow {
+			break
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////
+// 		Testing
+
+// TestDrives runs a gating trial without learning, using given drive values
+// for each alternative, and returns the selected action (-1 if none)
+// and the cycle at which it was gated.
+func (ss *Sim) TestDrives(drives ...float32) (choice, gateCyc int) {
+	tsr := etensor.NewFloat32([]int{1, ss.NPools, 1, 1}, nil, nil)
+	copy(tsr.Values, drives)
+	ss.GateTrial(tsr, false)
+	return ss.TrlChoice, ss.TrlGateCyc
+}
+
+/////////////////////////////////////////////////////////////////////////
+//   Params setting
+
+// ParamsName returns name of current set of parameters
+func (ss *Sim) ParamsName() string {
+	if ss.ParamSet == "" {
+		return "Base"
+	}
+	return ss.ParamSet
+}
+
+// SetParams sets the params for "Base" and then current ParamSet.
+// If sheet is empty, then it applies all avail sheets (e.g., Network, Sim)
+// otherwise just the named sheet
+// if setMsg = true then we output a message for each param that was set.
+func (ss *Sim) SetParams(sheet string, setMsg bool) error {
+	if sheet == "" {
+		// this is important for catching typos and ensuring that all sheets can be used
+		ss.Params.ValidateSheets([]string{"Network", "Sim"})
+	}
+	err := ss.SetParamsSet("Base", sheet, setMsg)
+	if ss.ParamSet != "" && ss.ParamSet != "Base" {
+		err = ss.SetParamsSet(ss.ParamSet, sheet, setMsg)
+	}
+	return err
+}
+
+// SetParamsSet sets the params for given params.Set name.
+// If sheet is empty, then it applies all avail sheets (e.g., Network, Sim)
+// otherwise just the named sheet
+// if setMsg = true then we output a message for each param that was set.
+func (ss *Sim) SetParamsSet(setNm string, sheet string, setMsg bool) error {
+	pset, err := ss.Params.SetByNameTry(setNm)
+	if err != nil {
+		return err
+	}
+	if sheet == "" || sheet == "Network" {
+		netp, ok := pset.Sheets["Network"]
+		if ok {
+			ss.Net.ApplyParams(netp, setMsg)
+		}
+	}
+
+	if sheet == "" || sheet == "Sim" {
+		simp, ok := pset.Sheets["Sim"]
+		if ok {
+			simp.Apply(ss, setMsg)
+		}
+	}
+	return err
+}
+
+//////////////////////////////////////////////
+//  TrnTrlLog
+
+// LogTrnTrl adds data from current trial to the TrnTrlLog table,
+// including the gating results from GateMon.
+func (ss *Sim) LogTrnTrl(dt *etable.Table) {
+	row := dt.Rows
+	dt.SetNumRows(row + 1)
+
+	dt.SetCellFloat("Run", row, float64(ss.TrainEnv.Run.Cur))
+	dt.SetCellFloat("Epoch", row, float64(ss.TrainEnv.Epoch.Cur))
+	dt.SetCellFloat("Trial", row, float64(ss.TrainEnv.Trial.Cur))
+	dt.SetCellFloat("Offer", row, float64(ss.TrainEnv.Offer))
+	dt.SetCellFloat("Rew", row, ss.TrlRew)
+	dt.SetCellFloat("Cor", row, ss.TrlCor)
+	ss.GateMon.LogTrial(dt, row)
+}
+
+func (ss *Sim) ConfigTrnTrlLog(dt *etable.Table) {
+	dt.SetMetaData("name", "TrnTrlLog")
+	dt.SetMetaData("desc", "Record of gating over trials of training")
+	dt.SetMetaData("read-only", "true")
+	dt.SetMetaData("precision", "4")
+
+	sch := etable.Schema{
+		{"Run", etensor.INT64, nil, nil},
+		{"Epoch", etensor.INT64, nil, nil},
+		{"Trial", etensor.INT64, nil, nil},
+		{"Offer", etensor.INT64, nil, nil},
+		{"Rew", etensor.FLOAT64, nil, nil},
+		{"Cor", etensor.FLOAT64, nil, nil},
+	}
+	sch = append(sch, ss.GateMon.LogSchema()...)
+	dt.SetFromSchema(sch, 0)
+}
+
+//////////////////////////////////////////////
+//  TrnEpcLog
+
+// RunName returns a name for this run that combines Tag and Params -- add this to
+// any file names that are saved.
+func (ss *Sim) RunName() string {
+	if ss.Tag != "" {
+		return ss.Tag + "_" + ss.ParamsName()
+	}
+	return ss.ParamsName()
+}
+
+// LogFileName returns default log file name
+func (ss *Sim) LogFileName(lognm string) string {
+	return ss.Net.Nm + "_" + ss.RunName() + "_" + lognm + ".tsv"
+}
+
+// LogTrnEpc adds data from current epoch to the TrnEpcLog table.
+// computes epoch averages prior to logging.
+func (ss *Sim) LogTrnEpc(dt *etable.Table) {
+	row := dt.Rows
+	dt.SetNumRows(row + 1)
+
+	epc := ss.TrainEnv.Epoch.Prv         // this is triggered by increment so use previous value
+	nt := float64(ss.TrainEnv.Trial.Max) // number of trials in view
+
+	ss.EpcPctCor = ss.SumCor / nt
+	ss.EpcPctGated = ss.SumGated / nt
+	ss.EpcGateCyc = 0
+	if ss.SumGated > 0 {
+		ss.EpcGateCyc = ss.SumGateCyc / ss.SumGated
+	}
+	ss.SumCor = 0
+	ss.SumGated = 0
+	ss.SumGateCyc = 0
+
+	dt.SetCellFloat("Run", row, float64(ss.TrainEnv.Run.Cur))
+	dt.SetCellFloat("Epoch", row, float64(epc))
+	dt.SetCellFloat("PctCor", row, ss.EpcPctCor)
+	dt.SetCellFloat("PctGated", row, ss.EpcPctGated)
+	dt.SetCellFloat("GateCyc", row, ss.EpcGateCyc)
+
+	if ss.TrnEpcFile != nil {
+		if ss.TrainEnv.Run.Cur == 0 && epc == 0 {
+			dt.WriteCSVHeaders(ss.TrnEpcFile, etable.Tab)
+		}
+		dt.WriteCSVRow(ss.TrnEpcFile, row, etable.Tab)
+	}
+}
+
+func (ss *Sim) ConfigTrnEpcLog(dt *etable.Table) {
+	dt.SetMetaData("name", "TrnEpcLog")
+	dt.SetMetaData("desc", "Record of performance over epochs of training")
+	dt.SetMetaData("read-only", "true")
+	dt.SetMetaData("precision", "4")
+
+	sch := etable.Schema{
+		{"Run", etensor.INT64, nil, nil},
+		{"Epoch", etensor.INT64, nil, nil},
+		{"PctCor", etensor.FLOAT64, nil, nil},
+		{"PctGated", etensor.FLOAT64, nil, nil},
+		{"GateCyc", etensor.FLOAT64, nil, nil},
+	}
+	dt.SetFromSchema(sch, 0)
+}
+
+// CmdArgs processes the command-line arguments and runs the model
+func (ss *Sim) CmdArgs() {
+	var saveEpcLog bool
+	var note string
+	flag.StringVar(&ss.ParamSet, "params", "", "ParamSet name to use -- must be valid name as listed in compiled-in params or loaded params")
+	flag.StringVar(&ss.Tag, "tag", "", "extra tag to add to file names saved from this run")
+	flag.StringVar(&note, "note", "", "user note -- describe the run params etc")
+	flag.IntVar(&ss.MaxRuns, "runs", 1, "number of runs to do")
+	flag.IntVar(&ss.MaxEpcs, "epcs", 10, "number of epochs per run")
+	flag.Int64Var(&ss.RndSeed, "seed", 1, "random seed")
+	flag.BoolVar(&ss.LogSetParams, "setparams", false, "if true, print a record of each parameter that is set")
+	flag.BoolVar(&saveEpcLog, "epclog", false, "if true, save train epoch log to file")
+	flag.Parse()
+	ss.Init()
+
+	if note != "" {
+		fmt.Printf("note: %s\n", note)
+	}
+	if ss.ParamSet != "" {
+		fmt.Printf("Using ParamSet: %s\n", ss.ParamSet)
+	}
+
+	if saveEpcLog {
+		var err error
+		fnm := ss.LogFileName("epc")
+		ss.TrnEpcFile, err = os.Create(fnm)
+		if err != nil {
+			log.Println(err)
+			ss.TrnEpcFile = nil
+		} else {
+			fmt.Printf("Saving epoch log to: %s\n", fnm)
+			defer ss.TrnEpcFile.Close()
+		}
+	}
+	fmt.Printf("Running %d Runs\n", ss.MaxRuns)
+	ss.Train()
+	for row := 0; row < ss.TrnEpcLog.Rows; row++ {
+		fmt.Printf("Run: %d\tEpoch: %d\tPctCor: %.3f\tPctGated: %.3f\tGateCyc: %.1f\n",
+			int(ss.TrnEpcLog.CellFloat("Run", row)), int(ss.TrnEpcLog.CellFloat("Epoch", row)),
+			ss.TrnEpcLog.CellFloat("PctCor", row), ss.TrnEpcLog.CellFloat("PctGated", row),
+			ss.TrnEpcLog.CellFloat("GateCyc", row))
+	}
+}
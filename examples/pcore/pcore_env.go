@@ -0,0 +1,149 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/emer/emergent/env"
+	"github.com/emer/etable/etensor"
+)
+
+// ActSelEnv is a simple N-alternative action selection environment.
+// Each training trial offers one alternative, by setting its drive level
+// (one per BG pool), and the model selects it by gating the corresponding pool,
+// or not.  Selecting the Target action is rewarded with probability RewP,
+// and selecting any other action is punished.  Not selecting
+// has no outcome.
+type ActSelEnv struct {
+	Nm       string          `desc:"name of this environment"`
+	Dsc      string          `desc:"description of this environment"`
+	NAlts    int             `desc:"number of action alternatives"`
+	Target   int             `desc:"index of the action that is rewarded"`
+	RewP     float32         `desc:"probability of reward for selecting the Target action"`
+	DriveMin float32         `desc:"minimum drive level for the offered alternative"`
+	DriveMax float32         `desc:"maximum drive level for the offered alternative -- drives are uniformly distributed between Min and Max on each trial"`
+	Offer    int             `desc:"alternative offered on the current trial"`
+	Drives   etensor.Float32 `desc:"drive input for each alternative, shaped as BG pools: [1, NAlts, 1, 1]"`
+	Choice   int             `desc:"action selected by the model on the current trial, -1 for none"`
+	Rew      float32         `desc:"reward outcome for the current trial: 1 = reward, -1 = punishment, 0 = no action"`
+	Run      env.Ctr         `view:"inline" desc:"current run of model as provided during Init"`
+	Epoch    env.Ctr         `view:"inline" desc:"number of times through Trial.Max number of trials"`
+	Trial    env.Ctr         `view:"inline" desc:"trial counter"`
+}
+
+func (ev *ActSelEnv) Name() string { return ev.Nm }
+func (ev *ActSelEnv) Desc() string { return ev.Dsc }
+
+// Config sets the number of alternatives and trials per epoch, and configures the states
+func (ev *ActSelEnv) Config(nalts int, ntrls int) {
+	ev.NAlts = nalts
+	ev.Trial.Max = ntrls
+	if ev.RewP == 0 {
+		ev.RewP = 1
+	}
+	if ev.DriveMax == 0 {
+		ev.DriveMin = 0.8
+		ev.DriveMax = 1
+	}
+	ev.Drives.SetShape([]int{1, nalts, 1, 1}, nil, []string{"PY", "PX", "NY", "NX"})
+}
+
+func (ev *ActSelEnv) Validate() error {
+	if ev.NAlts == 0 {
+		return fmt.Errorf("ActSelEnv: %v has NAlts == 0 -- need to Config", ev.Nm)
+	}
+	if ev.Target < 0 || ev.Target >= ev.NAlts {
+		return fmt.Errorf("ActSelEnv: %v Target: %d out of range for NAlts: %d", ev.Nm, ev.Target, ev.NAlts)
+	}
+	return nil
+}
+
+func (ev *ActSelEnv) State(element string) etensor.Tensor {
+	switch element {
+	case "Drives":
+		return &ev.Drives
+	}
+	return nil
+}
+
+// String returns the current state as a string
+func (ev *ActSelEnv) String() string {
+	return fmt.Sprintf("Offer_%d_Act_%d_Rew_%g", ev.Offer, ev.Choice, ev.Rew)
+}
+
+// IsCorrect returns true if the current choice is the correct response
+// to the offer: selecting the Target, or not selecting any other alternative.
+func (ev *ActSelEnv) IsCorrect() bool {
+	if ev.Offer == ev.Target {
+		return ev.Choice == ev.Target
+	}
+	return ev.Choice < 0
+}
+
+// Init is called to restart environment
+func (ev *ActSelEnv) Init(run int) {
+	ev.Run.Scale = env.Run
+	ev.Epoch.Scale = env.Epoch
+	ev.Trial.Scale = env.Trial
+	ev.Run.Init()
+	ev.Epoch.Init()
+	ev.Trial.Init()
+	ev.Run.Cur = run
+	ev.Trial.Cur = -1 // init state -- key so that first Step() = 0
+	ev.Choice = -1
+	ev.Rew = 0
+}
+
+// NewOffer selects a random alternative to offer, with a random drive
+// between DriveMin and DriveMax, and no drive for the other alternatives.
+func (ev *ActSelEnv) NewOffer() {
+	ev.Offer = rand.Intn(ev.NAlts)
+	ev.Drives.SetZeros()
+	ev.Drives.Values[ev.Offer] = ev.DriveMin + (ev.DriveMax-ev.DriveMin)*rand.Float32()
+}
+
+// Step is called to advance the environment state
+func (ev *ActSelEnv) Step() bool {
+	ev.Epoch.Same() // good idea to just reset all non-inner-most counters at start
+	ev.NewOffer()
+	ev.Choice = -1
+	ev.Rew = 0
+	if ev.Trial.Incr() { // true if wraps around Max back to 0
+		ev.Epoch.Incr()
+	}
+	return true
+}
+
+// Action records the action selected by the model, as the index of the
+// gated pool in the first value of the input tensor (-1 = none),
+// and computes the resulting reward outcome.
+func (ev *ActSelEnv) Action(element string, input etensor.Tensor) {
+	ev.Choice = int(input.FloatVal1D(0))
+	switch {
+	case ev.Choice < 0:
+		ev.Rew = 0
+	case ev.Choice == ev.Target && rand.Float32() < ev.RewP:
+		ev.Rew = 1
+	default:
+		ev.Rew = -1
+	}
+}
+
+func (ev *ActSelEnv) Counter(scale env.TimeScales) (cur, prv int, chg bool) {
+	switch scale {
+	case env.Run:
+		return ev.Run.Query()
+	case env.Epoch:
+		return ev.Epoch.Query()
+	case env.Trial:
+		return ev.Trial.Query()
+	}
+	return -1, -1, false
+}
+
+// Compile-time check that implements Env interface
+var _ env.Env = (*ActSelEnv)(nil)
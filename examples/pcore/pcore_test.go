@@ -0,0 +1,112 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
+)
+
+func newTestSim() *Sim {
+	ss := &Sim{}
+	ss.New()
+	ss.Config()
+	ss.Init()
+	return ss
+}
+
+// TestSelect checks that the most strongly driven alternative is selected.
+func TestSelect(t *testing.T) {
+	ss := newTestSim()
+	tests := []struct {
+		drives []float32
+		choice int
+	}{
+		{[]float32{0, 0, 0, .9}, 3},
+		{[]float32{.2, .9, .5, .3}, 1},
+		{[]float32{.1, .9, .4, .2}, 1},
+		{[]float32{.4, 1, .3, .2}, 1},
+		{[]float32{.3, .3, .3, .9}, 3},
+	}
+	for _, tt := range tests {
+		choice, gcyc := ss.TestDrives(tt.drives...)
+		if choice != tt.choice {
+			t.Errorf("drives: %v choice: %d != %d", tt.drives, choice, tt.choice)
+		}
+		if choice >= 0 && gcyc <= 0 {
+			t.Errorf("drives: %v gated without gating cycle: %d", tt.drives, gcyc)
+		}
+	}
+}
+
+// TestLearn checks that training shifts selection toward the rewarded action.
+func TestLearn(t *testing.T) {
+	ss := newTestSim()
+	trg := ss.TrainEnv.Target
+	pair := func(alt int) []float32 {
+		drives := make([]float32, ss.NPools)
+		drives[trg] = .6
+		drives[alt] = .6
+		return drives
+	}
+	ss.Train()
+	tl := ss.TrnTrlLog
+	if tl.Rows != ss.MaxEpcs*ss.TrainEnv.Trial.Max {
+		t.Errorf("TrnTrlLog rows: %d != %d", tl.Rows, ss.MaxEpcs*ss.TrainEnv.Trial.Max)
+	}
+	for row := 0; row < tl.Rows; row++ {
+		failed := tl.CellFloat("GateFailed", row) == 1
+		if failed != (tl.CellFloat("GatePool", row) < 0) || failed != (tl.CellFloat("GateRT", row) < 0) {
+			t.Errorf("TrnTrlLog row: %d inconsistent gating results", row)
+		}
+	}
+	lg := ss.TrnEpcLog
+	first := lg.CellFloat("PctCor", 0)
+	last := lg.CellFloat("PctCor", lg.Rows-1)
+	if last <= first {
+		t.Errorf("PctCor did not improve with training: first: %g last: %g", first, last)
+	}
+	for alt := 0; alt < ss.NPools; alt++ {
+		if alt == trg {
+			continue
+		}
+		drives := pair(alt)
+		choice, _ := ss.TestDrives(drives...)
+		if choice != trg {
+			t.Errorf("after training, drives: %v choice: %d != Target: %d", drives, choice, trg)
+		}
+	}
+}
+
+// TestSTNStop checks that high conflict across alternatives prevents gating,
+// and that this stopping depends on the STN projections to GPi.
+func TestSTNStop(t *testing.T) {
+	ss := newTestSim()
+	low := []float32{0, 0, 0, .9}
+	highs := [][]float32{{.6, .6, .6, .9}, {.8, .8, .8, .8}}
+	if choice, _ := ss.TestDrives(low...); choice != 3 {
+		t.Errorf("low conflict drives: %v choice: %d != 3", low, choice)
+	}
+	for _, drives := range highs {
+		if choice, _ := ss.TestDrives(drives...); choice >= 0 {
+			t.Errorf("high conflict drives: %v gated: %d, should stop", drives, choice)
+		}
+	}
+
+	gpi := ss.Net.LayerByName("GPi").(leabra.LeabraLayer).AsLeabra()
+	for _, snm := range []string{"STNp", "STNs"} {
+		pj, err := gpi.RcvPrjns.SendNameTry(snm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pj.(leabra.LeabraPrjn).AsLeabra().Off = true
+	}
+	for _, drives := range highs {
+		if choice, _ := ss.TestDrives(drives...); choice < 0 {
+			t.Errorf("high conflict drives: %v without STN -> GPi did not gate", drives)
+		}
+	}
+}
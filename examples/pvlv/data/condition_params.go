@@ -1,1098 +0,0 @@
-// Copyright (c) 2020, The Emergent Authors. All rights reserved.
-// Use of this source code is governed by a BSD-style
-// license that can be found in the LICENSE file.
-
-package data
-
-// ConditionParams contains settings for one portion of a Run. A ConditionParams refers to a single ConditionParams, along with
-// other information such as the number of times to run each instantiated Block. A single Block can be referenced by many RunBlocks.
-type ConditionParams struct {
-	Nm              string `desc:"identifier for this type of configuration"`
-	Desc            string `desc:"description of this configuration"`
-	TrialBlkNm      string `desc:"trial group name"`
-	FixedProb       bool   `desc:"fixed probability for each trial group"`
-	NIters          int    `desc:"number of iterations to run"`
-	BlocksPerIter   int    `desc:"number of blocks (1 block = one behavioral trial = sequence of CS, US) in each iteration -- needs to be higher if there are stochastic variables (probabilities)."`
-	PermuteTrialGps bool   `desc:"permute list of fully-instantiated trials after generation"`
-	SaveFinalWts    bool   `desc:"save final weights after training"`
-	SaveWtsInterval int    `desc:"how frequently to save weights during training (in blocks)"`
-	TestInterval    int    `desc:"how frequently (blocks) to run a test during training"`
-	LogTrials       bool   `desc:"should trial-level data be saved to log files?"`
-	LoadWeights     bool   `desc:"load initial weights from a file (specified in weights_file)"`
-	WeightsFile     string `desc:"full relative path (from project) of weights file to load -- use CRR: prefix to load from cluster run results directory"`
-	LoadStBlk       int    `desc:"after loading weights, reset block counter to this value (-1 = leave at value from the loaded weights)"`
-	LrsStepBlks     int    `desc:"learning rate schedule blocks per step of decrease in learning rate"`
-	LrsNSteps       int    `desc:"number of steps in the learning rate schedule"`
-	LrsBumpStep     int    `desc:"if positive (3 is typical), then bump up the learning rate at this step in the schedule -- can help improve final performance level"`
-}
-type ConditionParamsMap map[string]ConditionParams
-
-func AllConditionParams() ConditionParamsMap {
-	sets := map[string]ConditionParams{
-		"RunMaster": {
-			Nm:              "RunMaster",
-			Desc:            "default values for basic training parameters -- this is a 'master' param set -- make changes here and all others in group will auto-update",
-			TrialBlkNm:      "PosAcq",
-			FixedProb:       true,
-			NIters:          50,
-			BlocksPerIter:   8,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"NullStep": {
-			Nm:              "NullStep",
-			Desc:            "use for unused steps in sequences",
-			TrialBlkNm:      "BlankTemplate",
-			FixedProb:       true,
-			NIters:          50,
-			BlocksPerIter:   8,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"AutomatedTesting": {
-			Nm:              "AutomatedTesting",
-			Desc:            "This is the startup paramset for automated testing. The individual elements will get reset based on the sub Paramsets",
-			TrialBlkNm:      "PosAcq",
-			FixedProb:       true,
-			NIters:          50,
-			BlocksPerIter:   8,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"USDebug": {
-			Nm:              "USDebug",
-			Desc:            "For debugging, 100% reward, CS A",
-			TrialBlkNm:      "USDebug",
-			FixedProb:       true,
-			NIters:          51,
-			BlocksPerIter:   8,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosAcq_B50": {
-			Nm:              "PosAcq_B50",
-			Desc:            "Pavlovian conditioning w/ positively-valenced US: A_Rf_POS, B at 50%",
-			TrialBlkNm:      "PosAcq_B50",
-			FixedProb:       true,
-			NIters:          51,
-			BlocksPerIter:   8,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosAcq_A50": {
-			Nm:              "PosAcq_A50",
-			Desc:            "Pavlovian conditioning w/ positively-valenced US: A_Rf_POS at 50%",
-			TrialBlkNm:      "PosAcq_A50",
-			FixedProb:       true,
-			NIters:          51,
-			BlocksPerIter:   10,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"US0": {
-			Nm:              "US0",
-			Desc:            "No US at all",
-			TrialBlkNm:      "US0",
-			FixedProb:       true,
-			NIters:          5,
-			BlocksPerIter:   100,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosAcqPreSecondOrder": {
-			Nm:              "PosAcqPreSecondOrder",
-			Desc:            "Pavlovian conditioning w/ positively-valenced US: A_Rf_POS, B at 50%",
-			TrialBlkNm:      "PosAcqPreSecondOrder",
-			FixedProb:       true,
-			NIters:          51,
-			BlocksPerIter:   8,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosAcq_B50Cont": {
-			Nm:              "PosAcq_B50Cont",
-			Desc:            "Pavlovian conditioning w/ positively-valenced US: A_Rf_POS, B at 50% reinf, continue using prior weights",
-			TrialBlkNm:      "PosReacq",
-			FixedProb:       true,
-			NIters:          50,
-			BlocksPerIter:   8,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_cel_AB_POS_trn.00_0050.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosAcq_B100": {
-			Nm:              "PosAcq_B100",
-			Desc:            "Pavlovian conditioning w/ positively-valenced US: A_Rf_POS, B at 100%",
-			TrialBlkNm:      "PosAcq_B100",
-			FixedProb:       true,
-			NIters:          50,
-			BlocksPerIter:   8,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosAcq_B100Cont": {
-			Nm:              "PosAcq_B100Cont",
-			Desc:            "Pavlovian conditioning w/ positively-valenced US: A_Rf_POS -- continue w/ wts",
-			TrialBlkNm:      "PosAcq_B100_cont",
-			FixedProb:       true,
-			NIters:          50,
-			BlocksPerIter:   8,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_cel_AB_POS_trn.00_0050.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosAcqEarlyUS_test": {
-			Nm:              "PosAcqEarlyUS_test",
-			Desc:            "Testing session: after pos_acq trng, deliver US early or late",
-			TrialBlkNm:      "PosAcqEarlyUS_test",
-			FixedProb:       true,
-			NIters:          5,
-			BlocksPerIter:   2,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_cel_AB_POS_trn.00_0050.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosAcq_B25": {
-			Nm:              "PosAcq_B25",
-			Desc:            "Pavlovian conditioning w/ positively-valenced US: A_Rf_POS",
-			TrialBlkNm:      "PosAcq_B25",
-			FixedProb:       true,
-			NIters:          200,
-			BlocksPerIter:   8,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosExtinct": {
-			Nm:              "PosExtinct",
-			Desc:            "Pavlovian extinction: A_NRf_POS",
-			TrialBlkNm:      "PosExtinct",
-			FixedProb:       false,
-			NIters:          50,
-			BlocksPerIter:   8,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/PVLVNet_cel_AB_POS_trn.00_0050.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosCondInhib": {
-			Nm:              "PosCondInhib",
-			Desc:            "conditioned inhibition training: AX_NRf_POS, A_Rf_POS interleaved",
-			TrialBlkNm:      "PosCondInhib",
-			FixedProb:       false,
-			NIters:          25,
-			BlocksPerIter:   8,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_cel_AB_POS_trn.00_0050.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosSecondOrderCond": {
-			Nm:              "PosSecondOrderCond",
-			Desc:            "second order conditioning training: AB_NRf_POS, A_Rf_POS interleaved; A = 1st order, F = 2nd order CS",
-			TrialBlkNm:      "PosSecondOrderCond",
-			FixedProb:       false,
-			NIters:          10,
-			BlocksPerIter:   50,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_cel_AB_POS_trn.00_0050.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosCondInhib_test": {
-			Nm:              "PosCondInhib_test",
-			Desc:            "Testing session: A_NRf_POS, AX_NRf_POS, and X_NRf_POS cases",
-			TrialBlkNm:      "PosCondInhib_test",
-			FixedProb:       false,
-			NIters:          5,
-			BlocksPerIter:   6,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_cel_AB_POS_cond_inhib_trn.00_0050.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"NegAcq": {
-			Nm:              "NegAcq",
-			Desc:            "Pavlovian conditioning w/ negatively-valenced US: D_Rf_NEG",
-			TrialBlkNm:      "NegAcq",
-			FixedProb:       false,
-			NIters:          76,
-			BlocksPerIter:   10,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"NegAcqFixedProb": {
-			Nm:              "NegAcqFixedProb",
-			Desc:            "Pavlovian conditioning w/ negatively-valenced US: A_Rf_NEG",
-			TrialBlkNm:      "NegAcq",
-			FixedProb:       true,
-			NIters:          150,
-			BlocksPerIter:   8,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosAcqOmit": {
-			Nm:              "PosAcqOmit",
-			Desc:            "Pavlovian conditioning w/ positively-valenced US: A_Rf_POS, A_Rf_POS_omit trials, interleaved",
-			TrialBlkNm:      "PosAcqOmit",
-			FixedProb:       false,
-			NIters:          10,
-			BlocksPerIter:   0,
-			PermuteTrialGps: false,
-			SaveFinalWts:    true,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"NegCondInh": {
-			Nm:              "NegCondInh",
-			Desc:            "condition inhibition w/ negatively-valenced US: CZ_NRf_NEG, C_Rf_NEG interleaved; i.e.,  Z = security signal",
-			TrialBlkNm:      "NegCondInhib",
-			FixedProb:       false,
-			NIters:          75,
-			BlocksPerIter:   10,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_DE_NEG_trn.00_0150.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"NegCondInh_test": {
-			Nm:              "NegCondInh_test",
-			Desc:            "condition inhibition w/ negatively-valenced US: CZ_NRf_NEG, C_Rf_NEG interleaved; i.e.,  Z = security signal",
-			TrialBlkNm:      "NegCondInhib_test",
-			FixedProb:       false,
-			NIters:          5,
-			BlocksPerIter:   6,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_DU_NEG_trn.00_0150.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"NegExtinct": {
-			Nm:              "NegExtinct",
-			Desc:            "Pavlovian conditioning w/ negatively-valenced US: A_Rf_NEG",
-			TrialBlkNm:      "NegExtinct",
-			FixedProb:       false,
-			NIters:          75,
-			BlocksPerIter:   8,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_DE_NEG_trn.00_0150.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosAcq_contextA": {
-			Nm:              "PosAcq_contextA",
-			Desc:            "Pavlovian conditioning w/ positively-valenced US: A_Rf_POS, A_Rf_POS_omit trials, interleaved",
-			TrialBlkNm:      "PosAcq_contextA",
-			FixedProb:       false,
-			NIters:          26,
-			BlocksPerIter:   10,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosExtinct_contextB": {
-			Nm:              "PosExtinct_contextB",
-			Desc:            "Pavlovian conditioning w/ positively-valenced US: A_Rf_POS, A_Rf_POS_omit trials, interleaved",
-			TrialBlkNm:      "PosExtinct_contextB",
-			FixedProb:       false,
-			NIters:          25,
-			BlocksPerIter:   10,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_A_contextA_vs.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosRenewal_contextA": {
-			Nm:              "PosRenewal_contextA",
-			Desc:            "Pavlovian conditioning w/ positively-valenced US: A_Rf_POS, A_Rf_POS_omit trials, interleaved",
-			TrialBlkNm:      "PosRenewal_contextA",
-			FixedProb:       false,
-			NIters:          1,
-			BlocksPerIter:   2,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_A_contextB_vs.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosBlocking_A_training": {
-			Nm:              "PosBlocking_A_training",
-			Desc:            "Blocking experiment",
-			TrialBlkNm:      "PosBlocking_A_training",
-			FixedProb:       false,
-			NIters:          50,
-			BlocksPerIter:   1,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosBlocking": {
-			Nm:              "PosBlocking",
-			Desc:            "Blocking experiment",
-			TrialBlkNm:      "PosBlocking",
-			FixedProb:       false,
-			NIters:          50,
-			BlocksPerIter:   2,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_posblocking_A_training_300.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosBlocking_test": {
-			Nm:              "PosBlocking_test",
-			Desc:            "Blocking experiment",
-			TrialBlkNm:      "PosBlocking_test",
-			FixedProb:       false,
-			NIters:          25,
-			BlocksPerIter:   1,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_posblocking_A_AB_200.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosBlocking2_test": {
-			Nm:              "PosBlocking2_test",
-			Desc:            "Blocking experiment",
-			TrialBlkNm:      "PosBlocking2_test",
-			FixedProb:       false,
-			NIters:          25,
-			BlocksPerIter:   2,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_posblocking_A_AB_200.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"NegBlocking_E_training": {
-			Nm:              "NegBlocking_E_training",
-			Desc:            "Blocking experiment",
-			TrialBlkNm:      "NegBlocking_E_training",
-			FixedProb:       false,
-			NIters:          300,
-			BlocksPerIter:   1,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"NegBlocking": {
-			Nm:              "NegBlocking",
-			Desc:            "Blocking experiment",
-			TrialBlkNm:      "NegBlocking",
-			FixedProb:       false,
-			NIters:          200,
-			BlocksPerIter:   2,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_negblocking_E_training.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"NegBlocking_test": {
-			Nm:              "NegBlocking_test",
-			Desc:            "Blocking experiment",
-			TrialBlkNm:      "NegBlocking_test",
-			FixedProb:       false,
-			NIters:          25,
-			BlocksPerIter:   1,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_negblocking_E_DE.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosAcqMag": {
-			Nm:              "PosAcqMag",
-			Desc:            "Magnitude experiment",
-			TrialBlkNm:      "PosAcqMagnitude",
-			FixedProb:       false,
-			NIters:          50,
-			BlocksPerIter:   8,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosSumAcq": {
-			Nm:              "PosSumAcq",
-			Desc:            "Conditioned Inhibition - A+, C+",
-			TrialBlkNm:      "PosSumAcq",
-			FixedProb:       false,
-			NIters:          450,
-			BlocksPerIter:   3,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosSumCondInhib": {
-			Nm:              "PosSumCondInhib",
-			Desc:            "Conditioned Inhibition - AX-, A+",
-			TrialBlkNm:      "PosCondInhib_BY",
-			FixedProb:       false,
-			NIters:          300,
-			BlocksPerIter:   3,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_AC_POS_trn.00_0450.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosSum_test": {
-			Nm:              "PosSum_test",
-			Desc:            "Conditioned Inhibition Summation Test",
-			TrialBlkNm:      "PosSumCondInhib_test",
-			FixedProb:       false,
-			NIters:          5,
-			BlocksPerIter:   6,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_sum_AX_POS_trn.00_0300.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"NegSumAcq": {
-			Nm:              "NegSumAcq",
-			Desc:            "Conditioned Inhibition - D-, E-",
-			TrialBlkNm:      "NegSumAcq",
-			FixedProb:       false,
-			NIters:          50,
-			BlocksPerIter:   3,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"NegSumCondInhib": {
-			Nm:              "NegSumCondInhib",
-			Desc:            "Conditioned Inhibition - DU, D-",
-			TrialBlkNm:      "NegCondInhib_FV",
-			FixedProb:       false,
-			NIters:          100,
-			BlocksPerIter:   3,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_DEF_NEG_trn.00_0050.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"NegSum_test": {
-			Nm:              "NegSum_test",
-			Desc:            "Conditioned Inhibition Summation Test",
-			TrialBlkNm:      "NegSumCondInhib_test",
-			FixedProb:       false,
-			NIters:          5,
-			BlocksPerIter:   6,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_sum_DU_NEG_trn.00_0050.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"Unblocking_train": {
-			Nm:              "Unblocking_train",
-			Desc:            "A+++,B+++,C+",
-			TrialBlkNm:      "Unblocking_train",
-			FixedProb:       false,
-			NIters:          50,
-			BlocksPerIter:   2,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"UnblockingValue": {
-			Nm:              "UnblockingValue",
-			Desc:            "AX+++,CZ+++",
-			TrialBlkNm:      "UnblockingValue",
-			FixedProb:       false,
-			NIters:          25,
-			BlocksPerIter:   1,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_ABC_POS_trn.00_0050.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"UnblockingValue_test": {
-			Nm:              "UnblockingValue_test",
-			Desc:            "A,X,C,Z",
-			TrialBlkNm:      "UnblockingValue_test",
-			FixedProb:       false,
-			NIters:          5,
-			BlocksPerIter:   1,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_AX_CZ_trn.00_0050.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"Unblocking_trainUS": {
-			Nm:              "Unblocking_trainUS",
-			Desc:            "A+++ (water) ,B+++ (food)",
-			TrialBlkNm:      "Unblocking_trainUS",
-			FixedProb:       false,
-			NIters:          50,
-			BlocksPerIter:   15,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"UnblockingIdentity": {
-			Nm:              "UnblockingIdentity",
-			Desc:            "AX+++(water),BY+++(water)",
-			TrialBlkNm:      "UnblockingIdentity",
-			FixedProb:       false,
-			NIters:          25,
-			BlocksPerIter:   20,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_ABC_POS_US_trn.00_0050.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"UnblockingIdentity_test": {
-			Nm:              "UnblockingIdentity_test",
-			Desc:            "A,X,B,Y",
-			TrialBlkNm:      "UnblockingIdentity_test",
-			FixedProb:       false,
-			NIters:          5,
-			BlocksPerIter:   4,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_AX_BY_trn.00_0050.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosAcqMagChange": {
-			Nm:              "PosAcqMagChange",
-			Desc:            "Magnitude experiment",
-			TrialBlkNm:      "PosAcqMagnitudeChange",
-			FixedProb:       false,
-			NIters:          50,
-			BlocksPerIter:   4,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_AB_POS_MAG_trn.00_0050.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"NegAcqMag": {
-			Nm:              "NegAcqMag",
-			Desc:            "Magnitude experiment",
-			TrialBlkNm:      "NegAcqMagnitude",
-			FixedProb:       false,
-			NIters:          51,
-			BlocksPerIter:   8,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"NegAcqMagChange": {
-			Nm:              "NegAcqMagChange",
-			Desc:            "Magnitude experiment",
-			TrialBlkNm:      "NegAcqMagnitudeChange",
-			FixedProb:       false,
-			NIters:          50,
-			BlocksPerIter:   4,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"Overexpect_train": {
-			Nm:              "Overexpect_train",
-			Desc:            "Overexpectation training (A+, B+, C+, X+, Y-)",
-			TrialBlkNm:      "Overexpectation_train",
-			FixedProb:       false,
-			NIters:          150,
-			BlocksPerIter:   5,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"OverexpectCompound": {
-			Nm:              "OverexpectCompound",
-			Desc:            "Overexpectation compound training (AX+, BY-, CX+, X+, Y-)",
-			TrialBlkNm:      "OverexpectationCompound",
-			FixedProb:       false,
-			NIters:          150,
-			BlocksPerIter:   5,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_ABCXY_trn.00_0150.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"Overexpect_test": {
-			Nm:              "Overexpect_test",
-			Desc:            "Overexpectation test ( A-, B-, C-, X-)",
-			TrialBlkNm:      "Overexpectation_test",
-			FixedProb:       false,
-			NIters:          5,
-			BlocksPerIter:   5,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "wts/bvPVLVNet_AXBYCY_trn.00_0150.wts.gz",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosNeg": {
-			Nm:              "PosNeg",
-			Desc:            "Positive negative test - W equally reinforced with reward + punishment",
-			TrialBlkNm:      "PosNeg",
-			FixedProb:       false,
-			NIters:          150,
-			BlocksPerIter:   6,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PosOrNegAcq": {
-			Nm:              "PosOrNegAcq",
-			Desc:            "Positive negative acquisition - with reward or punishment on interleaved trials according to user-set probabilities",
-			TrialBlkNm:      "PosOrNegAcq",
-			FixedProb:       false,
-			NIters:          150,
-			BlocksPerIter:   6,
-			PermuteTrialGps: true,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 200,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"CondExp": {
-			Nm:              "CondExp",
-			Desc:            "",
-			TrialBlkNm:      "CondExp",
-			FixedProb:       false,
-			NIters:          0,
-			BlocksPerIter:   296,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 0,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-		"PainExp": {
-			Nm:              "PainExp",
-			Desc:            "",
-			TrialBlkNm:      "CondExp",
-			FixedProb:       false,
-			NIters:          0,
-			BlocksPerIter:   48,
-			PermuteTrialGps: false,
-			SaveFinalWts:    false,
-			SaveWtsInterval: 0,
-			TestInterval:    0,
-			LogTrials:       false,
-			LoadWeights:     false,
-			WeightsFile:     "",
-			LoadStBlk:       0,
-			LrsStepBlks:     0,
-			LrsNSteps:       7,
-			LrsBumpStep:     -1,
-		},
-	}
-	return sets
-}
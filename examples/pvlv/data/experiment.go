@@ -0,0 +1,22 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package data
+
+import (
+	"github.com/ccnlab/leabrax/pvlv"
+)
+
+// RunParams is a sequence of conditions -- see pvlv.RunParams
+type RunParams = pvlv.RunParams
+type RunParamsMap = pvlv.RunParamsMap
+
+// ConditionParams contains settings for one portion of a Run -- see pvlv.ConditionParams
+type ConditionParams = pvlv.ConditionParams
+type ConditionParamsMap = pvlv.ConditionParamsMap
+
+// ExptFile is the default experiment definitions file loaded by the sim,
+// relative to the examples/pvlv directory.  It is the only source of the
+// experiment definitions -- edit it directly to add or change paradigms.
+const ExptFile = "data/pvlv_expt.json"
@@ -0,0 +1,63 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package data
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ccnlab/leabrax/pvlv"
+)
+
+// openExptFile opens the ExptFile loaded by the sim
+func openExptFile(t *testing.T) *pvlv.Experiment {
+	ex := &pvlv.Experiment{}
+	if err := ex.Open(filepath.Join("..", ExptFile)); err != nil {
+		t.Fatal(err)
+	}
+	return ex
+}
+
+// brokenConds are the conditions in the ExptFile that refer to trial blocks
+// that were never defined -- none of them are used in a run
+var brokenConds = []string{"AutomatedTesting", "PosAcqOmit", "PosAcq_B100Cont", "RunMaster"}
+
+// TestExptFile checks that the ExptFile loaded by the sim is valid, and that
+// no more conditions refer to missing trial blocks
+func TestExptFile(t *testing.T) {
+	ex := openExptFile(t)
+	if err := ex.Validate(); err != nil {
+		t.Error(err)
+	}
+	if len(ex.Runs) == 0 || len(ex.Conditions) == 0 || len(ex.TrialBlocks) == 0 {
+		t.Errorf("%s: missing definitions: %d runs, %d conditions, %d trial blocks", ExptFile, len(ex.Runs), len(ex.Conditions), len(ex.TrialBlocks))
+	}
+	if ur := ex.Unrunnable(); !reflect.DeepEqual(ur, brokenConds) {
+		t.Errorf("conditions with missing trial blocks: %v, expected: %v", ur, brokenConds)
+	}
+}
+
+// TestExptTSV checks that all paradigms survive conversion to TSV
+func TestExptTSV(t *testing.T) {
+	ex := openExptFile(t)
+	dir, err := ioutil.TempDir("", "pvlv_expt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ex.SaveTSV(dir); err != nil {
+		t.Fatal(err)
+	}
+	ld := &pvlv.Experiment{}
+	if err := ld.Open(dir); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ex, ld) {
+		t.Errorf("TSV loaded experiment differs from %s", ExptFile)
+	}
+}
@@ -1,8760 +0,0 @@
-// Copyright (c) 2020, The Emergent Authors. All rights reserved.
-// Use of this source code is governed by a BSD-style
-// license that can be found in the LICENSE file.
-
-package data
-
-import (
-	p "github.com/ccnlab/leabrax/pvlv"
-)
-
-// TrialGp : block-level state
-// aka TrialBlock
-type TrialBlockParams struct {
-	TrialBlkName        string    `desc:"name"`
-	PercentOfTotal      float64   `desc:"Percent of all trials for this type"`
-	ValenceContext      p.Valence `desc:"Positive or negative reward valence"`
-	USProb              float64   `desc:"Probability of US"`
-	FixedProb           bool      `desc:"Fixed probability?"`
-	MixedUS             bool      `desc:"Mixed US set?"`
-	USMagnitude         float64   `desc:"US magnitude"`
-	AlphTicksPerTrialGp int       `desc:"Number of ticks for a trial"`
-	CS                  string    `desc:"Conditioned stimulus"`
-	CSTimeStart         p.Tick    `desc:"Tick of CS start"`
-	CSTimeEnd           p.Tick    `desc:"Tick of CS end"`
-	CS2TimeStart        p.Tick    `desc:"Tick of CS2 start"`
-	CS2TimeEnd          p.Tick    `desc:"Tick of CS2 end"`
-	USType              p.US      `desc:"Unconditioned stimulus"`
-	USTimeStart         p.Tick    `desc:"Tick for start of US presentation"`
-	USTimeEnd           p.Tick    `desc:"Tick for end of US presentation"`
-	Context             string    `desc:"Context"`
-	Location            string    `desc:"Location"`
-}
-
-type TrialBlockMap map[string]TrialBlock
-
-type TrialBlock []*TrialBlockParams
-
-type TrialBlockRecs struct {
-	Recs
-}
-
-func NewTrialBlockRecs(epl *TrialBlock) *TrialBlockRecs {
-	if epl == nil {
-		epl = new(TrialBlock)
-	}
-	recs := &TrialBlockRecs{Recs: *NewRecs(epl)}
-	return recs
-}
-
-func (epl *TrialBlock) Length() int {
-	return len(*epl)
-}
-
-func (epl *TrialBlock) Append(ins interface{}) IRecs {
-	ret := append(*epl, ins.(*TrialBlockParams))
-	return &ret
-}
-
-func (epl *TrialBlock) Get(i int) interface{} {
-	return (*epl)[i]
-}
-
-func (eps *TrialBlockRecs) ReadNext() *TrialBlockParams {
-	return eps.Recs.ReadNext().(*TrialBlockParams)
-}
-
-// imported from PVLVEnv->EnvBlockParams_Group in cemer
-func AllTrialBlocks() TrialBlockMap {
-	sets := map[string]TrialBlock{
-		"PosAcq_B50": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              0.5,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"USDebug": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      1.0,
-				ValenceContext:      p.POS,
-				USProb:              1.0,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-		},
-		"PosAcq_A50": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      1.0,
-				ValenceContext:      p.POS,
-				USProb:              0.5,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-		},
-		"US0": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      1.0,
-				ValenceContext:      p.POS,
-				USProb:              0.01,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-		},
-		"PosReacq": {
-			{
-				TrialBlkName:        "A_Rf_reacq",
-				PercentOfTotal:      1.000,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf_reacq",
-				PercentOfTotal:      0.000,
-				ValenceContext:      p.POS,
-				USProb:              0.500,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"PosAcqPreSecondOrder": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 7,
-				CS:                  "A",
-				CSTimeStart:         p.T3,
-				CSTimeEnd:           p.T5,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T5,
-				USTimeEnd:           p.T5,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              0.500,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 7,
-				CS:                  "B",
-				CSTimeStart:         p.T3,
-				CSTimeEnd:           p.T5,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T5,
-				USTimeEnd:           p.T5,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"PosAcq_B100": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"PosAcq_B25": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              0.250,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"PosNeg": {
-			{
-				TrialBlkName:        "Z_Rf",
-				PercentOfTotal:      0.167,
-				ValenceContext:      p.NEG,
-				USProb:              0.250,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Z",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Z",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Z_Rf",
-				PercentOfTotal:      0.167,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Z",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Z",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Z_Rf_tst",
-				PercentOfTotal:      0.167,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Z",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Z",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "W_Rf",
-				PercentOfTotal:      0.167,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "W",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "W",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "W_Rf",
-				PercentOfTotal:      0.167,
-				ValenceContext:      p.NEG,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "W",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "W",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "W_Rf_tst",
-				PercentOfTotal:      0.167,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "W",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "W",
-				Location:            "",
-			},
-		},
-		"PosOrNegAcq": {
-			{
-				TrialBlkName:        "Z_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.NEG,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             true,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Z",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Z",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Z_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             true,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Z",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Z",
-				Location:            "",
-			},
-		},
-		"Overexpectation_train": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.200,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.200,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "C_Rf",
-				PercentOfTotal:      0.200,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "C",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "C",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.200,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.200,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-		},
-		"OverexpectationCompound": {
-			{
-				TrialBlkName:        "AX_Rf",
-				PercentOfTotal:      0.200,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.200,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "CY_Rf",
-				PercentOfTotal:      0.200,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "CY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "CY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.200,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.200,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-		},
-		"Overexpectation_test": {
-			{
-				TrialBlkName:        "A_NR_test",
-				PercentOfTotal:      0.250,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR_test",
-				PercentOfTotal:      0.250,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "C_NR_test",
-				PercentOfTotal:      0.250,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "C",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "C",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_R_test",
-				PercentOfTotal:      0.250,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-		},
-		"PosAcqEarlyUS_test": {
-			{
-				TrialBlkName:        "A_Rf_late",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T4,
-				USTimeEnd:           p.T4,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf_early",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T2,
-				USTimeEnd:           p.T2,
-				Context:             "A",
-				Location:            "",
-			},
-		},
-		"PosOrienting": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"PosAcqMagnitude": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"PosAcqMagnitudeChange": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "D",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "E",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"NegAcqMagnitude": {
-			{
-				TrialBlkName:        "D_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.NEG,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "C",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "D",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "E_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.NEG,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "D",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "E",
-				Location:            "",
-			},
-		},
-		"NegAcqMagnitudeChange": {
-			{
-				TrialBlkName:        "D_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.NEG,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "D",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "D",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "E_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.NEG,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "E",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "E",
-				Location:            "",
-			},
-		},
-		"PosExtinct": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"PosCondInhib": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.650,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.350,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-		},
-		"PosCondInhib_test": {
-			{
-				TrialBlkName:        "A_NR_test",
-				PercentOfTotal:      0.330,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR_test",
-				PercentOfTotal:      0.330,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR_test",
-				PercentOfTotal:      0.330,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-		},
-		"PosSecondOrderCond": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.950,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             true,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 7,
-				CS:                  "A",
-				CSTimeStart:         p.T3,
-				CSTimeEnd:           p.T5,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T5,
-				USTimeEnd:           p.T5,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AC_NR",
-				PercentOfTotal:      0.050,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 7,
-				CS:                  "AC",
-				CSTimeStart:         p.T3,
-				CSTimeEnd:           p.T5,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T2,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T5,
-				USTimeEnd:           p.T5,
-				Context:             "AC",
-				Location:            "",
-			},
-		},
-		"NegAcq": {
-			{
-				TrialBlkName:        "D_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.NEG,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "D",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "D",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "E_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.NEG,
-				USProb:              0.250,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "E",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "E",
-				Location:            "",
-			},
-		},
-		"NegExtinct": {
-			{
-				TrialBlkName:        "D_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "D",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "D",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "E_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.NEG,
-				USProb:              0.500,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "E",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "E",
-				Location:            "",
-			},
-		},
-		"NegCondInhib": {
-			{
-				TrialBlkName:        "D_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.NEG,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "D",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "D",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "DU_NR",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "DU",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "DU",
-				Location:            "",
-			},
-		},
-		"NegCondInhib_test": {
-			{
-				TrialBlkName:        "D_NR_test",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "D",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "D",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "DU_NR_test",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "DU",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "DU",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "U_NR_test",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "U",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "U",
-				Location:            "",
-			},
-		},
-		"CondExp": {
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR",
-				PercentOfTotal:      0.003,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.TckNone,
-				CS2TimeEnd:          p.TckNone,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-		},
-		"PosAcq_contextA": {
-			{
-				TrialBlkName:        "A_Rf_cntxtA",
-				PercentOfTotal:      1.000,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-		},
-		"PosExtinct_contextB": {
-			{
-				TrialBlkName:        "A_Rf_cntxtB",
-				PercentOfTotal:      1.000,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A_B",
-				Location:            "",
-			},
-		},
-		"PosRenewal_contextA": {
-			{
-				TrialBlkName:        "A_Rf_cntxtA",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf_cntxtB",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A_B",
-				Location:            "",
-			},
-		},
-		"PosBlocking_A_training": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      1.000,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-		},
-		"PosBlocking": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AB_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AB",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AB",
-				Location:            "",
-			},
-		},
-		"PosBlocking_test": {
-			{
-				TrialBlkName:        "B_Rf_test",
-				PercentOfTotal:      1.000,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"PosBlocking2_test": {
-			{
-				TrialBlkName:        "A_Rf_test",
-				PercentOfTotal:      0.5,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf_test",
-				PercentOfTotal:      0.5,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"NegBlocking_E_training": {
-			{
-				TrialBlkName:        "E_Rf",
-				PercentOfTotal:      1.000,
-				ValenceContext:      p.NEG,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "E",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "E",
-				Location:            "",
-			},
-		},
-		"NegBlocking": {
-			{
-				TrialBlkName:        "E_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.NEG,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "E",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "E",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "ED_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.NEG,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "ED",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "ED",
-				Location:            "",
-			},
-		},
-		"NegBlocking_test": {
-			{
-				TrialBlkName:        "D_Rf",
-				PercentOfTotal:      1.000,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "D",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "D",
-				Location:            "",
-			},
-		},
-		"AutoTstEnv_test": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 6,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T4,
-				USTimeEnd:           p.T4,
-				Context:             "A",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              0.500,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 6,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T4,
-				USTimeEnd:           p.T4,
-				Context:             "B",
-			},
-		},
-		"PosSumAcq": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "C_Rf",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "C",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "C",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"NegSumAcq": {
-			{
-				TrialBlkName:        "D_Rf",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.NEG,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "D",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "D",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "E_Rf",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.NEG,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "E",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "E",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "F_Rf",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "F",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "F",
-				Location:            "",
-			},
-		},
-		"PosCondInhib_BY": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_NR",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-		},
-		"NegCondInhib_FV": {
-			{
-				TrialBlkName:        "D_Rf",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.NEG,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "D",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "D",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "DU_NR",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "DU",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "DU",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "FV_NR",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "FV",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "FV",
-				Location:            "",
-			},
-		},
-		"NegSumCondInhib_test": {
-			{
-				TrialBlkName:        "D_NR_test",
-				PercentOfTotal:      0.171,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "D",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "D",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "E_NR_test",
-				PercentOfTotal:      0.166,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "E",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "E",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "U_NR_test",
-				PercentOfTotal:      0.166,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "U",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "U",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "DU_NR_test",
-				PercentOfTotal:      0.166,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "DU",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "DU",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "EU_NR_test",
-				PercentOfTotal:      0.166,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "EU",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "EU",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "EV_NR_test",
-				PercentOfTotal:      0.166,
-				ValenceContext:      p.NEG,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "EV",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Shock),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "EV",
-				Location:            "",
-			},
-		},
-		"PosSumCondInhib_test": {
-			{
-				TrialBlkName:        "A_NR_test",
-				PercentOfTotal:      0.171,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "C_NR_test",
-				PercentOfTotal:      0.166,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "C",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "C",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_NR_test",
-				PercentOfTotal:      0.166,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AX_NR_test",
-				PercentOfTotal:      0.166,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "CX_NR_test",
-				PercentOfTotal:      0.166,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "CX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "CX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "CY_NR_test",
-				PercentOfTotal:      0.166,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "CY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "CY",
-				Location:            "",
-			},
-		},
-		"Unblocking_train": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.000,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "C_Rf",
-				PercentOfTotal:      1.000,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "C",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "C",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.000,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"UnblockingValue": {
-			{
-				TrialBlkName:        "AX_Rf",
-				PercentOfTotal:      0.000,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "CZ_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "CZ",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "CZ",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.000,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "C_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "C",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "C",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.000,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"UnblockingValue_test": {
-			{
-				TrialBlkName:        "A_Rf_test",
-				PercentOfTotal:      0.000,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "C_Rf_test",
-				PercentOfTotal:      0.000,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "C",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "C",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf_test",
-				PercentOfTotal:      0.000,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Z_Rf_test",
-				PercentOfTotal:      1.000,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Z",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Z",
-				Location:            "",
-			},
-		},
-		"Unblocking_trainUS": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "C_Rf",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.100,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "C",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "C",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.333,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Food),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"UnblockingIdentity": {
-			{
-				TrialBlkName:        "AX_Rf",
-				PercentOfTotal:      0.300,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AX",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AX",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "BY_Rf",
-				PercentOfTotal:      0.300,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "BY",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "BY",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.200,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      0.200,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Food),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"UnblockingIdentity_test": {
-			{
-				TrialBlkName:        "A_Rf_test",
-				PercentOfTotal:      0.251,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "B_Rf_test",
-				PercentOfTotal:      0.251,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Food),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "X_Rf_test",
-				PercentOfTotal:      0.251,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "X",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "X",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "Y_Rf_test",
-				PercentOfTotal:      0.248,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "Y",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "Y",
-				Location:            "",
-			},
-		},
-		"PosUpUnblocking_A_training": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      1.000,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-		},
-		"PosUpUnblocking": {
-			{
-				TrialBlkName:        "A_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         0.500,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-			{
-				TrialBlkName:        "AB_Rf",
-				PercentOfTotal:      0.500,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "AB",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T1,
-				CS2TimeEnd:          p.T3,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "AB",
-				Location:            "",
-			},
-		},
-		"PosUpUnblocking_test": {
-			{
-				TrialBlkName:        "B_Rf",
-				PercentOfTotal:      1.000,
-				ValenceContext:      p.POS,
-				USProb:              0.000,
-				FixedProb:           false,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "B",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "B",
-				Location:            "",
-			},
-		},
-		"BlankTemplate": {
-			{
-				TrialBlkName:        "",
-				PercentOfTotal:      1.000,
-				ValenceContext:      p.POS,
-				USProb:              1.000,
-				FixedProb:           true,
-				MixedUS:             false,
-				USMagnitude:         1.000,
-				AlphTicksPerTrialGp: 5,
-				CS:                  "A",
-				CSTimeStart:         p.T1,
-				CSTimeEnd:           p.T3,
-				CS2TimeStart:        p.T9,
-				CS2TimeEnd:          p.T9,
-				USType:              p.US(p.Water),
-				USTimeStart:         p.T3,
-				USTimeEnd:           p.T3,
-				Context:             "A",
-				Location:            "",
-			},
-		},
-	}
-	return sets
-}
@@ -0,0 +1,44 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package data
+
+import (
+	p "github.com/ccnlab/leabrax/pvlv"
+)
+
+// TrialBlockParams is one trial group in a TrialBlock -- see pvlv.TrialBlockParams
+type TrialBlockParams = p.TrialBlockParams
+type TrialBlockMap = p.TrialBlockMap
+
+type TrialBlock []*TrialBlockParams
+
+type TrialBlockRecs struct {
+	Recs
+}
+
+func NewTrialBlockRecs(epl *TrialBlock) *TrialBlockRecs {
+	if epl == nil {
+		epl = new(TrialBlock)
+	}
+	recs := &TrialBlockRecs{Recs: *NewRecs(epl)}
+	return recs
+}
+
+func (epl *TrialBlock) Length() int {
+	return len(*epl)
+}
+
+func (epl *TrialBlock) Append(ins interface{}) IRecs {
+	ret := append(*epl, ins.(*TrialBlockParams))
+	return &ret
+}
+
+func (epl *TrialBlock) Get(i int) interface{} {
+	return (*epl)[i]
+}
+
+func (eps *TrialBlockRecs) ReadNext() *TrialBlockParams {
+	return eps.Recs.ReadNext().(*TrialBlockParams)
+}
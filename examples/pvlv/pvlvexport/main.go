@@ -0,0 +1,47 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// pvlvexport converts PVLV experiment definitions between the JSON and TSV
+// formats that are loaded by the pvlv example, e.g., to edit the definitions
+// in data/pvlv_expt.json as TSV files.  Run from the examples/pvlv directory:
+//
+//	go run ./pvlvexport [-expt data/pvlv_expt.json] [-json file] [-tsv dir]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/ccnlab/leabrax/examples/pvlv/data"
+	"github.com/ccnlab/leabrax/pvlv"
+)
+
+func main() {
+	var exptFile, jsonFile, tsvDir string
+	flag.StringVar(&exptFile, "expt", data.ExptFile, "experiment definitions to convert: a JSON file, or a directory of TSV files")
+	flag.StringVar(&jsonFile, "json", "", "JSON file to save the experiment to -- empty for none")
+	flag.StringVar(&tsvDir, "tsv", "", "directory to save the experiment to as TSV files -- empty for none")
+	flag.Parse()
+
+	ex := &pvlv.Experiment{}
+	if err := ex.Open(exptFile); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if jsonFile != "" {
+		if err := ex.SaveJSON(jsonFile); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Printf("Saved %d runs, %d conditions, %d trial blocks to: %s\n", len(ex.Runs), len(ex.Conditions), len(ex.TrialBlocks), jsonFile)
+	}
+	if tsvDir != "" {
+		if err := ex.SaveTSV(tsvDir); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Printf("Saved experiment TSV files to: %s\n", tsvDir)
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pvlvsim
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/ccnlab/leabrax/examples/pvlv/data"
+	"github.com/ccnlab/leabrax/pvlv"
+	"github.com/emer/emergent/stepper"
+	"github.com/goki/ki/ints"
+)
+
+// daLog records the VTAp dopamine for each alpha trial type (e.g., A_Rf_POS_t1)
+// in each block of each condition of a run
+type daLog struct {
+	Run  string
+	Blks map[string][]map[string]float64 `desc:"per condition, per block, DA by alpha trial type"`
+}
+
+// mean returns the mean DA for alpha trial type tt over blocks st..ed-1 of
+// condition cond, or NaN if it did not occur in any of them
+func (dl *daLog) mean(cond, tt string, st, ed int) float64 {
+	sum, n := 0.0, 0
+	for _, das := range dl.Blks[cond][st:ed] {
+		if da, ok := das[tt]; ok {
+			sum += da
+			n++
+		}
+	}
+	if n == 0 {
+		return math.NaN()
+	}
+	return sum / float64(n)
+}
+
+// first returns the mean DA for tt over the first third of the blocks of cond
+func (dl *daLog) first(cond, tt string) float64 {
+	nb := len(dl.Blks[cond])
+	return dl.mean(cond, tt, 0, ints.MaxInt(1, nb/3))
+}
+
+// last returns the mean DA for tt over the last third of the blocks of cond
+func (dl *daLog) last(cond, tt string) float64 {
+	nb := len(dl.Blks[cond])
+	return dl.mean(cond, tt, nb-ints.MaxInt(1, nb/3), nb)
+}
+
+// testSeed is the fixed random seed that all test runs start from, so that
+// their DA is the same on every run
+const testSeed = 1
+
+// newTestSim returns a Sim for the named RunParams without the GUI,
+// initialized to run from testSeed
+func newTestSim(t *testing.T, run string) *Sim {
+	ss := &Sim{ExptFile: filepath.Join("..", data.ExptFile)}
+	ss.New()
+	ss.ViewOn = false
+	ss.RunParamsNm = run
+	ss.Config()
+	ss.RndSeed = testSeed
+	ss.InitSim()
+	if err := ss.InitRun(); err != nil {
+		t.Fatal(err)
+	}
+	ss.Stepper.Enter(stepper.Running)
+	return ss
+}
+
+// runParadigm runs the named RunParams without the GUI, from testSeed, running at most maxIters blocks of each condition, and logs the DA
+func runParadigm(t *testing.T, run string, maxIters int) *daLog {
+	ss := newTestSim(t, run)
+	dl := &daLog{Run: run, Blks: map[string][]map[string]float64{}}
+	dt := ss.TrialTypeData
+	for i, cond := range ss.GetRunConditions(ss.RunParams) {
+		if cond.Nm == pvlv.NullStep {
+			break
+		}
+		cp := *cond
+		if cp.NIters > maxIters {
+			cp.NIters = maxIters
+		}
+		ss.ActivateCondition(i, &cp)
+		for blk := 0; blk < cp.NIters; blk++ {
+			for row := 0; row < dt.Rows; row++ {
+				dt.SetCellFloat("VTAp_act", row, math.NaN())
+			}
+			ss.Env.RunOneTrialBlk(ss)
+			das := map[string]float64{}
+			for row := 0; row < dt.Rows; row++ {
+				if da := dt.CellFloat("VTAp_act", row); !math.IsNaN(da) {
+					das[dt.CellString("TrialType", row)] = da
+				}
+			}
+			dl.Blks[cp.Nm] = append(dl.Blks[cp.Nm], das)
+		}
+	}
+	return dl
+}
+
+// sigCheck is one qualitative signature of a phenomenon: A must exceed B
+type sigCheck struct {
+	Desc string
+	A, B float64
+	Full bool `desc:"the signature is only established by the full run, and is not checked in the quick run"`
+}
+
+// phenomenon is a conditioning phenomenon reproduced by the named run,
+// with the signatures that its DA log must show
+type phenomenon struct {
+	Name       string
+	Run        string
+	MaxIters   int `desc:"maximum blocks per condition in the full run -- all signatures are established by then"`
+	QuickIters int `desc:"maximum blocks per condition in the quick run -- only the signatures that are not Full are checked, and 0 leaves the phenomenon out of the quick run"`
+	Sigs       func(dl *daLog) []sigCheck
+}
+
+var phenomena = []phenomenon{
+	{"Acquisition", "PosExt", 12, 2, func(dl *daLog) []sigCheck {
+		return []sigCheck{
+			{"CS DA rises", dl.last("PosAcq_B50", "A_Rf_POS_t1"), dl.first("PosAcq_B50", "A_Rf_POS_t1"), false},
+			{"US DA shrinks", dl.first("PosAcq_B50", "A_Rf_POS_t3"), dl.last("PosAcq_B50", "A_Rf_POS_t3"), false},
+			{"omitted partial US dips", 0, dl.last("PosAcq_B50", "B_Rf_POS_omit_t3"), true},
+		}
+	}},
+	{"Extinction", "PosExt", 12, 2, func(dl *daLog) []sigCheck {
+		return []sigCheck{
+			{"omitted US dips", 0, dl.first("PosExtinct", "A_Rf_POS_omit_t3"), false},
+			{"omission dip fades", dl.last("PosExtinct", "A_Rf_POS_omit_t3"), dl.first("PosExtinct", "A_Rf_POS_omit_t3"), true},
+			{"CS DA falls", dl.last("PosAcq_B50", "A_Rf_POS_t1"), dl.last("PosExtinct", "A_Rf_POS_omit_t1"), true},
+		}
+	}},
+	{"Renewal", "AbaRenewal", 10, 2, func(dl *daLog) []sigCheck {
+		return []sigCheck{
+			{"CS DA falls in context B", dl.last("PosAcq_contextA", "A_Rf_cntxtA_POS_t1"), dl.last("PosExtinct_contextB", "A_Rf_cntxtB_POS_omit_t1"), false},
+			{"CS DA renews in context A", dl.last("PosRenewal_contextA", "A_Rf_cntxtA_POS_omit_t1"), dl.last("PosRenewal_contextA", "A_Rf_cntxtB_POS_omit_t1"), false},
+		}
+	}},
+	{"Blocking", "PosBlocking", 30, 2, func(dl *daLog) []sigCheck {
+		return []sigCheck{
+			{"US DA to AB is predicted by A", dl.first("PosBlocking_A_training", "A_Rf_POS_t3"), dl.last("PosBlocking", "AB_Rf_POS_t3"), false},
+			{"blocked B CS DA under half of A", dl.last("PosBlocking", "A_Rf_POS_t1") / 2, dl.last("PosBlocking_test", "B_Rf_POS_omit_test_t1"), false},
+		}
+	}},
+	{"CondInhib", "PosCondInhib", 16, 0, func(dl *daLog) []sigCheck {
+		return []sigCheck{
+			{"X reduces CS DA of A", dl.last("PosCondInhib_test", "A_NR_POS_test_t1"), dl.last("PosCondInhib_test", "AX_NR_POS_test_t1"), false},
+			{"X alone dips", 0, dl.last("PosCondInhib_test", "X_NR_POS_test_t1"), false},
+		}
+	}},
+	// in this paradigm, the CS A comes on at t3 and the US at t5, with the
+	// second-order CS C at t1 before it, so the CS DA of A is at t3, not t1
+	{"SecondOrder", "PosSecondOrderCond", 3, 0, func(dl *daLog) []sigCheck {
+		return []sigCheck{
+			{"CS DA of A rises", dl.last("PosAcqPreSecondOrder", "A_Rf_POS_t3"), dl.first("PosAcqPreSecondOrder", "A_Rf_POS_t3"), false},
+			{"US DA to A shrinks", dl.first("PosAcqPreSecondOrder", "A_Rf_POS_t5"), dl.last("PosAcqPreSecondOrder", "A_Rf_POS_t5"), false},
+			{"C acquires CS DA from A", dl.last("PosSecondOrderCond", "AC_NR_POS_t1"), 0, false},
+		}
+	}},
+}
+
+// PhenomenaEnv is the environment variable that selects the full run of
+// TestPhenomena, which takes several minutes, e.g.:
+//
+//	PVLV_PHENOMENA=1 go test -timeout 30m ./examples/pvlv/pvlvsim
+//
+// Without it, the quick run checks the signatures that are established within
+// the first QuickIters blocks of each condition, in well under a minute.
+const PhenomenaEnv = "PVLV_PHENOMENA"
+
+// TestPhenomena checks the DA signatures of the classic conditioning phenomena,
+// printing a summary table of all of them if any fail
+func TestPhenomena(t *testing.T) {
+	if testing.Short() {
+		t.Skip("PVLV phenomena are not run in short mode")
+	}
+	full := os.Getenv(PhenomenaEnv) != ""
+	if !full {
+		t.Logf("quick run -- set %s=1 for the full run of all phenomena", PhenomenaEnv)
+	}
+	logs := map[string]*daLog{}
+	var tbl strings.Builder
+	tw := tabwriter.NewWriter(&tbl, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Phenomenon\tRun\tSignature\tA\tB\tA > B\n")
+	for _, ph := range phenomena {
+		ph := ph
+		iters := ph.MaxIters
+		if !full {
+			iters = ph.QuickIters
+		}
+		if iters == 0 {
+			continue
+		}
+		t.Run(ph.Name, func(t *testing.T) {
+			dl, has := logs[ph.Run]
+			if !has {
+				dl = runParadigm(t, ph.Run, iters)
+				logs[ph.Run] = dl
+			}
+			for _, sc := range ph.Sigs(dl) {
+				if sc.Full && !full {
+					continue
+				}
+				sc := sc
+				ok := sc.A > sc.B
+				t.Run(sc.Desc, func(t *testing.T) {
+					if !ok {
+						t.Errorf("%.3f > %.3f failed", sc.A, sc.B)
+					}
+				})
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%.3f\t%.3f\t%v\n", ph.Name, ph.Run, sc.Desc, sc.A, sc.B, ok)
+			}
+		})
+	}
+	tw.Flush()
+	if t.Failed() || testing.Verbose() {
+		t.Logf("PVLV phenomena summary:\n%s", tbl.String())
+	}
+}
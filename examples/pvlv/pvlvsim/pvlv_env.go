@@ -2,7 +2,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-package main
+package pvlvsim
 
 // The code in this file was listed verbatim from the cemer version of PVLV, and tries to duplicate its logic fathfully.
 
@@ -59,6 +59,8 @@ type PVLVEnv struct {
 	PctNormTotalActUSTime float64 `desc:"amount to add to denominator for USTimeIn normalization"`  // used in InstantiateBlockTrials and SetRowStdInputDataAlphTrial
 
 	InputShapes *map[string][]int
+	Vocab       *pvlv.Vocab `view:"-" desc:"input vocabulary of the experiment, defining the input tensor shapes"`
+	Err         error       `view:"-" desc:"error that stopped the run, e.g., a trial context that is not in the Vocab -- cleared by Init for the first condition"`
 }
 
 func (ev *PVLVEnv) Name() string { return ev.Nm }
@@ -70,6 +72,7 @@ func (ev *PVLVEnv) New(ss *Sim) {
 	ev.TrialBlockCt.Scale = env.Block
 	ev.AlphaCycle.Scale = env.Trial
 	ev.InputShapes = &ss.InputShapes
+	ev.Vocab = ss.Vocab
 	ev.ContextModel = ss.ContextModel // lives in MiscParams in cemer
 	ev.AlphaCycle.Init()
 	ev.StdInputData = &etable.Table{}
@@ -95,6 +98,7 @@ func (ev *PVLVEnv) Init(ss *Sim, firstCondition bool) (ok bool) {
 		return ok
 	}
 	if firstCondition {
+		ev.Err = nil
 		ev.ConditionCt.Init()
 		ev.ConditionCt.Max = ss.MaxConditions
 	}
@@ -374,11 +378,7 @@ func (ev *PVLVEnv) SetTableTrialGpListFmDefnTable() {
 				curTrial.MixedUS = curBlockParams.MixedUS
 				curTrial.USProb = curBlockParams.USProb
 				curTrial.USMagnitude = curBlockParams.USMagnitude
-				if curTrial.ValenceContext == pvlv.POS {
-					curTrial.USType = pvlv.PosUS(curBlockParams.USType).String()
-				} else {
-					curTrial.USType = pvlv.NegUS(curBlockParams.USType).String()
-				}
+				curTrial.USType = curBlockParams.USType
 				curTrial.AlphaTicksPerTrialGp = curBlockParams.AlphTicksPerTrialGp
 				curTrial.CS = curBlockParams.CS
 				curTrial.CSTimeStart = int(curBlockParams.CSTimeStart)
@@ -396,13 +396,17 @@ func (ev *PVLVEnv) SetTableTrialGpListFmDefnTable() {
 	ev.TrialBlockParams.Sequential() // avoid confusion?
 }
 
-func (ev *PVLVEnv) SetupOneAlphaTrial(curTrial *data.TrialInstance, stimNum int) {
+// SetupOneAlphaTrial writes the inputs for the current alpha trial of curTrial
+// into StdInputData.  Returns an error if a context of the trial is not in the
+// Vocab, without writing the inputs.
+func (ev *PVLVEnv) SetupOneAlphaTrial(curTrial *data.TrialInstance, stimNum int) error {
 	prefixUSTimeIn := ""
 
 	// CAUTION! - using percent normalization assumes the multiple CSs (e.g., AX) are always on together,
 	// i.e., the same timesteps; thus, doesn't work for second-order conditioning
-	stimInBase := pvlv.StmNone
-	stimIn2Base := pvlv.StmNone
+	vc := ev.Vocab
+	stimInBase := -1
+	stimIn2Base := -1
 	nStims := 1
 	nUSTimes := 1
 
@@ -426,7 +430,7 @@ func (ev *PVLVEnv) SetupOneAlphaTrial(curTrial *data.TrialInstance, stimNum int)
 		// need one for each predictive CS; also, one for each PREDICTED US if same CS (e.g., Z')
 		// predicts two different USs probalistically (i.e., mixed_US == true condition)
 		nUSTimes = 1
-		stimInBase = pvlv.StimMap[cs1]
+		stimInBase = vc.StimIdx(cs1)
 	} else {
 		cs1 = cs[0:1]
 		cs2 = cs[1:2]
@@ -434,52 +438,78 @@ func (ev *PVLVEnv) SetupOneAlphaTrial(curTrial *data.TrialInstance, stimNum int)
 		// need one for each predictive CS; also, one for each PREDICTED US if same CS (e.g., Z')
 		// predicts two different USs probalistically (i.e., mixed_US == true condition)
 		nUSTimes = 2
-		stimInBase = pvlv.StimMap[cs1]
-		stimIn2Base = pvlv.StimMap[cs2]
+		stimInBase = vc.StimIdx(cs1)
+		stimIn2Base = vc.StimIdx(cs2)
 	}
 
 	// Set up Context_In reps
 
 	// initialize to use the basic context_in var to rep the basic case in which CS and Context are isomorphic
-	ctxParts := pvlv.CtxRe.FindStringSubmatch(curTrial.Context)
-	ctx1 := ctxParts[1]
-	ctx2 := ctxParts[2]
-	preContext := ctx1 + ctx2
-	postContext := ctxParts[3]
-	contextIn := pvlv.CtxMap[curTrial.Context]
-	contextIn2 := pvlv.CtxNone
-	contextIn3 := pvlv.CtxNone
+	// contexts are named by the elements (CSs) they accompany, e.g., AX, with a variant after the "_", e.g., AX_B
+	// all of the context names used here are checked when the experiment is loaded,
+	// by pvlv.TrialBlockParams.Validate -- the first that is not in the Vocab is returned
+	var ctxErr error
+	ctxCoords := func(nm string) []int {
+		coords, ok := vc.ContextCoords(nm)
+		if !ok && ctxErr == nil {
+			ctxErr = fmt.Errorf("PVLVEnv: trial %s: context %q not in Vocab", curTrial.TrialName, nm)
+		}
+		return coords
+	}
+	preContext := curTrial.Context
+	postContext := ""
+	if ui := strings.Index(preContext, "_"); ui >= 0 {
+		preContext, postContext = preContext[:ui], preContext[ui+1:]
+	}
+	ctx1 := ""
+	ctx2 := ""
+	if len(preContext) > 0 {
+		ctx1 = preContext[0:1]
+		ctx2 = preContext[1:]
+	}
+	var contextIn, contextIn2, contextIn3 []int
 	nContexts := len(preContext)
 	// gets complicated if more than one CS...
-	if len(preContext) > 1 {
-		switch ev.ContextModel {
+	if len(preContext) <= 1 {
+		contextIn = ctxCoords(curTrial.Context)
+	} else {
+		// a compound context without its own conjunctive unit in the Vocab, e.g., AC,
+		// is presented through its elements, as in the elemental model
+		ctxModel := ev.ContextModel
+		if _, ok := vc.ContextCoords(preContext); !ok {
+			ctxModel = ELEMENTAL
+		}
+		switch ctxModel {
 		case ELEMENTAL:
 			// first element, e.g., A
-			contextIn = pvlv.CtxMap[ctx1]
+			contextIn = ctxCoords(ctx1)
 			// second element, e.g., X
-			contextIn2 = pvlv.CtxMap[ctx2]
+			contextIn2 = ctxCoords(ctx2)
 			// only handles two for now...
 		case CONJUNCTIVE:
 			// use "as is"...
-			contextIn = pvlv.CtxMap[curTrial.Context]
+			contextIn = ctxCoords(curTrial.Context)
 			nContexts = 1
 		case BOTH:
 			// first element, e.g., A
-			contextIn = pvlv.CtxMap[ctx1]
+			contextIn = ctxCoords(ctx1)
 			// second element, e.g., X
-			contextIn2 = pvlv.CtxMap[ctx2]
+			contextIn2 = ctxCoords(ctx2)
 			// conjunctive case, e.g., AX
-			contextIn3 = pvlv.CtxMap[preContext]
+			contextIn3 = ctxCoords(preContext)
 			nContexts = len(preContext) + 1
 		}
 	}
 	// anything after the "_" indicates different context for extinction, renewal, etc.
 	if len(postContext) > 0 {
-		contextIn = pvlv.CtxMap[ctx1+"_"+postContext]
+		contextIn = ctxCoords(ctx1 + "_" + postContext)
 		if len(ctx2) > 0 {
-			contextIn2 = pvlv.CtxMap[ctx2+"_"+postContext]
+			contextIn2 = ctxCoords(ctx2 + "_" + postContext)
 		}
-		contextIn3 = pvlv.CtxNone
+		contextIn3 = nil
+	}
+	if ctxErr != nil {
+		return ctxErr
 	}
 
 	if ev.StdInputData.Rows != 0 {
@@ -492,10 +522,10 @@ func (ev *PVLVEnv) SetupOneAlphaTrial(curTrial *data.TrialInstance, stimNum int)
 		alphaTrialName := curTrial.TrialName + "_t" + strconv.Itoa(i)
 		trialGpTimestep := pvlv.Tick(i)
 		trialGpTimestepInt := i
-		stimIn := pvlv.StmNone
-		stimIn2 := pvlv.StmNone
-		posPV := pvlv.PosUSNone
-		negPV := pvlv.NegUSNone
+		stimIn := -1
+		stimIn2 := -1
+		posPV := -1
+		negPV := -1
 		usTimeInStr := ""
 		usTimeIn2Str := ""
 		usTimeInWrongStr := ""
@@ -515,40 +545,40 @@ func (ev *PVLVEnv) SetupOneAlphaTrial(curTrial *data.TrialInstance, stimNum int)
 			// TODO: Theoretically, USTime reps shouldn't come on at CS-onset until BAacq and/or
 			// gets active first - for time being, using a priori inputs as a temporary proof-of-concept
 		} else {
-			stimIn = pvlv.StmNone
+			stimIn = -1
 		}
 		// set CS2 input activation values on or off according to timesteps, if a second CS exists
 		if i >= curTrial.CS2TimeStart && i <= curTrial.CS2TimeEnd {
 			stimIn2 = stimIn2Base
 		} else {
-			stimIn2 = pvlv.StmNone
+			stimIn2 = -1
 		}
 		// set US and USTime input activation values on or off according to timesteps
 		var us int
 		if i > curTrial.CSTimeStart && (!(i > curTrial.USTimeStart) || !curTrial.USFlag) {
 			if curTrial.ValenceContext == pvlv.POS {
-				us = int(pvlv.PosSMap[curTrial.USType])
-				posPV = pvlv.PosUS(us)
+				us = vc.USIdx(pvlv.POS, curTrial.USType)
+				posPV = us
 				usTimeInStr = prefixUSTimeIn + "PosUS" + strconv.Itoa(us) + "_t" +
 					strconv.Itoa(i-curTrial.CSTimeStart-1)
-				usTimeIn = pvlv.PUSTFromString(usTimeInStr)
+				usTimeIn = vc.USTimeFmString(usTimeInStr)
 				usTimeInWrongStr = pvlv.USTimeNone.String()
 				if curTrial.MixedUS {
 					usTimeInWrongStr = prefixUSTimeIn + "NegUS" + strconv.Itoa(us) + "_t" +
 						strconv.Itoa(i-curTrial.CSTimeStart-1)
-					usTimeInWrong = pvlv.PUSTFromString(usTimeInWrongStr)
+					usTimeInWrong = vc.USTimeFmString(usTimeInWrongStr)
 				}
 			} else if curTrial.ValenceContext == pvlv.NEG {
-				us = int(pvlv.NegSMap[curTrial.USType])
-				negPV = pvlv.NegUS(us)
+				us = vc.USIdx(pvlv.NEG, curTrial.USType)
+				negPV = us
 				usTimeInStr = prefixUSTimeIn + "NegUS" + strconv.Itoa(us) + "_t" +
 					strconv.Itoa(i-curTrial.CSTimeStart-1)
-				usTimeIn = pvlv.PUSTFromString(usTimeInStr)
+				usTimeIn = vc.USTimeFmString(usTimeInStr)
 				usTimeInWrongStr = pvlv.USTimeNone.String()
 				if curTrial.MixedUS {
 					usTimeInWrongStr = prefixUSTimeIn + "PosUS" + strconv.Itoa(us) + "_t" +
 						strconv.Itoa(i-curTrial.CSTimeStart-1)
-					usTimeInWrong = pvlv.PUSTFromString(usTimeInWrongStr)
+					usTimeInWrong = vc.USTimeFmString(usTimeInWrongStr)
 				}
 			}
 		} else {
@@ -560,25 +590,25 @@ func (ev *PVLVEnv) SetupOneAlphaTrial(curTrial *data.TrialInstance, stimNum int)
 		if i > curTrial.CS2TimeStart && i <= (curTrial.CS2TimeEnd+1) && (!(i > curTrial.USTimeStart) || !curTrial.USFlag) {
 			usTime2IntStr := strconv.Itoa(i - curTrial.CS2TimeStart - 1)
 			if curTrial.ValenceContext == pvlv.POS {
-				us = int(pvlv.PosSMap[curTrial.USType])
-				posPV = pvlv.PosUS(us)
+				us = vc.USIdx(pvlv.POS, curTrial.USType)
+				posPV = us
 				usTimeIn2Str = prefixUSTimeIn2 + "PosUS" + strconv.Itoa(us) + "_t" + usTime2IntStr
-				usTimeIn2 = pvlv.PUSTFromString(usTimeIn2Str)
+				usTimeIn2 = vc.USTimeFmString(usTimeIn2Str)
 				usTimeInWrongStr = pvlv.USTimeNone.String()
 				if curTrial.MixedUS {
 					usTimeInWrongStr = prefixUSTimeIn + "NegUS" + strconv.Itoa(us) + "_t" + usTime2IntStr
-					usTimeInWrong = pvlv.USTimeNone.FromString(usTimeInWrongStr)
+					usTimeInWrong = vc.USTimeFmString(usTimeInWrongStr)
 				}
 			} else if curTrial.ValenceContext == pvlv.NEG {
-				negPV = pvlv.NegSMap[curTrial.USType]
-				us = int(negPV)
+				us = vc.USIdx(pvlv.NEG, curTrial.USType)
+				negPV = us
 				usTimeIn2Str = prefixUSTimeIn2 + "NegUS" + strconv.Itoa(us) + "_t" + usTime2IntStr
-				usTimeIn2 = pvlv.PUSTFromString(usTime2IntStr)
+				usTimeIn2 = vc.USTimeFmString(usTime2IntStr)
 				usTimeInWrongStr = pvlv.USTimeNone.String()
 				if curTrial.MixedUS {
 					usTimeInWrongStr = prefixUSTimeIn + "PosUS" + strconv.Itoa(us) + "_t" +
 						strconv.Itoa(i-curTrial.CSTimeStart-1)
-					usTimeInWrong = pvlv.USTimeNone.FromString(usTimeInWrongStr)
+					usTimeInWrong = vc.USTimeFmString(usTimeInWrongStr)
 				}
 			}
 		} else {
@@ -589,25 +619,25 @@ func (ev *PVLVEnv) SetupOneAlphaTrial(curTrial *data.TrialInstance, stimNum int)
 
 		if (i >= curTrial.USTimeStart) && (i <= curTrial.USTimeEnd) && curTrial.USFlag {
 		} else {
-			posPV = pvlv.PosUSNone
-			negPV = pvlv.NegUSNone
+			posPV = -1
+			negPV = -1
 		}
 		if (i > curTrial.USTimeStart) && curTrial.USFlag {
 			if curTrial.ValenceContext == pvlv.POS {
-				us = int(pvlv.PosSMap[curTrial.USType])
+				us = vc.USIdx(pvlv.POS, curTrial.USType)
 				usTimeInStr = "PosUS" + strconv.Itoa(us) + "_t" + strconv.Itoa(i-curTrial.USTimeStart-1)
-				usTimeIn = pvlv.USTimeNone.FromString(usTimeInStr)
+				usTimeIn = vc.USTimeFmString(usTimeInStr)
 				usTimeInWrongStr = pvlv.USTimeNone.String()
 				usTimeInWrong = pvlv.USTimeNone
 			} else if curTrial.ValenceContext == pvlv.NEG {
-				us = int(pvlv.NegSMap[curTrial.USType])
+				us = vc.USIdx(pvlv.NEG, curTrial.USType)
 				usTimeInStr = "NegUS" + strconv.Itoa(us) + "_t" + strconv.Itoa(i-curTrial.USTimeStart-1)
-				usTimeIn = pvlv.USTimeNone.FromString(usTimeInStr)
+				usTimeIn = vc.USTimeFmString(usTimeInStr)
 				usTimeInWrongStr = pvlv.USTimeNone.String()
 				usTimeInWrong = pvlv.USTimeNone
 			}
 		}
-		pvEmpty := pvlv.PosUSNone.Tensor()
+		pvEmpty := etensor.NewFloat64(vc.USInShape(), nil, nil)
 		curTimestepStr := ""
 		curTimeStepInt := 0
 		stimulus := ""
@@ -620,7 +650,10 @@ func (ev *PVLVEnv) SetupOneAlphaTrial(curTrial *data.TrialInstance, stimNum int)
 			ev.StdInputData.AddRows(1)
 		}
 		if nStims == 1 {
-			stimulus = stimIn.String()
+			stimulus = pvlv.StmNone.String()
+			if stimIn >= 0 {
+				stimulus = vc.Stims[stimIn]
+			}
 		} else {
 			stimulus = cs1 + cs2
 		} // // i.e., there is a 2nd stimulus, e.g., 'AX', 'BY'
@@ -630,54 +663,57 @@ func (ev *PVLVEnv) SetupOneAlphaTrial(curTrial *data.TrialInstance, stimNum int)
 		ev.StdInputData.SetCellString("Stimulus", curTimeStepInt, stimulus)
 		ev.StdInputData.SetCellString("Context", curTimeStepInt, curTrial.Context)
 
-		tsrStim := etensor.NewFloat64(pvlv.StimInShape, nil, nil)
-		tsrCtx := etensor.NewFloat64(pvlv.ContextInShape, nil, nil)
+		tsrStim := etensor.NewFloat64(vc.StimInShape(), nil, nil)
+		tsrCtx := etensor.NewFloat64(vc.ContextInShape(), nil, nil)
+		// clear any stimulus or context left in this row by the previous trial
+		ev.StdInputData.SetCellTensor("StimIn", curTimeStepInt, tsrStim)
+		ev.StdInputData.SetCellTensor("ContextIn", curTimeStepInt, tsrCtx)
 		if curTimeStepInt >= curTrial.CSTimeStart && curTimeStepInt <= curTrial.CSTimeEnd {
 			stimDenom = 1.0 + ev.PctNormTotalActStim*float64(nStims-1)
-			if stimIn != pvlv.StmNone {
-				tsrStim.SetFloat([]int{int(stimIn)}, 1.0/stimDenom)
+			if stimIn >= 0 {
+				tsrStim.SetFloat([]int{stimIn, 0}, 1.0/stimDenom)
 			}
-			if stimIn2 != pvlv.StmNone {
-				tsrStim.SetFloat([]int{int(stimIn2)}, 1.0/stimDenom)
+			if stimIn2 >= 0 {
+				tsrStim.SetFloat([]int{stimIn2, 0}, 1.0/stimDenom)
 			}
 			ev.StdInputData.SetCellTensor("StimIn", curTimeStepInt, tsrStim)
 
 			ctxtDenom = 1.0 + ev.PctNormTotalActCtx*float64(nContexts-1)
-			if contextIn != pvlv.CtxNone {
-				tsrCtx.SetFloat(contextIn.Parts(), 1.0/ctxtDenom)
+			if contextIn != nil {
+				tsrCtx.SetFloat(contextIn, 1.0/ctxtDenom)
 			}
-			if contextIn3 != pvlv.CtxNone {
-				tsrCtx.SetFloat(contextIn3.Parts(), 1.0/ctxtDenom)
+			if contextIn3 != nil {
+				tsrCtx.SetFloat(contextIn3, 1.0/ctxtDenom)
 			}
 			ev.StdInputData.SetCellTensor("ContextIn", curTimeStepInt, tsrCtx)
 		}
 		if curTimeStepInt >= curTrial.CS2TimeStart && curTimeStepInt <= curTrial.CS2TimeEnd {
 			stimDenom = 1.0 + ev.PctNormTotalActStim*float64(nStims-1)
-			if stimIn2 != pvlv.StmNone {
-				tsrStim.SetFloat([]int{int(stimIn2)}, 1.0/stimDenom)
+			if stimIn2 >= 0 {
+				tsrStim.SetFloat([]int{stimIn2, 0}, 1.0/stimDenom)
 			}
 			ev.StdInputData.SetCellTensor("StimIn", curTimeStepInt, tsrStim)
 
 			ctxtDenom = 1.0 + ev.PctNormTotalActCtx*float64(nContexts-1)
-			if contextIn2 != pvlv.CtxNone {
-				tsrCtx.SetFloat(contextIn2.Parts(), 1.0/ctxtDenom)
+			if contextIn2 != nil {
+				tsrCtx.SetFloat(contextIn2, 1.0/ctxtDenom)
 			}
-			if contextIn3 != pvlv.CtxNone {
-				tsrCtx.SetFloat(contextIn3.Parts(), 1.0/ctxtDenom)
+			if contextIn3 != nil {
+				tsrCtx.SetFloat(contextIn3, 1.0/ctxtDenom)
 			}
 			ev.StdInputData.SetCellTensor("ContextIn", curTimeStepInt, tsrCtx)
 		}
 
 		if curTrial.USFlag && (curTimeStepInt >= curTrial.USTimeStart && curTimeStepInt <= curTrial.USTimeEnd) {
 			if curTrial.USFlag && curTrial.ValenceContext == pvlv.POS {
-				if posPV != pvlv.PosUSNone {
-					ev.StdInputData.SetCellTensor("PosPV", curTimeStepInt, posPV.Tensor())
+				if posPV >= 0 {
+					ev.StdInputData.SetCellTensor("PosPV", curTimeStepInt, ev.USTensor(posPV))
 				} else {
 					ev.StdInputData.SetCellTensor("PosPV", curTimeStepInt, pvEmpty)
 				}
 			} else if curTrial.USFlag && curTrial.ValenceContext == pvlv.NEG {
-				if negPV != pvlv.NegUSNone {
-					ev.StdInputData.SetCellTensor("NegPV", curTimeStepInt, negPV.Tensor())
+				if negPV >= 0 {
+					ev.StdInputData.SetCellTensor("NegPV", curTimeStepInt, ev.USTensor(negPV))
 				} else {
 					ev.StdInputData.SetCellTensor("NegPV", curTimeStepInt, pvEmpty)
 				}
@@ -688,28 +724,45 @@ func (ev *PVLVEnv) SetupOneAlphaTrial(curTrial *data.TrialInstance, stimNum int)
 		}
 
 		usTimeDenom = 1.0 + ev.PctNormTotalActUSTime*float64(nUSTimes-1)
-		tsrUSTime := etensor.NewFloat64(pvlv.USTimeInShape, nil, nil)
+		tsrUSTime := etensor.NewFloat64(vc.USTimeInShape(), nil, nil)
 		if usTimeIn != pvlv.USTimeNone {
-			setVal := usTimeIn.Unpack().Coords()
-			tsrUSTime.SetFloat(setVal, 1.0/usTimeDenom)
+			tsrUSTime.SetFloat(vc.USTimeCoords(usTimeIn), 1.0/usTimeDenom)
 		}
 		if usTimeIn2 != pvlv.USTimeNone {
-			setVal := usTimeIn2.Unpack().Coords()
-			tsrUSTime.SetFloat(setVal, 1.0/usTimeDenom)
+			tsrUSTime.SetFloat(vc.USTimeCoords(usTimeIn2), 1.0/usTimeDenom)
 		}
 		if usTimeInWrong != pvlv.USTimeNone {
-			tsrUSTime.SetFloat(usTimeInWrong.Shape(), 1.0/usTimeDenom)
+			tsrUSTime.SetFloat(vc.USTimeCoords(usTimeInWrong), 1.0/usTimeDenom)
 		}
 		if notUSTimeIn != pvlv.USTimeNone {
-			tsrUSTime.SetFloat(notUSTimeIn.Shape(), 1.0/usTimeDenom)
+			tsrUSTime.SetFloat(vc.USTimeCoords(notUSTimeIn), 1.0/usTimeDenom)
 		}
 		ev.StdInputData.SetCellTensor("USTimeIn", curTimeStepInt, tsrUSTime)
 		if usTimeIn2Str != "" {
-			usTimeIn2Str = "+" + usTimeIn2Str + usTimeIn2.Unpack().CoordsString()
+			usTimeIn2Str = "+" + usTimeIn2Str + coordsString(vc.USTimeCoords(usTimeIn2))
 		}
 		ev.StdInputData.SetCellString("USTimeInStr", curTimeStepInt,
-			usTimeInStr+usTimeIn.Unpack().CoordsString()+usTimeIn2Str)
+			usTimeInStr+coordsString(vc.USTimeCoords(usTimeIn))+usTimeIn2Str)
+	}
+	return nil
+}
+
+// USTensor returns the PosPV or NegPV input tensor for the US with given index (-1 = none)
+func (ev *PVLVEnv) USTensor(us int) etensor.Tensor {
+	tsr := etensor.NewFloat64(ev.Vocab.USInShape(), nil, nil)
+	if us >= 0 {
+		tsr.Values[us] = 1
+	}
+	return tsr
+}
+
+// coordsString returns tensor coordinates formatted as {0,1,2,3}
+func coordsString(coords []int) string {
+	strs := make([]string, len(coords))
+	for i, c := range coords {
+		strs[i] = strconv.Itoa(c)
 	}
+	return "{" + strings.Join(strs, ",") + "}"
 }
 
 func (ev *PVLVEnv) IsTestTrial(ti *data.TrialInstance) bool {
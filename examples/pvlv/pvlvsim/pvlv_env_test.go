@@ -0,0 +1,76 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pvlvsim
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ccnlab/leabrax/examples/pvlv/data"
+	"github.com/ccnlab/leabrax/pvlv"
+	"github.com/emer/etable/etensor"
+)
+
+// ctxTrial returns a one-tick trial of CS and context ctx, with both CSs at t0
+func ctxTrial(cs, ctx string) *data.TrialInstance {
+	return &data.TrialInstance{TrialName: cs + "_NR_POS", ValenceContext: pvlv.POS, AlphaTicksPerTrialGp: 1,
+		CS: cs, USTimeStart: -1, USTimeEnd: -1, Context: ctx}
+}
+
+// activeContexts returns the coordinates of the active ContextIn units at t0
+func activeContexts(ev *PVLVEnv) [][]int {
+	tsr := ev.StdInputData.CellTensor("ContextIn", 0).(*etensor.Float64)
+	var act [][]int
+	for i, v := range tsr.Values {
+		if v > 0 {
+			act = append(act, tsr.Index(i))
+		}
+	}
+	return act
+}
+
+func TestContextIn(t *testing.T) {
+	ss := newTestSim(t, "PosSecondOrderCond")
+	ev := &ss.Env
+	vc := ss.Vocab
+	if shp := ss.Net.LayerByName("ContextIn").Shape().Shp; !reflect.DeepEqual(shp, pvlv.DefaultVocab().ContextInShape()) {
+		t.Errorf("ContextIn shape: %v != DefaultVocab: %v", shp, pvlv.DefaultVocab().ContextInShape())
+	}
+	coords := func(nm string) []int {
+		c, _ := vc.ContextCoords(nm)
+		return c
+	}
+	ss.ContextModel = CONJUNCTIVE
+	ev.ContextModel = ss.ContextModel
+	tests := []struct {
+		cs, ctx string
+		act     [][]int
+	}{
+		{"AX", "AX", [][]int{coords("AX")}},
+		{"AC", "AC", [][]int{coords("A"), coords("C")}}, // no AC unit: its elements
+		{"AX", "AX_B", [][]int{coords("A_B"), coords("X_B")}},
+		{"A", "A_B", [][]int{coords("A_B")}},
+	}
+	for _, tt := range tests {
+		ev.AlphaCycle.Init()
+		if err := ev.SetupOneAlphaTrial(ctxTrial(tt.cs, tt.ctx), 0); err != nil {
+			t.Errorf("context: %s: %v", tt.ctx, err)
+			continue
+		}
+		if act := activeContexts(ev); !reflect.DeepEqual(act, tt.act) {
+			t.Errorf("context: %s active ContextIn: %v != %v", tt.ctx, act, tt.act)
+		}
+	}
+
+	// a context missing from the Vocab stops the run with an error
+	ev.AlphaCycle.Init()
+	if err := ev.SetupOneAlphaTrial(ctxTrial("A", "Q"), 0); err == nil {
+		t.Errorf("SetupOneAlphaTrial should fail for context missing from the Vocab")
+	}
+	ev.RunOneTrial(ss, ctxTrial("AQ", "AQ"))
+	if ev.Err == nil || !ss.Stopped() {
+		t.Errorf("RunOneTrial should set Err and stop for context missing from the Vocab: %v", ev.Err)
+	}
+}
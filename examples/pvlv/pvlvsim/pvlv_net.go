@@ -2,7 +2,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-package main
+package pvlvsim
 
 import (
 	"log"
@@ -20,25 +20,30 @@ func (ss *Sim) ConfigNet(net *pvlv.Network) {
 
 	// Order of creation is partly dictated by desired layout in the display
 
-	// Inputs
-	stimIn := net.AddLayer2D("StimIn", 12, 1, emer.Input)
-	ctxIn := net.AddLayer2D("ContextIn", 20, 3, emer.Input)
-	ustimeIn := net.AddLayer4D("USTimeIn", 16, 2, 4, 5, emer.Input)
+	// Inputs, with shapes given by the vocabulary of the experiment
+	vc := ss.Vocab
+	nUSs := vc.NUSs() // US pools in amygdala and ventral striatum
+	stimShp := vc.StimInShape()
+	ctxShp := vc.ContextInShape()
+	ustShp := vc.USTimeInShape()
+	stimIn := net.AddLayer2D("StimIn", stimShp[0], stimShp[1], emer.Input)
+	ctxIn := net.AddLayer2D("ContextIn", ctxShp[0], ctxShp[1], emer.Input)
+	ustimeIn := net.AddLayer4D("USTimeIn", ustShp[0], ustShp[1], ustShp[2], ustShp[3], emer.Input)
 	//
 
 	// Primary value
-	posPV := pvlv.AddPVLayer(net, "PosPV", 1, 4, emer.Input)
+	posPV := pvlv.AddPVLayer(net, "PosPV", 1, nUSs, emer.Input)
 	posPV.SetClass("PVLVLayer PVLayer")
-	negPV := pvlv.AddPVLayer(net, "NegPV", 1, 4, emer.Input)
+	negPV := pvlv.AddPVLayer(net, "NegPV", 1, nUSs, emer.Input)
 	negPV.SetClass("PVLVLayer PVLayer")
 
 	pptg := pvlv.AddPPTgLayer(net, "PPTg", 1, 1)
 
 	lhbRmtG := pvlv.AddLHbRMTgLayer(net, "LHbRMTg") // Lateral habenula & Rostromedial tegmental nucleus
 
-	cEmPos := net.AddLayer4D("CEmPos", 1, 4, 1, 1, emer.Hidden)
+	cEmPos := net.AddLayer4D("CEmPos", 1, nUSs, 1, 1, emer.Hidden)
 	cEmPos.SetClass("CEmLayer")
-	cEmNeg := net.AddLayer4D("CEmNeg", 1, 4, 1, 1, emer.Hidden)
+	cEmNeg := net.AddLayer4D("CEmNeg", 1, nUSs, 1, 1, emer.Hidden)
 	cEmNeg.SetClass("CEmLayer")
 
 	// Ventral Striatum (VS)
@@ -46,35 +51,35 @@ func (ss *Sim) ConfigNet(net *pvlv.Network) {
 	// Ventral Striatum Patch (delayed, PV), Direct = Positive Valence expectation
 	// (increases + on LHB, causing more dipping unless counteracted with PV+)
 	vsPatchPosD1 := net.AddMSNLayer(
-		"VSPatchPosD1", 1, 4, 1, 1, pvlv.PATCH, pvlv.D1R)
+		"VSPatchPosD1", 1, nUSs, 1, 1, pvlv.PATCH, pvlv.D1R)
 	vsPatchPosD1.SetClass("VSPatchLayer")
 	vsPatchNegD2 := net.AddMSNLayer(
-		"VSPatchNegD2", 1, 4, 1, 1, pvlv.PATCH, pvlv.D2R)
+		"VSPatchNegD2", 1, nUSs, 1, 1, pvlv.PATCH, pvlv.D2R)
 	vsPatchNegD2.SetClass("VSPatchLayer")
 
 	// Ventral Striatum Patch (delayed, PV), indirect = Neg valence expectation
 	// (removes + on LHb dipper -- to cancel PV- neg outcome)
 	vsPatchPosD2 := net.AddMSNLayer(
-		"VSPatchPosD2", 1, 4, 1, 1, pvlv.PATCH, pvlv.D2R)
+		"VSPatchPosD2", 1, nUSs, 1, 1, pvlv.PATCH, pvlv.D2R)
 	vsPatchPosD2.SetClass("VSPatchLayer")
 	vsPatchNegD1 := net.AddMSNLayer(
-		"VSPatchNegD1", 1, 4, 1, 1, pvlv.PATCH, pvlv.D1R)
+		"VSPatchNegD1", 1, nUSs, 1, 1, pvlv.PATCH, pvlv.D1R)
 	vsPatchNegD1.SetClass("VSPatchLayer")
 
 	// Ventral Striatum Matrix (immediate, LV), Direct = Positive Valence (direct inhib of gpi, removes + on LHb dipper)
 	vsMatrixPosD1 := net.AddMSNLayer(
-		"VSMatrixPosD1", 1, 4, 1, 1, pvlv.MATRIX, pvlv.D1R)
+		"VSMatrixPosD1", 1, nUSs, 1, 1, pvlv.MATRIX, pvlv.D1R)
 	vsMatrixPosD1.SetClass("VSMatrixLayer")
 	vsMatrixNegD2 := net.AddMSNLayer(
-		"VSMatrixNegD2", 1, 4, 1, 1, pvlv.MATRIX, pvlv.D2R)
+		"VSMatrixNegD2", 1, nUSs, 1, 1, pvlv.MATRIX, pvlv.D2R)
 	vsMatrixNegD2.SetClass("VSMatrixLayer")
 
 	// Ventral Striatum Matrix (immediate, LV), Indirect = Negative Valence (increases + on LHb, causing more dipping)
 	vsMatrixPosD2 := net.AddMSNLayer(
-		"VSMatrixPosD2", 1, 4, 1, 1, pvlv.MATRIX, pvlv.D2R)
+		"VSMatrixPosD2", 1, nUSs, 1, 1, pvlv.MATRIX, pvlv.D2R)
 	vsMatrixPosD2.SetClass("VSMatrixLayer")
 	vsMatrixNegD1 := net.AddMSNLayer(
-		"VSMatrixNegD1", 1, 4, 1, 1, pvlv.MATRIX, pvlv.D1R)
+		"VSMatrixNegD1", 1, nUSs, 1, 1, pvlv.MATRIX, pvlv.D1R)
 	vsMatrixNegD1.SetClass("VSMatrixLayer")
 
 	for _, layer := range []*pvlv.MSNLayer{
@@ -87,20 +92,20 @@ func (ss *Sim) ConfigNet(net *pvlv.Network) {
 	// Learned Value
 	// Amygdala
 	// Basolateral amygdala (BLA)
-	blAmygPosD1 := net.AddBlAmygLayer("BLAmygPosD1", 1, 4, 7, 9, pvlv.POS, pvlv.D1R, emer.Hidden)
-	blAmygPosD2 := net.AddBlAmygLayer("BLAmygPosD2", 1, 4, 7, 9, pvlv.POS, pvlv.D2R, emer.Hidden)
-	blAmygNegD1 := net.AddBlAmygLayer("BLAmygNegD1", 1, 4, 7, 9, pvlv.NEG, pvlv.D1R, emer.Hidden)
-	blAmygNegD2 := net.AddBlAmygLayer("BLAmygNegD2", 1, 4, 7, 9, pvlv.NEG, pvlv.D2R, emer.Hidden)
+	blAmygPosD1 := net.AddBlAmygLayer("BLAmygPosD1", 1, nUSs, 7, 9, pvlv.POS, pvlv.D1R, emer.Hidden)
+	blAmygPosD2 := net.AddBlAmygLayer("BLAmygPosD2", 1, nUSs, 7, 9, pvlv.POS, pvlv.D2R, emer.Hidden)
+	blAmygNegD1 := net.AddBlAmygLayer("BLAmygNegD1", 1, nUSs, 7, 9, pvlv.NEG, pvlv.D1R, emer.Hidden)
+	blAmygNegD2 := net.AddBlAmygLayer("BLAmygNegD2", 1, nUSs, 7, 9, pvlv.NEG, pvlv.D2R, emer.Hidden)
 	for _, layer := range []emer.Layer{blAmygPosD1, blAmygPosD2, blAmygNegD1, blAmygNegD2} {
 		layer.SetClass("BLAmygLayer")
 		vtaPSendsTo = append(vtaPSendsTo, layer.Name())
 	}
 
 	// Centrolateral amygdala
-	celAcqPosD1 := net.AddCElAmygLayer("CElAcqPosD1", 1, 4, 1, 1, pvlv.Acq, pvlv.POS, pvlv.D1R)
-	celExtPosD2 := net.AddCElAmygLayer("CElExtPosD2", 1, 4, 1, 1, pvlv.Ext, pvlv.POS, pvlv.D2R)
-	celExtNegD1 := net.AddCElAmygLayer("CElExtNegD1", 1, 4, 1, 1, pvlv.Ext, pvlv.NEG, pvlv.D1R)
-	celAcqNegD2 := net.AddCElAmygLayer("CElAcqNegD2", 1, 4, 1, 1, pvlv.Acq, pvlv.NEG, pvlv.D2R)
+	celAcqPosD1 := net.AddCElAmygLayer("CElAcqPosD1", 1, nUSs, 1, 1, pvlv.Acq, pvlv.POS, pvlv.D1R)
+	celExtPosD2 := net.AddCElAmygLayer("CElExtPosD2", 1, nUSs, 1, 1, pvlv.Ext, pvlv.POS, pvlv.D2R)
+	celExtNegD1 := net.AddCElAmygLayer("CElExtNegD1", 1, nUSs, 1, 1, pvlv.Ext, pvlv.NEG, pvlv.D1R)
+	celAcqNegD2 := net.AddCElAmygLayer("CElAcqNegD2", 1, nUSs, 1, 1, pvlv.Acq, pvlv.NEG, pvlv.D2R)
 	for _, layer := range []emer.Layer{celAcqPosD1, celExtPosD2, celExtNegD1, celAcqNegD2} {
 		layer.SetClass("CElAmyg")
 		vtaPSendsTo = append(vtaPSendsTo, layer.Name())
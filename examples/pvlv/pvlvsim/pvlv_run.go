@@ -2,10 +2,11 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-package main
+package pvlvsim
 
 import (
 	"fmt"
+	"log"
 
 	"github.com/ccnlab/leabrax/examples/pvlv/data"
 	"github.com/ccnlab/leabrax/leabra"
@@ -223,7 +224,12 @@ func (ev *PVLVEnv) RunOneTrial(ss *Sim, curTrial *data.TrialInstance) (blockDone
 	trialDone := false
 	ss.Net.ClearModActs(&ss.Time)
 	for !trialDone {
-		ev.SetupOneAlphaTrial(curTrial, 0)
+		if err := ev.SetupOneAlphaTrial(curTrial, 0); err != nil {
+			ev.Err = err
+			log.Println(err)
+			ss.Stepper.Stop()
+			return true
+		}
 		train = !ev.IsTestTrial(curTrial)
 		ev.RunOneAlphaCycle(ss, curTrial)
 		trialDone = ev.AlphaCycle.Incr()
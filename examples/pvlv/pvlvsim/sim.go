@@ -0,0 +1,1038 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pvlvsim
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/emer/emergent/env"
+	"github.com/emer/emergent/params"
+	"github.com/emer/emergent/stepper"
+	"github.com/emer/etable/etable"
+	"github.com/emer/etable/etensor"
+	"github.com/goki/ki/ki"
+	"github.com/goki/ki/kit"
+
+	"github.com/ccnlab/leabrax/examples/pvlv/data"
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/ccnlab/leabrax/pvlv"
+)
+
+// MonitorVal is similar to the Neuron field mechanism, but allows us to implement monitors for arbitrary
+// quanities without messing with fields that are intrinsic to the workings of our model.
+type MonitorVal interface {
+	GetMonitorVal([]string) float64
+}
+
+// LogPrec is precision for saving float values in logs
+const LogPrec = 4
+
+// Viewer is implemented by a GUI for the Sim, which the Sim notifies as it runs
+// so that the views can be updated.  The Sim runs without any views if it is nil.
+type Viewer interface {
+	// UpdateView updates the network view for the current state of the Sim
+	UpdateView()
+
+	// UpdatePlot updates the plot of given table after new data has been logged to it
+	UpdatePlot(dt *etable.Table)
+
+	// ResetPlot updates the plot of given table after its rows have been reconfigured
+	ResetPlot(dt *etable.Table)
+
+	// Render updates the toolbar actions and re-renders the window
+	Render()
+}
+
+// Sim has the PVLV model and its environment, and the state for running it,
+// without any GUI, which can be attached as the View
+type Sim struct {
+	RunParamsNm       string                `inactive:"+" desc:"Name of the current run. Use menu above to set"`
+	RunParams         *data.RunParams       `desc:"For sequences of conditions"`
+	ConditionParamsNm string                `inactive:"+" desc:"name of current ConditionParams"`
+	ConditionParams   *data.ConditionParams `desc:"pointer to current ConditionParams"`
+	Tag               string                `desc:"extra tag string to add to any file names output from sim (e.g., weights files, log files, params for run)"`
+	Params            params.Sets           `view:"no-inline" desc:"pvlv-specific network parameters"`
+	ParamSet          string
+	//StableParams                 params.Set        `view:"no-inline" desc:"shouldn't need to change these'"`
+	//MiscParams                   params.Set        `view:"no-inline" desc:"misc params -- network specs"`
+	//AnalysisParams               params.Set        `view:"no-inline" desc:"??"`
+	Env PVLVEnv `desc:"environment -- PVLV environment"`
+	//TestEnv                      PVLVEnv           `desc:"Testing environment -- PVLV environment"`
+	StepsToRun                   int               `view:"-" desc:"number of StopStepGrain steps to execute before stopping"`
+	OrigSteps                    int               `view:"-" desc:"saved number of StopStepGrain steps to execute before stopping"`
+	StepGrain                    StepGrain         `view:"-" desc:"granularity for the Step command"`
+	StopStepCondition            StopStepCond      `desc:"granularity for conditional stop"`
+	StopConditionTrialNameString string            `desc:"if StopStepCond is TrialName or NotTrialName, this string is used for matching the current AlphaTrialName"`
+	StopStepCounter              env.Ctr           `inactive:"+" view:"-" desc:"number of times we've hit whatever StopStepGrain is set to'"`
+	StepMode                     bool              `view:"-" desc:"running from Step command?"`
+	TestMode                     bool              `inactive:"+" desc:"testing mode, no training"`
+	CycleLogUpdt                 leabra.TimeScales `desc:"time scale for updating CycleOutputData. NOTE: Only Cycle and Quarter are currently implemented"`
+	NetTimesCycleQtr             bool              `desc:"turn this OFF to see cycle-level updating"`
+	TrialAnalysisTimeLogInterval int
+	TrialAnalUpdateCmpGraphs     bool                `desc:"turn off to preserve existing cmp graphs - else saves cur as cmp for new run"`
+	Net                          *pvlv.Network       `view:"no-inline" desc:"the network -- click to view / edit parameters for layers, prjns, etc"`
+	CycleOutputDataRows          int                 `desc:"maximum number of rows for CycleOutputData"`
+	CycleOutputData              *etable.Table       `view:"no-inline" desc:"Cycle-level output data"`
+	CycleOutputMetadata          map[string][]string `view:"-"`
+	TimeLogBlock                 int                 `desc:"current block within current run phase"`
+	TimeLogBlockAll              int                 `desc:"current block across all phases of the run"`
+	Time                         leabra.Time         `desc:"leabra timing parameters and state"`
+	ViewOn                       bool                `desc:"whether to update the network view while running"`
+	TrainUpdt                    leabra.TimeScales   `desc:"at what time scale to update the display during training?  Anything longer than TrialGp updates at TrialGp in this model"`
+	TestUpdt                     leabra.TimeScales   `desc:"at what time scale to update the display during testing?  Anything longer than TrialGp updates at TrialGp in this model"`
+	TstRecLays                   []string            `view:"-" desc:"names of layers to record activations etc of during testing"`
+	ContextModel                 ContextModel        `desc:"how to treat multi-part contexts. elemental=all parts, conjunctive=single context encodes parts, both=parts plus conjunctively encoded"`
+	// internal state - view:"-"
+	View                      Viewer                      `view:"-" desc:"the GUI views of the sim, if any"`
+	TrialTypeData             *etable.Table               `view:"no-inline" desc:"data for the TrialTypeData plot"`
+	TrialTypeBlockFirstLog    *etable.Table               `view:"no-inline" desc:"data for the TrialTypeData plot"`
+	TrialTypeBlockFirstLogCmp *etable.Table               `view:"no-inline" desc:"data for the TrialTypeData plot"`
+	TrialTypeDataPerBlock     bool                        `desc:"clear the TrialTypeData plot between parts of a run"`
+	TrialTypeSet              map[string]int              `view:"-"`
+	GlobalTrialTypeSet        map[string]int              `view:"-"`
+	SaveWts                   bool                        `view:"-" desc:"for command-line run only, auto-save final weights after each run"`
+	NoGui                     bool                        `view:"-" desc:"if true, runing in no GUI mode"`
+	RndSeed                   int64                       `desc:"the current random seed"`
+	Stepper                   *stepper.Stepper            `view:"-"`
+	SimHasRun                 bool                        `view:"-"`
+	IsRunning                 bool                        `view:"-"`
+	InitHasRun                bool                        `view:"-"`
+	VerboseInit               bool                        `view:"-"`
+	LayerThreads              bool                        `desc:"use per-layer threads"`
+	TrialTypeBlockFirstLogged map[string]bool             `view:"-"`
+	TrnEpcFile                *os.File                    `view:"-" desc:"log file"`
+	RunFile                   *os.File                    `view:"-" desc:"log file"`
+	ValsTsrs                  map[string]*etensor.Float32 `view:"-" desc:"for holding layer values"`
+	LogSetParams              bool                        `view:"-" desc:"if true, print message for all params that are set"`
+	Interactive               bool                        `view:"-" desc:"true iff running through the GUI"`
+	InputShapes               map[string][]int            `view:"-"`
+
+	// master lists of various kinds of parameters
+	ExptFile               string                  `view:"-" desc:"experiment definitions loaded at startup: a JSON file, or a directory of TSV files -- see pvlv.Experiment"`
+	Vocab                  *pvlv.Vocab             `view:"-" desc:"input vocabulary of the experiment, from which the input layer shapes are derived"`
+	MasterRunParams        data.RunParamsMap       `view:"no-inline" desc:"master list of RunParams records"`
+	MasterConditionParams  data.ConditionParamsMap `view:"no-inline" desc:"master list of ConditionParams records"`
+	MasterTrialBlockParams data.TrialBlockMap      `desc:"master list of BlockParams (sets of trial groups) records"`
+	MaxConditions          int                     `view:"-" desc:"maximum number of conditions to run through"` // for non-GUI runs
+	simOneTimeInit         sync.Once
+}
+
+func (ss *Sim) New() {
+	ss.Net = &pvlv.Network{}
+	ss.CycleOutputData = &etable.Table{}
+	ss.TrialTypeData = &etable.Table{}
+	ss.TrialTypeBlockFirstLog = &etable.Table{}
+	ss.TrialTypeBlockFirstLogCmp = &etable.Table{}
+	ss.TrialTypeSet = map[string]int{}
+	ss.GlobalTrialTypeSet = map[string]int{}
+	ss.simOneTimeInit.Do(func() {
+		err := ss.OpenExpt()
+		if err != nil {
+			log.Fatalln(err)
+		}
+		ss.InputShapes = map[string][]int{
+			"StimIn":    ss.Vocab.StimInShape(),
+			"ContextIn": ss.Vocab.ContextInShape(),
+			"USTimeIn":  ss.Vocab.USTimeInShape(), // cs, valence, us, time
+			"PosPV":     ss.Vocab.USInShape(),
+			"NegPV":     ss.Vocab.USInShape(),
+		}
+		ss.Env = PVLVEnv{Nm: "Env", Dsc: "run environment"}
+		ss.Env.New(ss)
+		ss.StepsToRun = 1
+		ss.StepGrain = SGTrial
+		ss.StopStepCondition = SSNone
+		ss.Stepper = stepper.New()
+		ss.Stepper.StopCheckFn = ss.CheckStopCondition
+		ss.Stepper.PauseNotifyFn = ss.NotifyPause
+	})
+	ss.Defaults()
+	ss.Params = ParamSets
+	ss.CycleOutputDataRows = 10000
+	ss.InitHasRun = false
+
+}
+
+func (ss *Sim) Defaults() {
+	defaultRunSeqNm := "PosAcq"
+	ss.ContextModel = CONJUNCTIVE
+	ss.RunParamsNm = defaultRunSeqNm
+	err := ss.SetRunParams()
+	if err != nil {
+		panic(err)
+	}
+	ss.TrainUpdt = leabra.AlphaCycle
+	ss.TestUpdt = leabra.AlphaCycle
+	ss.CycleLogUpdt = leabra.Quarter
+	ss.NetTimesCycleQtr = true
+	ss.TrialAnalysisTimeLogInterval = 1
+	ss.TrialAnalUpdateCmpGraphs = true
+	ss.TrialTypeDataPerBlock = true
+	ss.StopConditionTrialNameString = "_t3"
+	ss.ViewOn = true
+	ss.RndSeed = 1
+}
+
+func (ss *Sim) MaybeUpdate(train, exact bool, checkTS leabra.TimeScales) {
+	if !ss.ViewOn {
+		return
+	}
+	var ts leabra.TimeScales
+	if train {
+		ts = ss.TrainUpdt
+	} else {
+		ts = ss.TestUpdt
+	}
+	if (exact && ts == checkTS) || ts <= checkTS {
+		ss.UpdateView()
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////
+// 		Top-level Configs
+
+// Config configures all the elements using the standard functions
+func (ss *Sim) Config() {
+	ss.ConfigEnv()
+	ss.ConfigNet(ss.Net)
+	ss.ConfigOutputData()
+	ss.InitSim()
+}
+
+func (ss *Sim) ConfigEnv() {
+	ss.Env.Init(ss, true)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Init, utils
+
+func (ss *Sim) Init(aki ki.Ki) {
+	//ss.Layout.Init(aki)
+}
+
+func (ss *Sim) Ki() *Sim {
+	return ss
+}
+
+type StopStepCond int
+
+const (
+	SSNone              StopStepCond = iota // None
+	SSTrialNameMatch                        // Trial Name
+	SSTrialNameNonmatch                     // Not Trial Name
+	StopStepCondN
+)
+
+// //go:generate stringer -type=StopStepCond -linecomment // moved to stringers.go
+var KiT_StopStepCond = kit.Enums.AddEnum(StopStepCondN, kit.NotBitFlag, nil)
+
+// Init restarts the run, and initializes everything, including network weights
+// and resets the block log table
+func (ss *Sim) InitSim() {
+	ev := &ss.Env
+	rand.Seed(ss.RndSeed)
+	ss.Stepper.Init()
+	ev.TrialInstances = data.NewTrialInstanceRecs(nil)
+	err := ss.SetParams("", ss.VerboseInit) // all sheets
+	if err != nil {
+		fmt.Println(err)
+	}
+	err = ss.InitCondition(true)
+	if err != nil {
+		fmt.Println("ERROR: InitCondition failed in InitSim")
+	}
+	ss.Net.InitWts()
+	ss.InitHasRun = true
+	ss.VerboseInit = false
+}
+
+// NewRndSeed gets a new random seed based on current time -- otherwise uses
+// the same random seed for every run
+func (ss *Sim) NewRndSeed() {
+	ss.RndSeed = time.Now().UnixNano()
+}
+
+// Counters returns a string of the current counter state
+// use tabs to achieve a reasonable formatting overall
+// and add a few tabs at the end to allow for expansion..
+func (ss *Sim) Counters() string {
+	ev := &ss.Env
+	return fmt.Sprintf("Condition:\t%d(%s)\tBlock:\t%03d\tTrial:\t%02d\tAlpha:\t%01d\tCycle:\t%03d\t\tName:\t%12v\t\t\t",
+		ev.ConditionCt.Cur, ev.CurConditionParams.TrialBlkNm, ev.TrialBlockCt.Cur, ev.TrialCt.Cur, ev.AlphaCycle.Cur,
+		ss.Time.Cycle, ev.AlphaTrialName) //, ev.USTimeInStr)
+}
+
+// UpdateView updates the network view of the View, if any
+func (ss *Sim) UpdateView() {
+	if ss.View != nil {
+		ss.View.UpdateView()
+	}
+}
+
+// UpdatePlot updates the View plot of given table, if any
+func (ss *Sim) UpdatePlot(dt *etable.Table) {
+	if ss.View != nil {
+		ss.View.UpdatePlot(dt)
+	}
+}
+
+// Render re-renders the View, if any
+func (ss *Sim) Render() {
+	if ss.View != nil {
+		ss.View.Render()
+	}
+}
+
+// Stopped is called when a run method stops running -- updates the IsRunning flag and toolbar
+func (ss *Sim) NotifyStopped() {
+	ss.Stepper.Stop()
+	if ss.View != nil {
+		ss.View.UpdateView()
+		ss.View.Render()
+	}
+	fmt.Println("stopped")
+}
+
+// configure output data tables
+func (ss *Sim) ConfigCycleOutputData(dt *etable.Table) {
+	dt.SetMetaData("name", "CycleOutputData")
+	dt.SetMetaData("desc", "Cycle-level output data")
+	dt.SetMetaData("read-only", "true")
+	dt.SetMetaData("precision", strconv.Itoa(LogPrec))
+
+	floatCols := []string{
+		"VSPatchPosD1_0_Act", "VSPatchPosD2_0_Act",
+		"VSPatchNegD1_0_Act", "VSPatchNegD2_0_Act",
+		"VSMatrixPosD1_0_Act", "VSMatrixPosD2_0_Act",
+		"VSMatrixNegD1_0_Act", "VSMatrixNegD2_0_Act",
+		//
+		//"VSMatrixPosD1_0_ModNet", "VSMatrixPosD1_0_DA",
+		//"VSMatrixPosD2_0_ModNet", "VSMatrixPosD2_0_DA",
+
+		"PosPV_0_Act", "StimIn_0_Act", "ContextIn_0_Act", "USTimeIn_0_Act",
+
+		"VTAp_0_Act", "LHbRMTg_0_Act", "PPTg_0_Act",
+		//"VTAp_0_PPTgDApt", "VTAp_0_LHbDA", "VTAp_0_PosPVAct", "VTAp_0_VSPosPVI", "VTAp_0_VSNegPVI", "VTAp_0_BurstLHbDA",
+		//"VTAp_0_DipLHbDA", "VTAp_0_TotBurstDA", "VTAp_0_TotDipDA", "VTAp_0_NetDipDA", "VTAp_0_NetDA", "VTAp_0_SendVal",
+		//
+		//"LHbRMTg_0_VSPatchPosD1", "LHbRMTg_0_VSPatchPosD2","LHbRMTg_0_VSPatchNegD1","LHbRMTg_0_VSPatchNegD2",
+		"LHbRMTg_0_VSMatrixPosD1", "LHbRMTg_0_VSMatrixPosD2", "LHbRMTg_0_VSMatrixNegD1", "LHbRMTg_0_VSMatrixNegD2",
+		//"LHbRMTg_0_VSPatchPosNet", "LHbRMTg_0_VSPatchNegNet","LHbRMTg_0_VSMatrixPosNet","LHbRMTg_0_VSMatrixNegNet",
+		//"LHbRMTg_0_PosPV", "LHbRMTg_0_NegPV","LHbRMTg_0_NetPos","LHbRMTg_0_NetNeg",
+
+		//"CElAcqPosD1_0_ModAct", "CElAcqPosD1_0_PVAct",
+		//"CElAcqPosD1_0_ModLevel", "CElAcqPosD1_0_ModLrn",
+		//"CElAcqPosD1_0_Act", "CElAcqPosD1_0_ActP", "CElAcqPosD1_0_ActQ0", "CElAcqPosD1_0_ActM",
+		//"CElAcqPosD1_1_ModPoolAvg", "CElAcqPosD1_1_PoolActAvg", "CElAcqPosD1_1_PoolActMax",
+		//
+		//"CElExtPosD2_0_ModAct", "CElExtPosD2_0_ModLevel", "CElExtPosD2_0_ModNet", "CElExtPosD2_0_ModLrn",
+		//"CElExtPosD2_0_Act", "CElExtPosD2_0_Ge", "CElExtPosD2_0_Gi", "CElExtPosD2_0_Inet", "CElExtPosD2_0_GeRaw",
+		//
+		//"BLAmygPosD1_3_Act", "BLAmygPosD1_3_ModAct", "BLAmygPosD1_3_ActDiff", "BLAmygPosD1_3_ActQ0",
+		//"BLAmygPosD1_3_ModLevel", "BLAmygPosD1_3_ModNet", "BLAmygPosD1_3_ModLrn", "BLAmygPosD1_3_DA",
+		//"BLAmygPosD1_1_PoolActAvg", "BLAmygPosD1_1_PoolActMax", "BLAmygPosD1_2_PoolActAvg", "BLAmygPosD1_2_PoolActMax",
+		//
+		//"BLAmygPosD2_5_Act", "BLAmygPosD2_5_ModAct", "BLAmygPosD2_5_ActDiff", "BLAmygPosD2_5_ActQ0",
+		//"BLAmygPosD2_5_ModLevel", "BLAmygPosD2_5_ModNet", "BLAmygPosD2_5_ModLrn", "BLAmygPosD2_5_DA",
+
+		"CEmPos_0_Act",
+	}
+	ss.CycleOutputMetadata = make(map[string][]string, len(floatCols))
+
+	sch := etable.Schema{}
+	sch = append(sch, etable.Column{Name: "Cycle", Type: etensor.INT32})
+	sch = append(sch, etable.Column{Name: "GlobalStep", Type: etensor.INT32})
+	for _, colName := range floatCols {
+		parts := strings.Split(colName, "_")
+		idx := parts[1]
+		val := parts[2]
+		var md []string
+		sch = append(sch, etable.Column{Name: colName, Type: etensor.FLOAT64})
+		md = append(md, val)
+		md = append(md, idx)
+		ss.CycleOutputMetadata[colName] = md
+	}
+	dt.SetFromSchema(sch, ss.CycleOutputDataRows)
+}
+
+func (ss *Sim) ConfigOutputData() {
+	ss.ConfigCycleOutputData(ss.CycleOutputData)
+	ss.ConfigTrialTypeTables(0)
+}
+
+func (ss *Sim) ConfigTrialTypeTables(nRows int) {
+	ss.ConfigTrialTypeBlockFirstLog(ss.TrialTypeBlockFirstLog, "TrialTypeBlockFirst", nRows)
+	ss.ConfigTrialTypeBlockFirstLog(ss.TrialTypeBlockFirstLogCmp, "TrialTypeBlockFirstCmp", nRows)
+	ss.ConfigTrialTypeData(ss.TrialTypeData)
+}
+
+// end output data config
+
+// configure plots
+func (ss *Sim) ConfigTrialTypeBlockFirstLog(dt *etable.Table, name string, nRows int) {
+	dt.SetMetaData("name", name)
+	dt.SetMetaData("desc", "Multi-block monitor")
+	dt.SetMetaData("read-only", "true")
+	dt.SetMetaData("precision", strconv.Itoa(LogPrec))
+	sch := etable.Schema{}
+
+	colNames := []string{
+		"GlobalTrialBlock", "VTAp_act", "BLAmygD1_US0_act", "BLAmygD2_US0_act",
+		"CElAcqPosD1_US0_act", "CElExtPosD2_US0_act", "CElAcqNegD2_US0_act",
+		"VSMatrixPosD1_US0_act", "VSMatrixPosD2_US0_act",
+	}
+
+	for _, colName := range colNames {
+		if colName == "GlobalTrialBlock" {
+			sch = append(sch, etable.Column{Name: colName, Type: etensor.INT64})
+		} else {
+			sch = append(sch, etable.Column{Name: colName, Type: etensor.FLOAT64, CellShape: []int{nRows, 1}, DimNames: []string{"Tick", "Value"}})
+		}
+	}
+	dt.SetFromSchema(sch, nRows)
+	dt.SetNumRows(nRows)
+}
+
+func (ss *Sim) ConfigTrialTypeData(dt *etable.Table) {
+	dt.SetMetaData("name", "TrialTypeData")
+	dt.SetMetaData("desc", "Plot of activations for different trial types")
+	dt.SetMetaData("read-only", "true")
+	dt.SetMetaData("precision", strconv.Itoa(LogPrec))
+
+	colNames := []string{
+		"TrialType",
+		"GlobalTrialBlock",
+		"VTAp_act", "LHbRMTg_act",
+		"CElAcqPosD1_US0_act", "CElExtPosD2_US0_act",
+		"VSPatchPosD1_US0_act", "VSPatchPosD2_US0_act",
+		"VSPatchNegD1_US0_act", "VSPatchNegD2_US0_act",
+		"VSMatrixPosD1_US0_act", "VSMatrixPosD2_US0_act",
+		"VSMatrixNegD1_US0_act", "VSMatrixNegD2_US0_act",
+		"CElAcqNegD2_US0_act", "CElExtNegD1_US0_act",
+		"CEmPos_US0_act", "VTAn_act",
+	}
+	sch := etable.Schema{}
+
+	for _, colName := range colNames {
+		if colName == "TrialType" {
+			sch = append(sch, etable.Column{Name: colName, Type: etensor.STRING})
+		} else {
+			sch = append(sch, etable.Column{Name: colName, Type: etensor.FLOAT64})
+		}
+	}
+	dt.SetFromSchema(sch, len(ss.TrialTypeSet))
+}
+
+func (ss *Sim) Stopped() bool {
+	return ss.Stepper.RunState == stepper.Stopped
+}
+
+func (ss *Sim) Paused() bool {
+	return ss.Stepper.RunState == stepper.Paused
+}
+
+// SetParams sets the params for "Base" and then current ParamSet.
+// If sheet is empty, then it applies all avail sheets (e.g., Network, Sim)
+// otherwise just the named sheet
+// if setMsg = true then we output a message for each param that was set.
+func (ss *Sim) SetParams(sheet string, setMsg bool) error {
+	if sheet == "" {
+		// this is important for catching typos and ensuring that all sheets can be used
+		err := ss.Params.ValidateSheets([]string{"Network"})
+		if err != nil {
+			fmt.Printf("error in validate sheets for Network: %v\n", err)
+		}
+	}
+	err := ss.SetParamsSet("Base", sheet, setMsg)
+
+	return err
+}
+
+// SetParamsSet sets the params for given params.Set name.
+// If sheet is empty, then it applies all avail sheets (e.g., Network, Sim)
+// otherwise just the named sheet
+// if setMsg = true then we output a message for each param that was set.
+func (ss *Sim) SetParamsSet(setNm string, sheet string, setMsg bool) error {
+	pset, err := ss.Params.SetByNameTry(setNm)
+	if err != nil {
+		return err
+	}
+	if sheet == "" || sheet == "Network" {
+		netp, ok := pset.Sheets["Network"]
+		if ok {
+			applied, err := ss.Net.ApplyParams(netp, setMsg)
+			if err != nil {
+				fmt.Printf("error when applying %v, applied=%v, err=%v\n", netp, applied, err)
+			}
+		}
+	}
+
+	if sheet == "" || sheet == "Sim" {
+		simp, ok := pset.Sheets["Sim"]
+		if ok {
+			applied, err := simp.Apply(ss, setMsg)
+			if err != nil {
+				fmt.Printf("error when applying %v, applied=%v, err=%v\n", simp, applied, err)
+			}
+		}
+	}
+	return err
+}
+
+// These props register Save methods so they can be used
+func (ss *Sim) RunEnd() {
+	//ss.LogRun(ss.RunLog)
+}
+
+// ParamsName returns name of current set of parameters
+func (ss *Sim) ParamsName() string {
+	if ss.ParamSet == "" {
+		return "Base"
+	}
+	return ss.RunParams.Nm
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////
+// 		Logging
+
+// ValsTsr gets value tensor of given name, creating if not yet made
+func (ss *Sim) ValsTsr(name string) *etensor.Float32 {
+	if ss.ValsTsrs == nil {
+		ss.ValsTsrs = make(map[string]*etensor.Float32)
+	}
+	tsr, ok := ss.ValsTsrs[name]
+	if !ok {
+		tsr = &etensor.Float32{}
+		ss.ValsTsrs[name] = tsr
+	}
+	return tsr
+}
+
+// RunName returns a name for this run that combines Tag and Params -- add this to
+// any file names that are saved.
+func (ss *Sim) RunName() string {
+	if ss.Tag != "" {
+		return ss.Tag + "_" + ss.ParamsName()
+	} else {
+		return ss.ParamsName()
+	}
+}
+
+// RunBlockName returns a string with the run and block numbers with leading zeros, suitable
+// for using in weights file names.  Uses 3, 5 digits for each.
+func (ss *Sim) RunBlockName(run, epc int) string {
+	return fmt.Sprintf("%03d_%05d", run, epc)
+}
+
+// WeightsFileName returns default current weights file name
+func (ss *Sim) WeightsFileName() string {
+	return ss.Net.Nm + "_" + ss.RunName() + "_" + ss.RunBlockName(ss.Env.ConditionCt.Cur, ss.Env.TrialBlockCt.Cur) + ".wts.gz"
+}
+
+// LogFileName returns default log file name
+func (ss *Sim) LogFileName(lognm string) string {
+	return ss.Net.Nm + "_" + ss.RunName() + "_" + lognm + ".csv"
+}
+
+//////////////////////////////////////////////
+//  TrnEpcLog
+
+// LogTrnBlk adds data from current block to the TrnBlkLog table.
+// computes block averages prior to logging.
+func (ss *Sim) LogTrnBlk() {
+	ss.UpdatePlot(ss.TrialTypeBlockFirstLog)
+}
+
+func (ss *Sim) SetRunParams() error {
+	var err error = nil
+	if ss.RunParams == nil || ss.RunParamsNm != ss.RunParams.Nm {
+		oldSeqParams := ss.RunParams
+		newSeqParams, found := ss.GetRunParams(ss.RunParamsNm)
+		if !found {
+			err = errors.New(fmt.Sprintf("RunSeq \"%v\" was not found!", ss.RunParamsNm))
+			fmt.Println(err)
+			return err
+		} else {
+			ss.RunParams = newSeqParams
+			newBlockParams, found := ss.GetConditionParams(ss.RunParams.Cond1Nm)
+			if !found {
+				err = errors.New(fmt.Sprintf("RunParams step 1 \"%v\" was not found!", ss.RunParams.Cond1Nm))
+				ss.RunParams = oldSeqParams
+				return err
+			} else {
+				ss.ConditionParams = newBlockParams
+				ss.Env.CurConditionParams = ss.ConditionParams
+				ss.ConditionParamsNm = ss.ConditionParams.Nm
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// InitCondition intializes a new run of the model, using the Env.ConditionCt counter
+// for the new run value
+func (ss *Sim) InitRun() error {
+	ev := &ss.Env
+	err := ss.SetRunParams()
+	if err != nil {
+		return err
+	}
+	ev.GlobalStep = 0
+	ss.ClearCycleData()
+	ss.TrialTypeData.SetNumRows(0)
+	tgNmMap, _, err := ss.RunSeqTrialTypes(ss.RunParams)
+	ss.TrialTypeBlockFirstLogged = map[string]bool{}
+	for key := range tgNmMap {
+		ss.TrialTypeBlockFirstLogged[key] = false
+	}
+	ss.ConfigTrialTypeTables(len(tgNmMap)) // max number of rows for entire sequence, for TrialTypeBlockFirst only
+	err = ss.InitCondition(true)
+	if err != nil {
+		fmt.Println("ERROR: InitCondition failed")
+	}
+	ss.UpdateView()
+	ss.Render()
+	return nil
+}
+
+// InitCondition intializes a new run of the model, using the Env.ConditionCt counter
+// for the new run value
+func (ss *Sim) InitCondition(firstInSeq bool) (err error) {
+	ev := &ss.Env
+	err = ss.SetRunParams()
+	if err != nil {
+		return err
+	}
+	ss.Time.Reset()
+	ss.Net.InitActs()
+	ss.TimeLogBlock = 0
+	ev.Init(ss, firstInSeq)
+	if firstInSeq || ss.TrialTypeDataPerBlock {
+		_ = ss.SetTrialTypeDataXLabels()
+	}
+	return nil
+}
+
+func (ss *Sim) GetRunConditions(runParams *data.RunParams) *[5]*data.ConditionParams {
+	var found bool
+	conditions := &[5]*data.ConditionParams{}
+	conditions[0], found = ss.GetConditionParams(runParams.Cond1Nm)
+	if !found {
+		fmt.Println("Condition", runParams.Cond1Nm, "was not found")
+	}
+	conditions[1], found = ss.GetConditionParams(runParams.Cond2Nm)
+	if !found {
+		fmt.Println("Condition", runParams.Cond2Nm, "was not found")
+	}
+	conditions[2], found = ss.GetConditionParams(runParams.Cond3Nm)
+	if !found {
+		fmt.Println("Condition", runParams.Cond3Nm, "was not found")
+	}
+	conditions[3], found = ss.GetConditionParams(runParams.Cond4Nm)
+	if !found {
+		fmt.Println("Condition", runParams.Cond4Nm, "was not found")
+	}
+	conditions[4], found = ss.GetConditionParams(runParams.Cond5Nm)
+	if !found {
+		fmt.Println("Condition", runParams.Cond5Nm, "was not found")
+	}
+	return conditions
+}
+
+// Run
+// Block the currently selected sequence of runs
+// Each run has its own set of trial types
+func (ss *Sim) ExecuteRun() bool {
+	ss.Net.InitActs()
+	allDone := false
+	conditions := ss.GetRunConditions(ss.RunParams)
+	ss.TimeLogBlockAll = 0
+	for i, condition := range conditions {
+		if condition.Nm == "NullStep" {
+			allDone = true
+			break
+		}
+		ss.ActivateCondition(i, condition)
+		ss.ExecuteBlocks(true)
+		if allDone || ss.Stopped() {
+			break
+		}
+		if ss.ViewOn && ss.TrainUpdt >= leabra.Run {
+			ss.UpdateView()
+		}
+		ss.Stepper.StepPoint(int(Condition))
+	}
+	ss.Stepper.Stop()
+	ss.IsRunning = false
+	return allDone
+}
+
+// ActivateCondition makes the given condition, the i'th step of the current
+// run, the current one, and initializes it
+func (ss *Sim) ActivateCondition(i int, blockParams *data.ConditionParams) {
+	ev := &ss.Env
+	ev.CurConditionParams = blockParams
+	ss.ConditionParams = ev.CurConditionParams
+	ss.ConditionParamsNm = ss.ConditionParams.Nm
+	err := ss.InitCondition(i == 0)
+	if err != nil {
+		fmt.Println("ERROR: InitCondition failed in ActivateCondition")
+	}
+	ss.Render()
+}
+
+// end Run
+
+// Multiple trial types
+func (ss *Sim) ExecuteBlocks(seqRun bool) {
+	ev := &ss.Env
+	if !seqRun {
+		ev.CurConditionParams = ss.ConditionParams
+	}
+	nDone := 0
+	for i := 0; i < ev.CurConditionParams.NIters; i++ {
+		ev.RunOneTrialBlk(ss)
+		nDone++
+		if ev.Err != nil {
+			break
+		}
+	}
+	ev.ConditionCt.Incr()
+}
+
+// end MultiTrial
+
+// CheckStopCondition is called from within the Stepper.
+// Since CheckStopCondition is called with the Stepper's lock held,
+// it must not call any Stepper methods that set the lock. Rather, Stepper variables
+// should be set directly, if need be.
+func (ss *Sim) CheckStopCondition(_ int) bool {
+	ev := &ss.Env
+	ret := false
+	switch ss.StopStepCondition {
+	case SSNone:
+		return false
+	case SSTrialNameMatch:
+		ret = strings.Contains(ev.AlphaTrialName, ss.StopConditionTrialNameString)
+	case SSTrialNameNonmatch:
+		ret = !strings.Contains(ev.AlphaTrialName, ss.StopConditionTrialNameString)
+	default:
+		ret = false
+	}
+	return ret
+}
+
+// NotifyPause is called from within the Stepper, with the Stepper's lock held.
+// Stepper variables should be set directly, rather than calling Stepper methods,
+// which would try to take the lock and then deadlock.
+func (ss *Sim) NotifyPause() {
+	if int(ss.StepGrain) != ss.Stepper.StepGrain {
+		ss.Stepper.StepGrain = int(ss.StepGrain)
+	}
+	if ss.StepsToRun != ss.OrigSteps { // User has changed the step count while running
+		ss.Stepper.StepsPer = ss.StepsToRun
+		ss.OrigSteps = ss.StepsToRun
+	}
+	ss.IsRunning = false
+	ss.UpdateView()
+	ss.Render()
+}
+
+// end TrialGp and functions
+
+// Monitors //
+
+func IMax(x, y int) int {
+	if x > y {
+		return x
+	} else {
+		return y
+	}
+}
+
+func (ss *Sim) RunSeqTrialTypes(rs *data.RunParams) (map[string]string, int, error) {
+	steps := ss.GetRunConditions(rs)
+	ticksPerGroup := 0
+	var err error
+	types := map[string]string{}
+	fullStepMap := map[string]string{}
+	for _, step := range steps {
+		if step.Nm == "NullStep" {
+			break
+		}
+		tgt, ticks, err := ss.GetBlockTrialTypes(step)
+		ticksPerGroup = IMax(ticksPerGroup, ticks)
+		if err != nil {
+			return nil, 0, err
+		}
+		for long, short := range tgt {
+			types[long] = short
+		}
+	}
+	for long, short := range types {
+		for i := 0; i < ticksPerGroup; i++ {
+			is := strconv.Itoa(i)
+			fullStepMap[long+"_t"+is] = short + is
+		}
+	}
+	stepNames := sort.StringSlice{}
+	for val := range fullStepMap {
+		stepNames = append(stepNames, val)
+	}
+	sort.Sort(stepNames)
+	ss.GlobalTrialTypeSet = map[string]int{}
+	for i, name := range stepNames {
+		ss.GlobalTrialTypeSet[name] = i
+	}
+	return fullStepMap, ticksPerGroup, err
+}
+
+func (ss *Sim) GetBlockTrialTypes(rp *data.ConditionParams) (map[string]string, int, error) {
+	var err error
+	ticks := 0
+	cases := map[string]string{}
+	ep, found := ss.MasterTrialBlockParams[rp.TrialBlkNm]
+	valMap := map[pvlv.Valence]string{pvlv.POS: "+", pvlv.NEG: "-"}
+	if !found {
+		err := errors.New(fmt.Sprintf("TrialBlockParams %s was not found",
+			rp.TrialBlkNm))
+		return nil, 0, err
+	}
+	for _, tg := range ep {
+		tSuffix := ""
+		oSuffix := "_omit"
+		val := tg.ValenceContext
+		if strings.Contains(tg.TrialBlkName, "_test") {
+			tSuffix = "_test"
+		}
+		parts := strings.Split(tg.TrialBlkName, "_")
+		if parts[1] == "NR" {
+			oSuffix = ""
+		}
+		longNm := fmt.Sprintf("%s_%s", tg.TrialBlkName, val)
+		shortNm := tg.CS + valMap[val]
+		if strings.Contains(longNm, "_test") {
+			parts := strings.Split(longNm, "_")
+			longNm = ""
+			for i, part := range parts {
+				isTest := part == "test"
+				if !isTest && i != 0 {
+					longNm += "_"
+				}
+				if !isTest {
+					longNm += part
+				}
+			}
+		}
+		switch tg.USProb {
+		case 1:
+			cases[longNm+tSuffix] = shortNm + "*"
+		case 0:
+			cases[longNm+oSuffix+tSuffix] = shortNm + "~"
+		default:
+			cases[longNm+oSuffix+tSuffix] = shortNm + "~"
+			cases[longNm+tSuffix] = shortNm + "*"
+		}
+		ticks = IMax(ticks, tg.AlphTicksPerTrialGp)
+	}
+	return cases, ticks, err
+}
+
+func (ss *Sim) SetTrialTypeDataXLabels() (nRows int) {
+	tgNmMap := map[string]string{}
+	var ticksPerGroup int
+
+	if ss.TrialTypeDataPerBlock {
+		types := map[string]string{}
+		types, ticksPerGroup, _ = ss.GetBlockTrialTypes(ss.ConditionParams)
+		for long, short := range types {
+			for i := 0; i < ticksPerGroup; i++ {
+				is := strconv.Itoa(i)
+				tgNmMap[long+"_t"+is] = short + is
+			}
+		}
+	} else {
+		tgNmMap, _, _ = ss.RunSeqTrialTypes(ss.RunParams)
+	}
+	names := sort.StringSlice{}
+	for val := range tgNmMap {
+		names = append(names, val)
+	}
+	nRows = len(names)
+	sort.Sort(names)
+	dt := ss.TrialTypeData
+	ss.TrialTypeSet = map[string]int{}
+	for i, name := range names {
+		ss.TrialTypeSet[name] = i
+		dt.SetCellString("TrialType", i, name)
+	}
+	dt.UpdateColNameMap()
+	dt.SetNumRows(nRows)
+	if ss.View != nil {
+		ss.View.ResetPlot(dt)
+	}
+	return nRows
+}
+
+func (ss *Sim) LogTrialTypeData() {
+	ev := &ss.Env
+	dt := ss.TrialTypeData
+	efdt := ss.TrialTypeBlockFirstLog
+	row, _ := ss.TrialTypeSet[ev.AlphaTrialName]
+	dt.SetCellString("TrialType", row, ev.AlphaTrialName)
+	for _, colNm := range dt.ColNames {
+		if colNm != "TrialType" && colNm != "GlobalTrialBlock" {
+			parts := strings.Split(colNm, "_")
+			lnm := parts[0]
+			if parts[1] != "act" {
+				// ??
+			}
+			tsr := ss.ValsTsr(lnm)
+			ly := ss.Net.LayerByName(lnm).(leabra.LeabraLayer).AsLeabra()
+			err := ly.UnitValsTensor(tsr, "Act") // get minus phase act
+			if err == nil {
+				dt.SetCellTensor(colNm, row, tsr)
+			} else {
+				fmt.Println(err)
+			}
+			if !ss.TrialTypeBlockFirstLogged[ev.AlphaTrialName] {
+				ss.TrialTypeBlockFirstLogged[ev.AlphaTrialName] = true
+				vtaCol := ss.GlobalTrialTypeSet[ev.AlphaTrialName]
+				efRow := ss.TimeLogBlockAll
+				val := float64(tsr.Values[0])
+				if efdt.Rows <= efRow {
+					efdt.SetNumRows(efRow + 1)
+					if efRow > 0 { // initialize from previous block to avoid weird-looking artifacts
+						efdt.SetCellTensor(colNm, efRow, efdt.CellTensor(colNm, efRow-1))
+					}
+				}
+				efdt.SetCellFloat("GlobalTrialBlock", efRow, float64(efRow))
+				efdt.SetCellTensorFloat1D(colNm, efRow, vtaCol, val)
+			}
+		}
+	}
+	ss.UpdatePlot(dt)
+}
+
+func GetLeabraMonitorVal(ly *leabra.Layer, data []string) float64 {
+	var val float32
+	var err error
+	var varIdx int
+	valType := data[0]
+	varIdx, err = pvlv.NeuronVarIdxByName(valType)
+	if err != nil {
+		varIdx, err = leabra.NeuronVarIdxByName(valType)
+		if err != nil {
+			fmt.Printf("index lookup failed for %v_%v_%v_%v: \n", ly.Name(), data[1], valType, err)
+		}
+	}
+	unitIdx, err := strconv.Atoi(data[1])
+	if err != nil {
+		fmt.Printf("string to int conversion failed for %v_%v_%v%v: \n", ly.Name(), data[1], valType, err)
+	}
+	val = ly.UnitVal1D(varIdx, unitIdx)
+	return float64(val)
+}
+
+func (ss *Sim) ClearCycleData() {
+	for i := 0; i < ss.CycleOutputData.Rows; i++ {
+		for _, colName := range ss.CycleOutputData.ColNames {
+			ss.CycleOutputData.SetCellFloat(colName, i, 0)
+		}
+	}
+}
+
+func (ss *Sim) LogCycleData() {
+	ev := &ss.Env
+	var val float64
+	dt := ss.CycleOutputData
+	row := ev.GlobalStep
+	alphaStep := ss.Time.Cycle + ev.AlphaCycle.Cur*100
+	for _, colNm := range dt.ColNames {
+		if colNm == "GlobalStep" {
+			dt.SetCellFloat("GlobalStep", row, float64(ev.GlobalStep))
+		} else if colNm == "Cycle" {
+			dt.SetCellFloat(colNm, row, float64(alphaStep))
+		} else {
+			monData := ss.CycleOutputMetadata[colNm]
+			parts := strings.Split(colNm, "_")
+			lnm := parts[0]
+			ly := ss.Net.LayerByName(lnm)
+			switch ly.(type) {
+			case *leabra.Layer:
+				val = GetLeabraMonitorVal(ly.(*leabra.Layer), monData)
+			default:
+				val = ly.(MonitorVal).GetMonitorVal(monData)
+			}
+			dt.SetCellFloat(colNm, row, val)
+		}
+	}
+	if ss.CycleLogUpdt == leabra.Quarter || row%25 == 0 {
+		ss.UpdatePlot(dt)
+	}
+}
+
+// end TrialAnalysis functions
+
+func (ss *Sim) BlockMonitor() {
+	ss.LogTrnBlk()
+	ss.TimeLogBlock += 1
+	ss.TimeLogBlockAll += 1
+}
+
+// GetTrialBlockParams looks up a TrialBlockRecs by name. The second return value is true if found.
+func (ss *Sim) GetTrialBlockParams(nm string) (*data.TrialBlockRecs, bool) {
+	blk, ok := ss.MasterTrialBlockParams[nm]
+	groups := data.TrialBlock(blk)
+	ret := data.NewTrialBlockRecs(&groups)
+	return ret, ok
+}
+
+// GetBlockTrial returns the nth TrialBlockParams record in the currently set TrialBlockParams in the environment.
+func (ev *PVLVEnv) GetBlockTrial(n int) *data.TrialBlockParams {
+	ret := ev.TrialBlockParams.Records.Get(n).(*data.TrialBlockParams)
+	return ret
+}
+
+// GetConditionParams returns a pointer to a ConditionParams, and indicates an error if not found.
+func (ss *Sim) GetConditionParams(nm string) (*data.ConditionParams, bool) {
+	ret, found := ss.MasterConditionParams[nm]
+	return &ret, found
+}
+
+// GetRunParams returns a pointer to a RunParams, and indicates an error if not found.
+func (ss *Sim) GetRunParams(nm string) (*data.RunParams, bool) {
+	ret, found := ss.MasterRunParams[nm]
+	return &ret, found
+}
+
+// OpenExpt loads the master lists of RunParams, ConditionParams and TrialBlocks,
+// and the input Vocab, from the ExptFile (data.ExptFile by default), validating
+// all of the names referred to by the RunParams, all the way down to the TrialBlock level.
+func (ss *Sim) OpenExpt() error {
+	if ss.ExptFile == "" {
+		ss.ExptFile = data.ExptFile
+	}
+	ex := &pvlv.Experiment{}
+	err := ex.Open(ss.ExptFile)
+	if err != nil {
+		return fmt.Errorf("error loading experiment from %s: %v", ss.ExptFile, err)
+	}
+	ss.MasterRunParams = ex.Runs
+	ss.MasterConditionParams = ex.Conditions
+	ss.MasterTrialBlockParams = ex.TrialBlocks
+	ss.Vocab = ex.ExptVocab()
+	return nil
+}
@@ -2,6 +2,6 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-package main
+package pvlvsim
 
 //go:generate stringer -linecomment -output=strings.go -type=StepGrain,StopStepCond,ContextModel
@@ -1,6 +1,6 @@
 // Code generated by "stringer -linecomment -output=strings.go -type=StepGrain,StopStepCond,ContextModel"; DO NOT EDIT.
 
-package main
+package pvlvsim
 
 import "strconv"
 
@@ -21,7 +21,7 @@
 
 # labra25ra runs a simple random-associator 5x5 = 25 four-layer leabra network
 
-from leabra import go, leabra, emer, relpos, eplot, env, agg, patgen, prjn, etable, efile, split, etensor, params, netview, rand, erand, gi, giv, pygiv, pyparams, mat32
+from leabra import go, leabra, emer, relpos, eplot, env, agg, patgen, prjn, etable, efile, split, etensor, params, netview, rand, erand, gi, giv, pygiv, pyparams, mat32, netbuild
 
 import importlib as il  #il.reload(ra25) -- doesn't seem to work for reasons unknown
 import io, sys, getopt
@@ -330,10 +330,11 @@ class Sim(pygiv.ClassViewObj):
 
     def ConfigNet(ss, net):
         net.InitName(net, "RA25")
-        inp = net.AddLayer2D("Input", 5, 5, emer.Input)
-        hid1 = net.AddLayer2D("Hidden1", 7, 7, emer.Hidden)
-        hid2 = net.AddLayer4D("Hidden2", 2, 4, 3, 2, emer.Hidden)
-        out = net.AddLayer2D("Output", 5, 5, emer.Target)
+        nb = netbuild.NewNetBuilder(net)
+        inp = nb.AddLayer2D("Input", 5, 5, emer.Input)
+        hid1 = nb.AddLayer2D("Hidden1", 7, 7, emer.Hidden)
+        hid2 = nb.AddLayer4D("Hidden2", 2, 4, 3, 2, emer.Hidden)
+        out = nb.AddLayer2D("Output", 5, 5, emer.Target)
 
         # use this to position layers relative to each other
         # default is Above, YAlign = Front, XAlign = Center
@@ -343,9 +344,9 @@ class Sim(pygiv.ClassViewObj):
         # NewFull returns a new prjn.Full connectivity pattern
         full = prjn.NewFull()
 
-        net.ConnectLayers(inp, hid1, full, emer.Forward)
-        net.BidirConnectLayersPy(hid1, hid2, full)
-        net.BidirConnectLayersPy(hid2, out, full)
+        nb.ConnectLayers(inp, hid1, full, emer.Forward)
+        nb.BidirConnectLayers(hid1, hid2, full)
+        nb.BidirConnectLayers(hid2, out, full)
 
         # note: can set these to do parallel threaded computation across multiple cpus
         # not worth it for this small of a model, but definitely helps for larger ones
@@ -16,7 +16,7 @@
 # reinforcement of correct behavior, and learned reinforcement of useful
 # working memory representations.
 
-from leabra import go, leabra, emer, relpos, eplot, env, agg, patgen, prjn, etable, efile, split, etensor, params, netview, rand, erand, gi, giv, pygiv, pyparams, mat32, metric, simat, pca, clust, pbwm, rl
+from leabra import go, leabra, emer, relpos, eplot, env, agg, patgen, prjn, etable, efile, split, etensor, params, netview, rand, erand, gi, giv, pygiv, pyparams, mat32, metric, simat, pca, clust, pbwm, rl, netbuild
 
 import importlib as il  #il.reload(ra25) -- doesn't seem to work for reasons unknown
 import io, sys, getopt
@@ -326,17 +326,18 @@ class Sim(pygiv.ClassViewObj):
 
     def ConfigNet(ss, net):
         net.InitName(net, "SIR")
-        rc = rl.AddRWLayersPy(net.AsLeabra(), "", relpos.Behind, 2)
+        nb = netbuild.NewNetBuilder(net)
+        rc = nb.RL.AddRWLayers("", relpos.Behind, 2)
         rew = rc[0]
         rp = rc[1]
         da = rc[2]
         snc = rl.RWDaLayer(da)
         snc.SetName("SNc")
 
-        inp = net.AddLayer2D("Input", 1, 7, emer.Input)
-        ctrl = net.AddLayer2D("CtrlInput", 1, 3, emer.Input)
-        out = net.AddLayer2D("Output", 1, 4, emer.Target)
-        hid = net.AddLayer2D("Hidden", 7, 7, emer.Hidden)
+        inp = nb.AddLayer2D("Input", 1, 7, emer.Input)
+        ctrl = nb.AddLayer2D("CtrlInput", 1, 3, emer.Input)
+        out = nb.AddLayer2D("Output", 1, 4, emer.Target)
+        hid = nb.AddLayer2D("Hidden", 7, 7, emer.Hidden)
         inp.SetRelPos(relpos.Rel(Rel= relpos.Above, Other= rew.Name(), YAlign= relpos.Front, XAlign= relpos.Left))
         out.SetRelPos(relpos.Rel(Rel= relpos.RightOf, Other= "Input", YAlign= relpos.Front, Space= 1))
         ctrl.SetRelPos(relpos.Rel(Rel= relpos.Behind, Other= "Input", XAlign= relpos.Left, Space= 2))
@@ -344,7 +345,7 @@ class Sim(pygiv.ClassViewObj):
 
         # args: nY, nMaint, nOut, nNeurBgY, nNeurBgX, nNeurPfcY, nNeurPfcX
         # returns: mtxGo, mtxNoGo, gpe, gpi, cini, pfcMnt, pfcMntD, pfcOut, pfcOutD = 
-        nl = pbwm.AddPBWMPy(net.AsLeabra(), "", 2, 2, 2, 1, 3, 1, 7)
+        nl = nb.PBWM.AddPBWM("", 2, 2, 2, 1, 3, 1, 7)
         mtxGo = nl[0]
         mtxNoGo = nl[1]
         cin = pbwm.CINLayer(nl[4])
@@ -361,23 +362,23 @@ class Sim(pygiv.ClassViewObj):
         fmin.Scale.Set(1, 1)
         fmin.Wrap = True
 
-        net.ConnectLayersPrjn(inp, rp, full, emer.Forward, rl.RWPrjn())
-        # net.ConnectLayersPrjn(pfcMntD, rp, full, emer.Forward, &rl.RWPrjn{})
+        nb.ConnectLayersPrjn(inp, rp, full, emer.Forward, rl.RWPrjn())
+        # nb.ConnectLayersPrjn(pfcMntD, rp, full, emer.Forward, &rl.RWPrjn{})
 
-        pj = net.ConnectLayersPrjn(ctrl, mtxGo, fmin, emer.Forward, pbwm.MatrixTracePrjn())
+        pj = nb.ConnectLayersPrjn(ctrl, mtxGo, fmin, emer.Forward, pbwm.MatrixTracePrjn())
         pj.SetClass("MatrixPrjn")
-        pj = net.ConnectLayersPrjn(ctrl, mtxNoGo, fmin, emer.Forward, pbwm.MatrixTracePrjn())
+        pj = nb.ConnectLayersPrjn(ctrl, mtxNoGo, fmin, emer.Forward, pbwm.MatrixTracePrjn())
         pj.SetClass("MatrixPrjn")
-        pj = net.ConnectLayers(inp, pfcMnt, fmin, emer.Forward)
+        pj = nb.ConnectLayers(inp, pfcMnt, fmin, emer.Forward)
         pj.SetClass("PFCFixed")
 
-        net.ConnectLayers(inp, hid, full, emer.Forward)
-        net.BidirConnectLayersPy(hid, out, full)
-        pj = net.ConnectLayers(pfcOutD, hid, full, emer.Forward)
+        nb.ConnectLayers(inp, hid, full, emer.Forward)
+        nb.BidirConnectLayers(hid, out, full)
+        pj = nb.ConnectLayers(pfcOutD, hid, full, emer.Forward)
         pj.SetClass("FmPFCOutD")
-        pj = net.ConnectLayers(pfcOutD, out, full, emer.Forward)
+        pj = nb.ConnectLayers(pfcOutD, out, full, emer.Forward)
         pj.SetClass("FmPFCOutD")
-        net.ConnectLayers(inp, out, full, emer.Forward)
+        nb.ConnectLayers(inp, out, full, emer.Forward)
 
         snc.SendDA.AddAllBut(net, go.nil) # send dopamine to all layers..
 
@@ -0,0 +1,152 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hip
+
+import (
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/chewxy/math32"
+)
+
+// CA3ErrParams are parameters for error-driven learning in CA3 projections,
+// where the plus phase is the CA3 state driven by strong DG -> CA3 mossy
+// inputs, and the minus phase is the state prior to DG input, driven only by
+// ECin -> CA3 and CA3 recurrents, per https://arxiv.org/abs/1909.10340.
+// The ThetaPhase schedule turns DG -> CA3 off in Q1, so ActQ1 is the minus phase.
+type CA3ErrParams struct {
+	On     bool    `desc:"if true, use the DG-driven plus phase and pre-DG minus phase for the error-driven term -- otherwise this is the same as CHLPrjn"`
+	Mix    float32 `def:"1" min:"0" max:"1" viewif:"On" desc:"proportion of the error-driven term from the DG-driven plus phase, with the remainder from the standard CHL ActP vs. ActM (or ActQ1) error"`
+	MinusQ int     `def:"0" min:"0" max:"3" viewif:"On" desc:"quarter (0-3) whose final activation is the minus phase, prior to DG input -- 0 = ActQ1 in the standard ThetaPhase schedule"`
+	PlusQ  int     `def:"3" min:"0" max:"3" viewif:"On" desc:"quarter (0-3) whose final activation is the DG-driven plus phase -- 3 = ActP, after DG input from Q2 onward"`
+}
+
+func (ce *CA3ErrParams) Defaults() {
+	ce.On = true
+	ce.Mix = 1
+	ce.MinusQ = 0
+	ce.PlusQ = 3
+}
+
+// QtrAct returns the activation of the neuron at the end of given quarter (0-3)
+func QtrAct(nrn *leabra.Neuron, qtr int) float32 {
+	switch qtr {
+	case 0:
+		return nrn.ActQ1
+	case 1:
+		return nrn.ActQ2
+	case 2:
+		return nrn.ActM
+	}
+	return nrn.ActP
+}
+
+// MinusAct returns the minus phase activation, prior to DG input
+func (ce *CA3ErrParams) MinusAct(nrn *leabra.Neuron) float32 {
+	return QtrAct(nrn, ce.MinusQ)
+}
+
+// PlusAct returns the DG-driven plus phase activation
+func (ce *CA3ErrParams) PlusAct(nrn *leabra.Neuron) float32 {
+	return QtrAct(nrn, ce.PlusQ)
+}
+
+// ErrDWt blends the DG-driven error term with the standard CHL error term
+func (ce *CA3ErrParams) ErrDWt(dgErr, chlErr float32) float32 {
+	return ce.Mix*dgErr + (1-ce.Mix)*chlErr
+}
+
+////////////////////////////////////////////////////////////////////
+//  CA3Prjn
+
+// hip.CA3Prjn is for ECin -> CA3 and CA3 -> CA3 recurrent projections,
+// performing error-driven learning based on DG -> CA3 input serving as the
+// plus phase (CA3Err params), blended with the CHL hebbian term according
+// to CHL.Hebb.  If CA3Err is off, it is the same as CHLPrjn.
+type CA3Prjn struct {
+	CHLPrjn              // access as .CHLPrjn
+	CA3Err  CA3ErrParams `view:"inline" desc:"parameters for error-driven learning from the DG-driven plus phase -- requires CHL.On"`
+}
+
+func (pj *CA3Prjn) Defaults() {
+	pj.CHLPrjn.Defaults()
+	pj.CA3Err.Defaults()
+}
+
+//////////////////////////////////////////////////////////////////////////////////////
+//  Learn methods
+
+// DWt computes the weight change (learning) -- on sending projections
+// DG error-driven version used if CA3Err and CHL are On
+func (pj *CA3Prjn) DWt() {
+	if !pj.Learn.Learn {
+		return
+	}
+	if pj.CHL.On && pj.CA3Err.On {
+		pj.DWtCA3()
+	} else {
+		pj.CHLPrjn.DWt()
+	}
+}
+
+// DWtCA3 computes the weight change (learning) for CHL with the DG-driven error term
+func (pj *CA3Prjn) DWtCA3() {
+	slay := pj.Send.(leabra.LeabraLayer).AsLeabra()
+	rlay := pj.Recv.(leabra.LeabraLayer).AsLeabra()
+	if slay.Pools[0].ActP.Avg < pj.CHL.SAvgThr { // inactive, no learn
+		return
+	}
+	for si := range slay.Neurons {
+		sn := &slay.Neurons[si]
+		nc := int(pj.SConN[si])
+		st := int(pj.SConIdxSt[si])
+		syns := pj.Syns[st : st+nc]
+		scons := pj.SConIdx[st : st+nc]
+		snActM := pj.CHL.MinusAct(sn.ActM, sn.ActQ1)
+		snPlus := pj.CA3Err.PlusAct(sn)
+		snMinus := pj.CA3Err.MinusAct(sn)
+
+		savgCor := pj.SAvgCor(slay)
+
+		for ci := range syns {
+			sy := &syns[ci]
+			ri := scons[ci]
+			rn := &rlay.Neurons[ri]
+			rnActM := pj.CHL.MinusAct(rn.ActM, rn.ActQ1)
+
+			hebb := pj.CHL.HebbDWt(sn.ActP, rn.ActP, savgCor, sy.LWt)
+			dgErr := pj.CHL.ErrDWt(snPlus, snMinus, pj.CA3Err.PlusAct(rn), pj.CA3Err.MinusAct(rn), sy.LWt)
+			err := dgErr
+			if pj.CA3Err.Mix < 1 {
+				chlErr := pj.CHL.ErrDWt(sn.ActP, snActM, rn.ActP, rnActM, sy.LWt)
+				err = pj.CA3Err.ErrDWt(dgErr, chlErr)
+			}
+
+			dwt := pj.CHL.DWt(hebb, err)
+			norm := float32(1)
+			if pj.Learn.Norm.On {
+				norm = pj.Learn.Norm.NormFmAbsDWt(&sy.Norm, math32.Abs(dwt))
+			}
+			if pj.Learn.Momentum.On {
+				dwt = norm * pj.Learn.Momentum.MomentFmDWt(&sy.Moment, dwt)
+			} else {
+				dwt *= norm
+			}
+			sy.DWt += pj.Learn.Lrate * dwt
+		}
+		// aggregate max DWtNorm over sending synapses
+		if pj.Learn.Norm.On {
+			maxNorm := float32(0)
+			for ci := range syns {
+				sy := &syns[ci]
+				if sy.Norm > maxNorm {
+					maxNorm = sy.Norm
+				}
+			}
+			for ci := range syns {
+				sy := &syns[ci]
+				sy.Norm = maxNorm
+			}
+		}
+	}
+}
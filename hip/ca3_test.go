@@ -0,0 +1,74 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hip
+
+import (
+	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/chewxy/math32"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/params"
+	"github.com/emer/emergent/prjn"
+)
+
+var CA3ParamSheet = params.Sheet{
+	{Sel: "Prjn", Desc: "pure error-driven, no extras",
+		Params: params.Params{
+			"Prjn.CHL.Hebb":          "0",
+			"Prjn.CA3Err.Mix":        "1",
+			"Prjn.Learn.Lrate":       "0.5",
+			"Prjn.Learn.WtBal.On":    "false",
+			"Prjn.Learn.Norm.On":     "false",
+			"Prjn.Learn.Momentum.On": "false",
+			"Prjn.WtInit.Mean":       "0.5",
+			"Prjn.WtInit.Var":        "0",
+		}},
+}
+
+func TestCA3Prjn(t *testing.T) {
+	net := &leabra.Network{}
+	net.InitName(net, "CA3Net")
+	ecin := net.AddLayer2D("ECin", 1, 1, emer.Input)
+	ca3 := net.AddLayer2D("CA3", 1, 1, emer.Hidden)
+	pj := net.ConnectLayersPrjn(ecin, ca3, prjn.NewFull(), emer.Forward, &CA3Prjn{}).(*CA3Prjn)
+	net.Defaults()
+	net.ApplyParams(&CA3ParamSheet, false)
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	if pj.CHL.Hebb != 0 || pj.CA3Err.Mix != 1 || pj.Learn.Lrate != 0.5 {
+		t.Fatalf("params not applied: Hebb: %g Mix: %g Lrate: %g", pj.CHL.Hebb, pj.CA3Err.Mix, pj.Learn.Lrate)
+	}
+
+	sly := ecin.(leabra.LeabraLayer).AsLeabra()
+	rly := ca3.(leabra.LeabraLayer).AsLeabra()
+	sly.Pools[0].ActP.Avg = 1
+	sn := &sly.Neurons[0]
+	rn := &rly.Neurons[0]
+	sn.ActQ1, sn.ActM, sn.ActP = 1, 1, 1
+	rn.ActQ1, rn.ActM, rn.ActP = 0.2, 0.8, 0.8 // DG drives CA3 on after Q1
+
+	sy := &pj.Syns[0]
+	dwtFor := func(on bool, mix float32) float32 {
+		pj.CA3Err.On = on
+		pj.CA3Err.Mix = mix
+		sy.DWt = 0
+		pj.DWt()
+		return sy.DWt
+	}
+	dgErr := float32(0.8-0.2) * (1 - sy.LWt) // ActP - ActQ1, soft bounded
+	if dwt := dwtFor(true, 1); math32.Abs(dwt-0.5*dgErr) > 1.0e-6 {
+		t.Errorf("DG error DWt: %g != %g", dwt, 0.5*dgErr)
+	}
+	// standard CHL uses ActP - ActM, which has no error here
+	if dwt := dwtFor(false, 1); dwt != 0 {
+		t.Errorf("CHL DWt: %g != 0", dwt)
+	}
+	if dwt := dwtFor(true, 0.5); math32.Abs(dwt-0.25*dgErr) > 1.0e-6 {
+		t.Errorf("mixed DWt: %g != %g", dwt, 0.25*dgErr)
+	}
+}
@@ -36,6 +36,14 @@ ActP = plus (serves as plus phase for both auto and recall)
 learning just happens at end of trial as usual, but encoder projections use
 the ActQ1, ActM, ActP variables to learn on the right signals
 
+hip.Network applies these projection strengths for each quarter using its
+ThetaPhase schedule, which defaults to the above, and can be modified to
+explore other schedules.
+
+RecallStats computes the ECout recall stats for a trial, and MemTest runs
+recall, pattern separation, pattern completion and AB-AC interference tests
+on a trained network, returning etables.
+
 todo: implement a two-trial version of the code to produce a true theta rhythm
 integrating over two adjacent alpha trials..
 
@@ -0,0 +1,361 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hip
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/etable/etable"
+	"github.com/emer/etable/etensor"
+	"github.com/emer/etable/metric"
+)
+
+// MemTest runs standard episodic memory tests on a (typically trained or
+// saved) hippocampal network, returning the results as etables.
+// Items are presented as in testing trials of the standard hip sims:
+// the pattern table Input column is applied to the input layer (driving
+// ECin), and the ECout column is the full target pattern, compared with
+// the ECout recall in the minus phase without clamping, using the Test
+// theta phase schedule.  Pattern tables must have Name, Input and ECout
+// columns, as used by the standard AB, AC and Lure tables.
+// No learning takes place, but the network activation state is changed.
+type MemTest struct {
+	InLay   string            `desc:"name of the input layer, where the Input pattern column is applied"`
+	ECin    string            `desc:"name of the ECin layer, whose activation determines which ECout units require pattern completion"`
+	ECout   string            `desc:"name of the ECout layer, where the ECout pattern column is the target"`
+	MemThr  float64           `def:"0.34" desc:"threshold on TrgOnWasOff and TrgOffWasOn for counting an item as remembered"`
+	Lays    []string          `desc:"names of layers whose minus phase activation overlap is computed for pattern separation"`
+	Metric  metric.StdMetrics `desc:"metric for computing the overlap between patterns in Separation -- typically Cosine"`
+	RndSeed int64             `desc:"random seed for selecting the cue units removed in Completion -- the same seed gives the same cues"`
+
+	Net   *Network    `view:"-" desc:"network being tested"`
+	Time  leabra.Time `view:"-" desc:"time for running the test trials"`
+	Stats RecallStats `view:"-" desc:"recall stats for the last item tested"`
+	inly  *leabra.Layer
+	ecin  *leabra.Layer
+	ecout *leabra.Layer
+}
+
+func (mt *MemTest) Defaults() {
+	mt.InLay = "Input"
+	mt.ECin = "ECin"
+	mt.ECout = "ECout"
+	mt.MemThr = 0.34
+	mt.Lays = []string{"ECin", "DG", "CA3", "CA1"}
+	mt.Metric = metric.Cosine
+	mt.RndSeed = 1
+}
+
+// Init configures the MemTest to test given network.
+// Calls Defaults if ECout has not been set.
+func (mt *MemTest) Init(net *Network) error {
+	if mt.ECout == "" {
+		mt.Defaults()
+	}
+	mt.Net = net
+	mt.Time.Defaults()
+	var err error
+	if mt.inly, err = mt.layer(mt.InLay); err != nil {
+		return err
+	}
+	if mt.ecin, err = mt.layer(mt.ECin); err != nil {
+		return err
+	}
+	if mt.ecout, err = mt.layer(mt.ECout); err != nil {
+		return err
+	}
+	for _, lnm := range mt.Lays {
+		if _, err = mt.layer(lnm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (mt *MemTest) layer(lnm string) (*leabra.Layer, error) {
+	ly, err := mt.Net.LayerByNameTry(lnm)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return ly.(leabra.LeabraLayer).AsLeabra(), nil
+}
+
+// checkPats checks that Init has been called and the pattern table has
+// the standard columns
+func (mt *MemTest) checkPats(pats *etable.Table) error {
+	if mt.ecout == nil {
+		err := fmt.Errorf("hip.MemTest: Init must be called before testing")
+		log.Println(err)
+		return err
+	}
+	for _, cnm := range []string{"Name", "Input", "ECout"} {
+		if _, err := pats.ColByNameTry(cnm); err != nil {
+			err = fmt.Errorf("hip.MemTest: pattern table %v: %v", pats.MetaData["name"], err)
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// TestItem runs one testing alpha cycle with given input and ECout target
+// patterns, returning the recall stats, which are also left in Stats.
+// The ECout layer type and the Theta.Testing flag are restored afterward.
+func (mt *MemTest) TestItem(input, targ etensor.Tensor) RecallStats {
+	net := mt.Net
+	ectyp := mt.ecout.Type()
+	testing := net.Theta.Testing
+	mt.ecout.SetType(emer.Compare) // don't clamp
+	mt.ecout.UpdateExtFlags()
+	net.Theta.Testing = true
+
+	net.InitExt()
+	mt.inly.ApplyExt(input)
+	mt.ecout.ApplyExt(targ)
+	net.AlphaCycInit()
+	mt.Time.AlphaCycStart()
+	for qtr := 0; qtr < 4; qtr++ {
+		for cyc := 0; cyc < mt.Time.CycPerQtr; cyc++ {
+			net.Cycle(&mt.Time)
+			mt.Time.CycleInc()
+		}
+		net.QuarterFinal(&mt.Time)
+		if qtr+1 == 3 {
+			mt.Stats.Compute(mt.ecin, mt.ecout, mt.MemThr, true) // must come after QuarterFinal
+		}
+		mt.Time.QuarterInc()
+	}
+
+	net.Theta.Testing = testing
+	mt.ecout.SetType(ectyp)
+	mt.ecout.UpdateExtFlags()
+	return mt.Stats
+}
+
+// Recall tests recall of each item in pats, returning a table with the
+// Name and RecallStats of each item.  For test tables with a partial Input
+// cue, TrgOnWasOffCmp and Mem measure the recall of the missing part.
+func (mt *MemTest) Recall(pats *etable.Table) (*etable.Table, error) {
+	if err := mt.checkPats(pats); err != nil {
+		return nil, err
+	}
+	dt := &etable.Table{}
+	dt.SetMetaData("name", "MemRecall")
+	dt.SetMetaData("desc", "episodic memory recall of each item")
+	sch := etable.Schema{
+		{"Name", etensor.STRING, nil, nil},
+	}
+	sch = append(sch, recallSchema("")...)
+	dt.SetFromSchema(sch, pats.Rows)
+	for row := 0; row < pats.Rows; row++ {
+		rs := mt.TestItem(pats.CellTensor("Input", row), pats.CellTensor("ECout", row))
+		dt.SetCellString("Name", row, pats.CellString("Name", row))
+		mt.setRecall(dt, "", row, &rs)
+	}
+	return dt, nil
+}
+
+// recallSchema returns the RecallStats columns, with given column name prefix
+func recallSchema(pfx string) etable.Schema {
+	return etable.Schema{
+		{pfx + "Mem", etensor.FLOAT64, nil, nil},
+		{pfx + "TrgOnWasOffAll", etensor.FLOAT64, nil, nil},
+		{pfx + "TrgOnWasOffCmp", etensor.FLOAT64, nil, nil},
+		{pfx + "TrgOffWasOn", etensor.FLOAT64, nil, nil},
+	}
+}
+
+// setRecall sets the RecallStats columns of given row, with given column name prefix
+func (mt *MemTest) setRecall(dt *etable.Table, pfx string, row int, rs *RecallStats) {
+	dt.SetCellFloat(pfx+"Mem", row, rs.Mem)
+	dt.SetCellFloat(pfx+"TrgOnWasOffAll", row, rs.TrgOnWasOffAll)
+	dt.SetCellFloat(pfx+"TrgOnWasOffCmp", row, rs.TrgOnWasOffCmp)
+	dt.SetCellFloat(pfx+"TrgOffWasOn", row, rs.TrgOffWasOn)
+}
+
+// Separation measures pattern separation, testing each item in pats and
+// returning a table with one row for each pair of items (A, B), with the
+// overlap of their Input patterns, and of the minus phase activations of
+// each of Lays, computed with Metric.  Pattern separation is indicated by
+// DG and CA3 overlap being lower than the Input (and ECin) overlap.
+// Typically run on the full training patterns.
+func (mt *MemTest) Separation(pats *etable.Table) (*etable.Table, error) {
+	if err := mt.checkPats(pats); err != nil {
+		return nil, err
+	}
+	mfun := metric.StdFunc32(mt.Metric)
+	if mfun == nil {
+		err := fmt.Errorf("hip.MemTest: Separation cannot use Metric: %v", mt.Metric)
+		log.Println(err)
+		return nil, err
+	}
+	nl := len(mt.Lays)
+	acts := make([][][]float32, pats.Rows) // item, layer, unit
+	ins := make([][]float32, pats.Rows)
+	for row := 0; row < pats.Rows; row++ {
+		input := pats.CellTensor("Input", row)
+		mt.TestItem(input, pats.CellTensor("ECout", row))
+		ins[row] = tensorFloats32(input)
+		acts[row] = make([][]float32, nl)
+		for li, lnm := range mt.Lays {
+			ly, _ := mt.layer(lnm)
+			ly.UnitVals(&acts[row][li], "ActM")
+		}
+	}
+
+	dt := &etable.Table{}
+	dt.SetMetaData("name", "MemSeparation")
+	dt.SetMetaData("desc", fmt.Sprintf("%v overlap of layer activations for each pair of items", mt.Metric))
+	sch := etable.Schema{
+		{"A", etensor.STRING, nil, nil},
+		{"B", etensor.STRING, nil, nil},
+		{"Input", etensor.FLOAT64, nil, nil},
+	}
+	for _, lnm := range mt.Lays {
+		sch = append(sch, etable.Column{lnm, etensor.FLOAT64, nil, nil})
+	}
+	dt.SetFromSchema(sch, pats.Rows*(pats.Rows-1)/2)
+	row := 0
+	for a := 0; a < pats.Rows; a++ {
+		for b := a + 1; b < pats.Rows; b++ {
+			dt.SetCellString("A", row, pats.CellString("Name", a))
+			dt.SetCellString("B", row, pats.CellString("Name", b))
+			dt.SetCellFloat("Input", row, float64(mfun(ins[a], ins[b])))
+			for li, lnm := range mt.Lays {
+				dt.SetCellFloat(lnm, row, float64(mfun(acts[a][li], acts[b][li])))
+			}
+			row++
+		}
+	}
+	return dt, nil
+}
+
+// Completion measures pattern completion from partial cues, testing each
+// item in pats with a cue made from its Input pattern by keeping only the
+// given proportion of its active units, selected at random using RndSeed.
+// Returns a table with one row for each item and cue proportion, where
+// TrgOnWasOffCmp and Mem measure the recall of the missing part of the
+// ECout target.  Typically run on the full training patterns.
+func (mt *MemTest) Completion(pats *etable.Table, cuePcts []float64) (*etable.Table, error) {
+	if err := mt.checkPats(pats); err != nil {
+		return nil, err
+	}
+	rnd := rand.New(rand.NewSource(mt.RndSeed))
+	dt := &etable.Table{}
+	dt.SetMetaData("name", "MemCompletion")
+	dt.SetMetaData("desc", "pattern completion from partial cues of each item")
+	sch := etable.Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"CuePct", etensor.FLOAT64, nil, nil},
+	}
+	sch = append(sch, recallSchema("")...)
+	dt.SetFromSchema(sch, pats.Rows*len(cuePcts))
+	row := 0
+	for pi := 0; pi < pats.Rows; pi++ {
+		input := pats.CellTensor("Input", pi)
+		var on []int
+		for i := 0; i < input.Len(); i++ {
+			if input.FloatVal1D(i) > 0.5 {
+				on = append(on, i)
+			}
+		}
+		for _, pct := range cuePcts {
+			cue := input.Clone()
+			rnd.Shuffle(len(on), func(i, j int) { on[i], on[j] = on[j], on[i] })
+			ndrop := len(on) - int(pct*float64(len(on))+0.5)
+			for _, i := range on[:ndrop] {
+				cue.SetFloat1D(i, 0)
+			}
+			rs := mt.TestItem(cue, pats.CellTensor("ECout", pi))
+			dt.SetCellString("Name", row, pats.CellString("Name", pi))
+			dt.SetCellFloat("CuePct", row, pct)
+			mt.setRecall(dt, "", row, &rs)
+			row++
+		}
+	}
+	return dt, nil
+}
+
+// Interference measures AB-AC interference, testing each item in the ab
+// and ac test tables, which must be aligned so that the same row has the
+// same A cue in both.  Returns a table with one row per item, with the
+// recall stats for each list, and the intrusion of the other list: the
+// proportion of the units only active in the other list's ECout target
+// that are active in the ECout recall.  Typically run after training on
+// AC, following AB, to measure how much AB has been forgotten.
+func (mt *MemTest) Interference(ab, ac *etable.Table) (*etable.Table, error) {
+	if err := mt.checkPats(ab); err != nil {
+		return nil, err
+	}
+	if err := mt.checkPats(ac); err != nil {
+		return nil, err
+	}
+	if ab.Rows != ac.Rows {
+		err := fmt.Errorf("hip.MemTest: Interference AB and AC tables must have the same number of rows: %d != %d", ab.Rows, ac.Rows)
+		log.Println(err)
+		return nil, err
+	}
+	dt := &etable.Table{}
+	dt.SetMetaData("name", "MemInterference")
+	dt.SetMetaData("desc", "AB-AC interference for each item")
+	sch := etable.Schema{
+		{"ABName", etensor.STRING, nil, nil},
+		{"ACName", etensor.STRING, nil, nil},
+	}
+	for _, pfx := range []string{"AB", "AC"} {
+		sch = append(sch, recallSchema(pfx)...)
+		sch = append(sch, etable.Column{pfx + "Intrusion", etensor.FLOAT64, nil, nil})
+	}
+	dt.SetFromSchema(sch, ab.Rows)
+	for row := 0; row < ab.Rows; row++ {
+		dt.SetCellString("ABName", row, ab.CellString("Name", row))
+		dt.SetCellString("ACName", row, ac.CellString("Name", row))
+		for li, pt := range []*etable.Table{ab, ac} {
+			pfx, ot := "AB", ac
+			if li == 1 {
+				pfx, ot = "AC", ab
+			}
+			targ := pt.CellTensor("ECout", row)
+			rs := mt.TestItem(pt.CellTensor("Input", row), targ)
+			mt.setRecall(dt, pfx, row, &rs)
+			dt.SetCellFloat(pfx+"Intrusion", row, mt.intrusion(targ, ot.CellTensor("ECout", row)))
+		}
+	}
+	return dt, nil
+}
+
+// intrusion returns the proportion of the units active in the other target
+// but not in targ, that are active in the ECout minus phase
+func (mt *MemTest) intrusion(targ, other etensor.Tensor) float64 {
+	n := 0
+	on := 0
+	for ni := range mt.ecout.Neurons {
+		if other.FloatVal1D(ni) < 0.5 || targ.FloatVal1D(ni) > 0.5 {
+			continue
+		}
+		n++
+		if mt.ecout.Neurons[ni].ActM > 0.5 {
+			on++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	return float64(on) / float64(n)
+}
+
+// tensorFloats32 returns the values of the tensor as float32
+func tensorFloats32(tsr etensor.Tensor) []float32 {
+	vals := make([]float32, tsr.Len())
+	for i := range vals {
+		vals[i] = float32(tsr.FloatVal1D(i))
+	}
+	return vals
+}
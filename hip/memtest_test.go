@@ -0,0 +1,140 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hip
+
+import (
+	"math"
+	"testing"
+
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+	"github.com/emer/etable/etable"
+	"github.com/emer/etable/etensor"
+)
+
+func newMemTestNet(t *testing.T) *Network {
+	net := &Network{}
+	net.InitName(net, "MemTestNet")
+	in := net.AddLayer2D("Input", 2, 4, emer.Input)
+	ecin := net.AddLayer2D("ECin", 2, 4, emer.Hidden)
+	ecout := net.AddLayer2D("ECout", 2, 4, emer.Target)
+	ca1 := net.AddLayer2D("CA1", 2, 4, emer.Hidden)
+	dg := net.AddLayer2D("DG", 4, 4, emer.Hidden)
+	ca3 := net.AddLayer2D("CA3", 3, 3, emer.Hidden)
+	onetoone := prjn.NewOneToOne()
+	full := prjn.NewFull()
+	net.ConnectLayers(in, ecin, onetoone, emer.Forward)
+	net.ConnectLayers(ecout, ecin, onetoone, emer.Back)
+	net.ConnectLayersPrjn(ecin, ca1, full, emer.Forward, &EcCa1Prjn{})
+	net.ConnectLayersPrjn(ca1, ecout, full, emer.Forward, &EcCa1Prjn{})
+	net.ConnectLayersPrjn(ecout, ca1, full, emer.Back, &EcCa1Prjn{})
+	net.ConnectLayersPrjn(ecin, dg, full, emer.Forward, &CHLPrjn{})
+	net.ConnectLayersPrjn(ecin, ca3, full, emer.Forward, &EcCa1Prjn{})
+	net.ConnectLayersPrjn(dg, ca3, full, emer.Forward, &CHLPrjn{})
+	net.ConnectLayersPrjn(ca3, ca1, full, emer.Forward, &CHLPrjn{})
+	net.Defaults()
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	return net
+}
+
+// memPats returns a pattern table with given names, Input and ECout patterns
+func memPats(names []string, ins, outs [][]float32) *etable.Table {
+	dt := &etable.Table{}
+	dt.SetMetaData("name", "MemPats")
+	dt.SetFromSchema(etable.Schema{
+		{"Name", etensor.STRING, nil, nil},
+		{"Input", etensor.FLOAT32, []int{2, 4}, nil},
+		{"ECout", etensor.FLOAT32, []int{2, 4}, nil},
+	}, len(names))
+	for row, nm := range names {
+		dt.SetCellString("Name", row, nm)
+		dt.SetCellTensor("Input", row, etensor.NewFloat32Shape(etensor.NewShape([]int{2, 4}, nil, nil), ins[row]))
+		dt.SetCellTensor("ECout", row, etensor.NewFloat32Shape(etensor.NewShape([]int{2, 4}, nil, nil), outs[row]))
+	}
+	return dt
+}
+
+func TestMemTest(t *testing.T) {
+	net := newMemTestNet(t)
+	ab := [][]float32{{1, 1, 0, 0, 1, 0, 1, 0}, {0, 0, 1, 1, 1, 0, 1, 0}}
+	ac := [][]float32{{1, 1, 0, 0, 0, 1, 0, 1}, {0, 0, 1, 1, 0, 1, 0, 1}}
+	abPats := memPats([]string{"a1_b1", "a2_b2"}, ab, ab)
+	acPats := memPats([]string{"a1_c1", "a2_c2"}, ac, ac)
+
+	mt := &MemTest{}
+	mt.Defaults()
+	mt.Lays = append(mt.Lays, "CA4")
+	if err := mt.Init(net); err == nil {
+		t.Errorf("Init did not fail on missing layer CA4")
+	}
+	mt.Defaults()
+	if err := mt.Init(net); err != nil {
+		t.Fatal(err)
+	}
+
+	rc, err := mt.Recall(abPats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rc.Rows != 2 || rc.CellString("Name", 1) != "a2_b2" {
+		t.Errorf("Recall: rows %d, names %v", rc.Rows, rc.ColByName("Name"))
+	}
+	if net.Theta.Testing || net.LayerByName("ECout").Type() != emer.Target {
+		t.Errorf("Recall did not restore Theta.Testing or ECout layer type")
+	}
+
+	sp, err := mt.Separation(abPats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sp.Rows != 1 {
+		t.Fatalf("Separation: rows %d != 1", sp.Rows)
+	}
+	if ov := sp.CellFloat("Input", 0); math.Abs(ov-0.5) > 1.0e-6 {
+		t.Errorf("Separation: Input overlap %g != 0.5", ov)
+	}
+	for _, lnm := range mt.Lays {
+		if ov := sp.CellFloat(lnm, 0); math.IsNaN(ov) || ov < 0 || ov > 1 {
+			t.Errorf("Separation: %s overlap %g out of range", lnm, ov)
+		}
+	}
+
+	cpcts := []float64{1, 0.5}
+	cp, err := mt.Completion(abPats, cpcts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cp2, _ := mt.Completion(abPats, cpcts)
+	if cp.Rows != 4 || cp.CellFloat("CuePct", 1) != 0.5 {
+		t.Errorf("Completion: rows %d, CuePct %v", cp.Rows, cp.ColByName("CuePct"))
+	}
+	for row := 0; row < cp.Rows; row++ {
+		if a, b := cp.CellFloat("TrgOnWasOffCmp", row), cp2.CellFloat("TrgOnWasOffCmp", row); a != b {
+			t.Errorf("Completion: row %d not reproducible with same RndSeed: %g != %g", row, a, b)
+		}
+	}
+
+	in, err := mt.Interference(abPats, acPats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if in.Rows != 2 || in.CellString("ACName", 0) != "a1_c1" {
+		t.Errorf("Interference: rows %d, names %v", in.Rows, in.ColByName("ACName"))
+	}
+	for _, cnm := range []string{"ABIntrusion", "ACIntrusion"} {
+		for row := 0; row < in.Rows; row++ {
+			if v := in.CellFloat(cnm, row); v < 0 || v > 1 {
+				t.Errorf("Interference: %s row %d: %g out of range", cnm, row, v)
+			}
+		}
+	}
+	acPats.SetNumRows(1)
+	if _, err := mt.Interference(abPats, acPats); err == nil {
+		t.Errorf("Interference did not fail on different number of rows")
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hip
+
+import (
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/goki/ki/kit"
+)
+
+// hip.Network runs the ThetaPhase schedule of projection scales
+// across the quarters of each alpha cycle
+type Network struct {
+	leabra.Network
+	Theta ThetaPhase `desc:"theta phase schedule of projection scale overrides for each quarter"`
+}
+
+var KiT_Network = kit.Types.AddType(&Network{}, NetworkProps)
+
+var NetworkProps = leabra.NetworkProps
+
+// Defaults sets all the default parameters for all layers and projections,
+// and the standard Ketz et al. (2013) theta phase schedule
+func (nt *Network) Defaults() {
+	nt.Network.Defaults()
+	nt.Theta.Defaults()
+}
+
+// AlphaCycInitImpl applies the first quarter theta phase scales
+// before the standard initialization, which computes the scaling factors
+func (nt *Network) AlphaCycInitImpl() {
+	nt.Theta.AlphaCycInit(&nt.Network)
+	nt.Network.AlphaCycInitImpl()
+}
+
+// QuarterFinalImpl applies the theta phase scales for the next quarter
+// after the standard quarter final updating
+func (nt *Network) QuarterFinalImpl(ltime *leabra.Time) {
+	nt.Network.QuarterFinalImpl(ltime)
+	nt.Theta.QuarterFinal(&nt.Network, ltime)
+}
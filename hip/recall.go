@@ -0,0 +1,70 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hip
+
+import (
+	"github.com/ccnlab/leabrax/leabra"
+)
+
+// RecallStats are episodic memory recall statistics, comparing the ECout
+// recall (minus phase, ActM) activation with the full target pattern (Targ),
+// with binary counts thresholded at .5.
+// Units that are on in the target but off in ECin (ActQ1) must be recalled
+// by pattern completion, and are counted separately as the Cmp units.
+type RecallStats struct {
+	Mem            float64 `desc:"1 if TrgOnWasOff and TrgOffWasOn are both below threshold, counting as a correctly recalled memory, 0 otherwise -- uses TrgOnWasOffCmp for testing, if there are units to complete"`
+	TrgOnWasOffAll float64 `desc:"proportion of all units on in the target that were off in ECout"`
+	TrgOnWasOffCmp float64 `desc:"proportion of units on in the target and missing in ECin, requiring completion, that were off in ECout"`
+	TrgOffWasOn    float64 `desc:"proportion of units off in the target that were on in ECout"`
+	CmpN           int     `desc:"number of units on in the target and missing in ECin, requiring completion"`
+}
+
+// Compute computes the stats from the ECout ActM and Targ, and the ECin ActQ1
+// values -- must be called after the QuarterFinal of the 3rd quarter, so that
+// Targ is the full target pattern as opposed to the plus-phase target clamped
+// from ECin.  Mem uses the given threshold, and TrgOnWasOffCmp if test is true,
+// otherwise TrgOnWasOffAll (for training, where nothing needs to be completed).
+func (rs *RecallStats) Compute(ecin, ecout *leabra.Layer, thr float64, test bool) {
+	*rs = RecallStats{}
+	trgOnN := 0
+	trgOffN := 0
+	for ni := range ecout.Neurons {
+		on := &ecout.Neurons[ni]
+		if on.Targ < 0.5 {
+			trgOffN++
+			if on.ActM > 0.5 {
+				rs.TrgOffWasOn += 1
+			}
+			continue
+		}
+		trgOnN++
+		cmp := ecin.Neurons[ni].ActQ1 < 0.5 // missing in ECin -- completion target
+		if cmp {
+			rs.CmpN++
+		}
+		if on.ActM < 0.5 {
+			rs.TrgOnWasOffAll += 1
+			if cmp {
+				rs.TrgOnWasOffCmp += 1
+			}
+		}
+	}
+	if trgOnN > 0 {
+		rs.TrgOnWasOffAll /= float64(trgOnN)
+	}
+	if trgOffN > 0 {
+		rs.TrgOffWasOn /= float64(trgOffN)
+	}
+	if rs.CmpN > 0 {
+		rs.TrgOnWasOffCmp /= float64(rs.CmpN)
+	}
+	trgOnWasOff := rs.TrgOnWasOffAll
+	if test && rs.CmpN > 0 {
+		trgOnWasOff = rs.TrgOnWasOffCmp
+	}
+	if trgOnWasOff < thr && rs.TrgOffWasOn < thr {
+		rs.Mem = 1
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hip
+
+import (
+	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/emergent/emer"
+)
+
+// setRecallVals sets the ECin ActQ1, ECout Targ and ECout ActM values
+func setRecallVals(ecin, ecout *leabra.Layer, inq1, targ, actm []float32) {
+	for ni := range ecout.Neurons {
+		ecin.Neurons[ni].ActQ1 = inq1[ni]
+		ecout.Neurons[ni].Targ = targ[ni]
+		ecout.Neurons[ni].ActM = actm[ni]
+	}
+}
+
+func TestRecallStats(t *testing.T) {
+	net := &leabra.Network{}
+	net.InitName(net, "RecallNet")
+	net.AddLayer2D("ECin", 1, 8, emer.Input)
+	net.AddLayer2D("ECout", 1, 8, emer.Target)
+	net.Defaults()
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	ecin := net.LayerByName("ECin").(leabra.LeabraLayer).AsLeabra()
+	ecout := net.LayerByName("ECout").(leabra.LeabraLayer).AsLeabra()
+	targ := []float32{1, 1, 1, 1, 0, 0, 0, 0}
+	cue := []float32{1, 1, 0, 0, 0, 0, 0, 0}
+
+	// completed one of the two missing units, and one unit wrongly on
+	setRecallVals(ecin, ecout, cue, targ, []float32{1, 1, 1, 0, 1, 0, 0, 0})
+	var rs RecallStats
+	rs.Compute(ecin, ecout, 0.34, true)
+	cor := RecallStats{Mem: 0, TrgOnWasOffAll: 0.25, TrgOnWasOffCmp: 0.5, TrgOffWasOn: 0.25, CmpN: 2}
+	if rs != cor {
+		t.Errorf("partial recall: %+v != %+v", rs, cor)
+	}
+	// in training, only all the target units count
+	rs.Compute(ecin, ecout, 0.34, false)
+	if rs.Mem != 1 {
+		t.Errorf("partial recall, training: Mem %g != 1", rs.Mem)
+	}
+
+	setRecallVals(ecin, ecout, cue, targ, targ)
+	rs.Compute(ecin, ecout, 0.34, true)
+	cor = RecallStats{Mem: 1, CmpN: 2}
+	if rs != cor {
+		t.Errorf("full recall: %+v != %+v", rs, cor)
+	}
+
+	// nothing to complete: Mem falls back on all target units
+	setRecallVals(ecin, ecout, targ, targ, []float32{1, 1, 1, 0, 0, 0, 0, 0})
+	rs.Compute(ecin, ecout, 0.34, true)
+	cor = RecallStats{Mem: 1, TrgOnWasOffAll: 0.25}
+	if rs != cor {
+		t.Errorf("no completion: %+v != %+v", rs, cor)
+	}
+}
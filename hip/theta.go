@@ -0,0 +1,254 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hip
+
+import (
+	"log"
+
+	"github.com/ccnlab/leabrax/leabra"
+)
+
+// PrjnScale is one projection WtScale override in a ThetaPhase schedule
+type PrjnScale struct {
+	Send string  `desc:"name of the sending layer"`
+	Recv string  `desc:"name of the receiving layer"`
+	Rel  bool    `desc:"override WtScale.Rel instead of WtScale.Abs"`
+	Val  float32 `desc:"value to set the scale to"`
+	Del  bool    `desc:"Val is a delta added to the base scale, instead of replacing it"`
+}
+
+// prjnBase is the base (param-set) WtScale of a scheduled projection,
+// restored between quarters and at the end of the alpha cycle
+type prjnBase struct {
+	Send, Recv string
+	Pj         *leabra.Prjn
+	Abs, Rel   float32
+}
+
+// ThetaPhase holds a per-quarter schedule of projection scale overrides,
+// implementing the theta phase dynamics of the Ketz et al. (2013) model.
+// Each quarter starts from the base scales set by params, and applies
+// its own overrides on top, so any projection not listed in a quarter
+// has its base scale in that quarter.  The base scales are restored
+// at the end of the alpha cycle.
+// It is driven by the AlphaCycInit and QuarterFinal methods,
+// called by hip.Network -- other network types can embed it and
+// call these methods in the same way.
+type ThetaPhase struct {
+	On      bool           `desc:"apply the schedule -- if false, projection scales are left alone"`
+	Testing bool           `desc:"use the Test schedule instead of Train -- set by the sim for testing trials"`
+	Train   [4][]PrjnScale `desc:"overrides for each quarter during training"`
+	Test    [4][]PrjnScale `desc:"overrides for each quarter during testing"`
+
+	base    []prjnBase `view:"-" desc:"base scales of all scheduled projections, saved at start of alpha cycle"`
+	applied bool       `view:"-" desc:"overrides are currently in effect"`
+}
+
+// Defaults sets the standard Ketz et al. (2013) schedule:
+// Q1: ECin -> CA1 on, CA3 -> CA1 off, DG -> CA3 off
+// Q2,3: CA3 -> CA1 on, ECin -> CA1 off
+// Q4: ECin -> CA1 on, CA3 -> CA1 off
+// During testing, DG -> CA3 is significantly weaker (Rel = 1) for Q2-4.
+func (tp *ThetaPhase) Defaults() {
+	tp.On = true
+	ecOn := []PrjnScale{{Send: "ECin", Recv: "CA1", Val: 1}, {Send: "CA3", Recv: "CA1", Val: 0}}
+	ca3On := []PrjnScale{{Send: "ECin", Recv: "CA1", Val: 0}, {Send: "CA3", Recv: "CA1", Val: 1}}
+	dgOff := PrjnScale{Send: "DG", Recv: "CA3", Rel: true, Val: 0}
+	dgTest := PrjnScale{Send: "DG", Recv: "CA3", Rel: true, Val: 1}
+	for qtr := 0; qtr < 4; qtr++ {
+		tp.Train[qtr] = nil
+		tp.Test[qtr] = nil
+	}
+	tp.SetScales(ecOn, 0)
+	tp.SetScale(dgOff, 0)
+	tp.SetScales(ca3On, 1, 2)
+	tp.SetScales(ecOn, 3)
+	tp.SetTestScale(dgTest, 1, 2, 3)
+}
+
+// SetScale sets the override for the given quarters (0-3) of both
+// the Train and Test schedules, replacing any existing override of
+// the same projection and scale parameter.
+func (tp *ThetaPhase) SetScale(ps PrjnScale, qtrs ...int) {
+	for _, qtr := range qtrs {
+		tp.Train[qtr] = setScale(tp.Train[qtr], ps)
+		tp.Test[qtr] = setScale(tp.Test[qtr], ps)
+	}
+}
+
+// SetScales calls SetScale for each of the overrides
+func (tp *ThetaPhase) SetScales(pss []PrjnScale, qtrs ...int) {
+	for _, ps := range pss {
+		tp.SetScale(ps, qtrs...)
+	}
+}
+
+// SetTrainScale sets the override for the given quarters of the Train schedule only
+func (tp *ThetaPhase) SetTrainScale(ps PrjnScale, qtrs ...int) {
+	for _, qtr := range qtrs {
+		tp.Train[qtr] = setScale(tp.Train[qtr], ps)
+	}
+}
+
+// SetTestScale sets the override for the given quarters of the Test schedule only
+func (tp *ThetaPhase) SetTestScale(ps PrjnScale, qtrs ...int) {
+	for _, qtr := range qtrs {
+		tp.Test[qtr] = setScale(tp.Test[qtr], ps)
+	}
+}
+
+// setScale replaces the override in pss of the same projection and
+// scale parameter as ps, or appends it if there is none
+func setScale(pss []PrjnScale, ps PrjnScale) []PrjnScale {
+	for i := range pss {
+		if pss[i].Send == ps.Send && pss[i].Recv == ps.Recv && pss[i].Rel == ps.Rel {
+			pss[i] = ps
+			return pss
+		}
+	}
+	return append(pss, ps)
+}
+
+// Sched returns the current schedule, Test or Train depending on Testing
+func (tp *ThetaPhase) Sched() *[4][]PrjnScale {
+	if tp.Testing {
+		return &tp.Test
+	}
+	return &tp.Train
+}
+
+// AlphaCycInit saves the base scales of the scheduled projections,
+// and applies the first quarter overrides.  Must be called prior to
+// the AlphaCycInit of the network, which computes the scaling factors.
+func (tp *ThetaPhase) AlphaCycInit(net *leabra.Network) {
+	tp.Restore() // in case the last alpha cycle was interrupted
+	if !tp.On {
+		return
+	}
+	tp.SaveBase(net)
+	tp.ApplyQtr(0)
+}
+
+// QuarterFinal applies the overrides for the next quarter after the
+// end of the given quarter, updating the scaling factors if they change,
+// and restores the base scales after the final quarter.
+// Must be called after the QuarterFinal of the network.
+func (tp *ThetaPhase) QuarterFinal(net *leabra.Network, ltime *leabra.Time) {
+	if !tp.applied {
+		return
+	}
+	if ltime.Quarter >= 3 {
+		tp.Restore()
+		return
+	}
+	sc := tp.Sched()
+	if scalesEqual(sc[ltime.Quarter], sc[ltime.Quarter+1]) {
+		return
+	}
+	tp.ApplyQtr(ltime.Quarter + 1)
+	net.GScaleFmAvgAct() // update computed scaling factors
+	net.InitGInc()       // scaling params change, so need to recompute all netins
+}
+
+// SaveBase looks up all the projections in the Train and Test schedules,
+// and saves their current scales as the base scales
+func (tp *ThetaPhase) SaveBase(net *leabra.Network) {
+	tp.base = tp.base[:0]
+	for _, sc := range [][4][]PrjnScale{tp.Train, tp.Test} {
+		for _, pss := range sc {
+			for _, ps := range pss {
+				if tp.baseIdx(ps.Send, ps.Recv) >= 0 {
+					continue
+				}
+				pb := prjnBase{Send: ps.Send, Recv: ps.Recv}
+				if pj, err := prjnByName(net, ps.Send, ps.Recv); err != nil {
+					log.Println(err)
+				} else {
+					pb.Pj = pj
+					pb.Abs = pj.WtScale.Abs
+					pb.Rel = pj.WtScale.Rel
+				}
+				tp.base = append(tp.base, pb)
+			}
+		}
+	}
+}
+
+// ApplyQtr sets the scales of all scheduled projections to their base
+// values plus the overrides of the current schedule for given quarter.
+// SaveBase must have been called first.
+func (tp *ThetaPhase) ApplyQtr(qtr int) {
+	tp.restoreBase()
+	for _, ps := range tp.Sched()[qtr] {
+		bi := tp.baseIdx(ps.Send, ps.Recv)
+		if bi < 0 || tp.base[bi].Pj == nil {
+			continue
+		}
+		pb := &tp.base[bi]
+		scl, bs := &pb.Pj.WtScale.Abs, pb.Abs
+		if ps.Rel {
+			scl, bs = &pb.Pj.WtScale.Rel, pb.Rel
+		}
+		if ps.Del {
+			*scl = bs + ps.Val
+		} else {
+			*scl = ps.Val
+		}
+	}
+	tp.applied = true
+}
+
+// Restore restores the base scales of the scheduled projections,
+// if the overrides are in effect
+func (tp *ThetaPhase) Restore() {
+	if !tp.applied {
+		return
+	}
+	tp.restoreBase()
+	tp.applied = false
+}
+
+func (tp *ThetaPhase) restoreBase() {
+	for _, pb := range tp.base {
+		if pb.Pj != nil {
+			pb.Pj.WtScale.Abs = pb.Abs
+			pb.Pj.WtScale.Rel = pb.Rel
+		}
+	}
+}
+
+func (tp *ThetaPhase) baseIdx(send, recv string) int {
+	for i := range tp.base {
+		if tp.base[i].Send == send && tp.base[i].Recv == recv {
+			return i
+		}
+	}
+	return -1
+}
+
+// prjnByName returns the projection from send to recv layer
+func prjnByName(net *leabra.Network, send, recv string) (*leabra.Prjn, error) {
+	rly, err := net.LayerByNameTry(recv)
+	if err != nil {
+		return nil, err
+	}
+	pj, err := rly.RecvPrjns().SendNameTry(send)
+	if err != nil {
+		return nil, err
+	}
+	return pj.(leabra.LeabraPrjn).AsLeabra(), nil
+}
+
+func scalesEqual(a, b []PrjnScale) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hip
+
+import (
+	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+)
+
+func newThetaNet(t *testing.T) *Network {
+	net := &Network{}
+	net.InitName(net, "ThetaNet")
+	ecin := net.AddLayer2D("ECin", 2, 2, emer.Input)
+	dg := net.AddLayer2D("DG", 2, 2, emer.Hidden)
+	ca3 := net.AddLayer2D("CA3", 2, 2, emer.Hidden)
+	ca1 := net.AddLayer2D("CA1", 2, 2, emer.Hidden)
+	full := prjn.NewFull()
+	net.ConnectLayersPrjn(ecin, dg, full, emer.Forward, &CHLPrjn{})
+	net.ConnectLayersPrjn(dg, ca3, full, emer.Forward, &CHLPrjn{})
+	net.ConnectLayersPrjn(ecin, ca1, full, emer.Forward, &EcCa1Prjn{})
+	net.ConnectLayersPrjn(ca3, ca1, full, emer.Forward, &CHLPrjn{})
+	net.Defaults()
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	pj, _ := prjnByName(&net.Network, "DG", "CA3")
+	pj.WtScale.Rel = 4
+	return net
+}
+
+// thetaScales returns the ECin -> CA1 Abs, CA3 -> CA1 Abs, and DG -> CA3 Rel scales
+func thetaScales(net *Network) [3]float32 {
+	eca1, _ := prjnByName(&net.Network, "ECin", "CA1")
+	ca3ca1, _ := prjnByName(&net.Network, "CA3", "CA1")
+	dgca3, _ := prjnByName(&net.Network, "DG", "CA3")
+	return [3]float32{eca1.WtScale.Abs, ca3ca1.WtScale.Abs, dgca3.WtScale.Rel}
+}
+
+func TestThetaPhase(t *testing.T) {
+	net := newThetaNet(t)
+	ltime := leabra.NewTime()
+	base := [3]float32{1, 1, 4}
+	train := [4][3]float32{{1, 0, 0}, {0, 1, 4}, {0, 1, 4}, {1, 0, 4}}
+	test := [4][3]float32{{1, 0, 0}, {0, 1, 1}, {0, 1, 1}, {1, 0, 1}}
+	for ti, cor := range [][4][3]float32{train, test} {
+		net.Theta.Testing = ti == 1
+		net.AlphaCycInit()
+		ltime.AlphaCycStart()
+		for qtr := 0; qtr < 4; qtr++ {
+			if sc := thetaScales(net); sc != cor[qtr] {
+				t.Errorf("testing: %v quarter: %d scales: %v != %v", net.Theta.Testing, qtr, sc, cor[qtr])
+			}
+			net.QuarterFinal(ltime)
+			ltime.QuarterInc()
+		}
+		if sc := thetaScales(net); sc != base {
+			t.Errorf("testing: %v scales not restored: %v != %v", net.Theta.Testing, sc, base)
+		}
+	}
+
+	// an interrupted alpha cycle must not change the base scales
+	net.Theta.Testing = false
+	net.AlphaCycInit()
+	net.AlphaCycInit()
+	ltime.AlphaCycStart()
+	for qtr := 0; qtr < 4; qtr++ {
+		net.QuarterFinal(ltime)
+		ltime.QuarterInc()
+	}
+	if sc := thetaScales(net); sc != base {
+		t.Errorf("interrupted: scales not restored: %v != %v", sc, base)
+	}
+}
+
+func TestThetaPhaseDel(t *testing.T) {
+	net := newThetaNet(t)
+	net.Theta.SetScale(PrjnScale{Send: "DG", Recv: "CA3", Rel: true, Val: -3, Del: true}, 0)
+	net.Theta.SetTestScale(PrjnScale{Send: "DG", Recv: "CA3", Rel: true, Val: -2, Del: true}, 1, 2, 3)
+	net.Theta.Testing = true
+	net.AlphaCycInit()
+	if sc := thetaScales(net); sc[2] != 1 {
+		t.Errorf("Q1 DG -> CA3 Rel: %g != 1", sc[2])
+	}
+	ltime := leabra.NewTime()
+	net.QuarterFinal(ltime)
+	if sc := thetaScales(net); sc[2] != 2 {
+		t.Errorf("Q2 DG -> CA3 Rel: %g != 2", sc[2])
+	}
+}
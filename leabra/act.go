@@ -5,6 +5,8 @@
 package leabra
 
 import (
+	"math/rand"
+
 	"github.com/ccnlab/leabrax/chans"
 	"github.com/ccnlab/leabrax/knadapt"
 	"github.com/ccnlab/leabrax/nxx1"
@@ -151,7 +153,7 @@ func (ac *ActParams) GeFmRaw(nrn *Neuron, geRaw float32) {
 	ac.Dt.GFmRaw(geRaw, &nrn.Ge)
 	// first place noise is required -- generate here!
 	if ac.Noise.Type != NoNoise && !ac.Noise.Fixed && ac.Noise.Dist != erand.Mean {
-		nrn.Noise = float32(ac.Noise.Gen(-1))
+		nrn.Noise = float32(ac.Noise.GenNoise())
 	}
 	if ac.Noise.Type == GeNoise {
 		nrn.Ge += nrn.Noise
@@ -369,6 +371,13 @@ type ActNoiseParams struct {
 	erand.RndParams
 	Type  ActNoiseType `desc:"where and how to add processing noise"`
 	Fixed bool         `desc:"keep the same noise value over the entire alpha cycle -- prevents noise from being washed out and produces a stable effect that can be better used for learning -- this is strongly recommended for most learning situations"`
+	Rnd   *rand.Rand   `view:"-" json:"-" desc:"random number stream for generating noise -- set to the layer's Rnd stream -- nil uses the global random number generator"`
+}
+
+// GenNoise generates a noise value according to the noise parameters,
+// using the Rnd stream.
+func (an *ActNoiseParams) GenNoise() float64 {
+	return RndGen(&an.RndParams, an.Rnd)
 }
 
 func (an *ActNoiseParams) Update() {
@@ -413,7 +422,12 @@ func (cp *ClampParams) AvgGe(ext, ge float32) float32 {
 // random distribution parameters but also Symmetry flag
 type WtInitParams struct {
 	erand.RndParams
-	Sym bool `desc:"symmetrize the weight values with those in reciprocal projection -- typically true for bidirectional excitatory connections"`
+	Sym      bool        `desc:"symmetrize the weight values with those in reciprocal projection -- typically true for bidirectional excitatory connections"`
+	Strat    WtInitStrat `desc:"strategy for initializing the weights -- RndWts draws each weight directly from the random distribution, and the others use the distribution parameters as described for each strategy"`
+	Seed     int64       `desc:"if non-zero, the weights are initialized using a random number generator specific to this projection with this seed, so that they are reproducible and independent of all other random numbers drawn (e.g., in other projections) -- all distributions use this generator"`
+	NNonZero int         `viewif:"Strat=SparseWts" min:"1" desc:"number of non-zero random weights per receiving unit for SparseWts -- the remaining weights are set to 0"`
+	Sigma    float32     `viewif:"Strat=TopoWts" def:"0.3" min:"0" desc:"width of the gaussian for TopoWts, in normalized units where the full extent of each layer is 1"`
+	CopyFrom string      `viewif:"Strat=CopyWts" desc:"name of the projection to copy the weights from for CopyWts (e.g., InputToHidden) -- must have the same sending and receiving layer sizes"`
 }
 
 func (wp *WtInitParams) Defaults() {
@@ -421,8 +435,56 @@ func (wp *WtInitParams) Defaults() {
 	wp.Var = 0.25
 	wp.Dist = erand.Uniform
 	wp.Sym = true
+	wp.NNonZero = 10
+	wp.Sigma = 0.3
 }
 
+// WtInitStrat are different strategies for initializing the weights in a projection
+type WtInitStrat int
+
+//go:generate stringer -type=WtInitStrat
+
+var KiT_WtInitStrat = kit.Enums.AddEnum(WtInitStratN, kit.NotBitFlag, nil)
+
+func (ev WtInitStrat) MarshalJSON() ([]byte, error)  { return kit.EnumMarshalJSON(ev) }
+func (ev *WtInitStrat) UnmarshalJSON(b []byte) error { return kit.EnumUnmarshalJSON(ev, b) }
+
+// The weight initialization strategies
+const (
+	// RndWts draws each weight independently from the random distribution
+	RndWts WtInitStrat = iota
+
+	// FanInWts draws each weight from the random distribution, with the
+	// deviation from the Mean scaled by 1 / sqrt(N), where N is the number
+	// of connections into the receiving unit (fan-in), so that the variance
+	// of the net input is independent of the number of connections.
+	FanInWts
+
+	// SparseWts draws NNonZero randomly-selected weights for each receiving
+	// unit from the random distribution, and sets all the others to 0.
+	SparseWts
+
+	// TopoWts sets weights as a gaussian function of the distance between
+	// the positions of the sending and receiving units (of their pools, for
+	// 4D layers) normalized within their respective layers, with width Sigma,
+	// ranging from Mean+Var at the same position to Mean-Var far away.
+	TopoWts
+
+	// OrthoWts sets weights so that their deviations from the Mean form an
+	// orthogonal matrix (orthogonal rows if there are fewer receiving than
+	// sending units, otherwise orthogonal columns), generated from gaussian
+	// random values, and scaled so the maximum deviation from Mean is Var.
+	OrthoWts
+
+	// CopyWts copies the weights from the CopyFrom projection, which is done
+	// by Network.InitWts after all other weights have been initialized.
+	// Weights are initialized as in RndWts before that point, and are
+	// retained for any synapses not present in the CopyFrom projection.
+	CopyWts
+
+	WtInitStratN
+)
+
 //////////////////////////////////////////////////////////////////////////////////////
 //  WtScaleParams
 
@@ -5,13 +5,16 @@
 package leabra
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/chewxy/math32"
 	"github.com/emer/emergent/emer"
 	"github.com/emer/emergent/params"
 	"github.com/emer/emergent/prjn"
+	"github.com/emer/emergent/weights"
 	"github.com/emer/etable/etensor"
 )
 
@@ -121,6 +124,110 @@ func TestSynVals(t *testing.T) {
 	// fmt.Printf("SynVals: before wt: %v, lwt: %v  after wt: %v, lwt: %v\n", bfWt, bfLWt, afWt, afLWt)
 }
 
+func TestBulkVals(t *testing.T) {
+	TestNet.InitWts()
+	hidLay := TestNet.LayerByName("Hidden").(*Layer)
+	fmIn := hidLay.RcvPrjns.SendName("Input").(*Prjn)
+
+	wts := &etensor.Float32{}
+	fmIn.SynValsTensor(wts, "Wt")
+	if wts.Dim(0) != 4 || wts.Dim(1) != 4 {
+		t.Errorf("SynValsTensor shape: %v != [4 4]", wts.Shapes())
+	}
+	if !math32.IsNaN(wts.Value([]int{0, 1})) {
+		t.Errorf("SynValsTensor missing synapse not NaN: %v", wts.Value([]int{0, 1}))
+	}
+	wts.Set([]int{1, 1}, .15)
+	err := fmIn.SetSynValsBuf(&Float32Buf{Vals: wts.Values}, "Wt")
+	if err != nil {
+		t.Error(err)
+	}
+	CmprFloats([]float32{fmIn.SynVal("Wt", 1, 1), fmIn.SynVal("LWt", 1, 1), fmIn.SynVal("Wt", 2, 2)}, []float32{0.15, 0.42822415, 0.5}, "bulk syn val setting test", t)
+
+	acts := []float32{.1, .2, .3, .4}
+	buf := NewFloat32Buf(len(acts))
+	copy(buf.Vals, acts)
+	err = hidLay.SetUnitValsBuf(buf, "Act")
+	if err != nil {
+		t.Error(err)
+	}
+	ptr := buf.Ptr()
+	err = hidLay.UnitValsBuf(buf, "Act")
+	if err != nil {
+		t.Error(err)
+	}
+	CmprFloats(buf.Vals, acts, "bulk unit val setting test", t)
+	if buf.Ptr() != ptr {
+		t.Errorf("UnitValsBuf should reuse buffer memory of the same size")
+	}
+	if err := fmIn.SynValsBuf(buf, "Wt"); err != nil || buf.Len() != 16 {
+		t.Errorf("SynValsBuf: len %d != 16, err: %v", buf.Len(), err)
+	}
+	if err := hidLay.SetUnitVals(acts, "Foo"); err == nil {
+		t.Errorf("SetUnitVals should fail on invalid var name")
+	}
+	TestNet.InitWts()
+}
+
+func TestWtsSynVars(t *testing.T) {
+	mknet := func() (*Network, *Prjn, []float32) {
+		net := &Network{}
+		net.InitName(net, "WtsNet")
+		inLay := net.AddLayer("Input", []int{4, 1}, emer.Input)
+		hidLay := net.AddLayer("Hidden", []int{4, 1}, emer.Hidden)
+		pj := net.ConnectLayers(inLay, hidLay, prjn.NewFull(), emer.Forward).(*Prjn)
+		net.Defaults()
+		net.Build()
+		net.InitWts()
+		trs := make([]float32, len(pj.Syns))
+		pj.AddWtsSynVar("Tr", func(si int) *float32 { return &trs[si] })
+		return net, pj, trs
+	}
+	net, pj, trs := mknet()
+	for si := range trs {
+		trs[si] = float32(si) * .25
+	}
+	pj.SetSynVal("Wt", 1, 2, .3)
+	var buf bytes.Buffer
+	net.WriteWtsJSON(&buf)
+	if !strings.Contains(buf.String(), `"Tr": [ `) {
+		t.Errorf("extra syn var not written as Rs array")
+	}
+
+	net2, pj2, trs2 := mknet()
+	if err := net2.ReadWtsJSON(&buf); err != nil {
+		t.Error(err)
+	}
+	CmprFloats(trs2, trs, "extra syn var weights read", t)
+	CmprFloats([]float32{pj2.SynVal("Wt", 1, 2)}, []float32{.3}, "syn wt weights read", t)
+
+	buf.Reset()
+	for si := range trs {
+		trs[si] = 1 - float32(si)*.125
+	}
+	pj.WriteWtsJSON(&buf, 0)
+	if err := pj2.ReadWtsJSON(&buf); err != nil {
+		t.Error(err)
+	}
+	CmprFloats(trs2, trs, "extra syn var prjn weights read", t)
+
+	buf.Reset()
+	for si := range trs {
+		trs[si] = float32(si) * .0625
+	}
+	net.LayerByName("Hidden").WriteWtsJSON(&buf, 0)
+	if err := net2.LayerByName("Hidden").(*Layer).ReadWtsJSON(&buf); err != nil {
+		t.Error(err)
+	}
+	CmprFloats(trs2, trs, "extra syn var layer weights read", t)
+
+	pw := &weights.Prjn{Rs: []weights.Recv{{Ri: 0, Si: []int{0, 1, 2}, Wt: []float32{.5, .5, .5}}}}
+	vp := &WtsVarsPrjn{Rs: []WtsVarsRecv{{"Tr": {1, 2}}}}
+	if err := pj2.SetWtsVars(pw, vp); err == nil {
+		t.Errorf("SetWtsVars should fail on wrong number of extra syn var values")
+	}
+}
+
 func TestInPats(t *testing.T) {
 	InPats = etensor.NewFloat32([]int{4, 4, 1}, nil, []string{"pat", "Y", "X"})
 	for pi := 0; pi < 4; pi++ {
@@ -0,0 +1,46 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package leabra
+
+import "unsafe"
+
+// Float32Buf is a contiguous float32 buffer in Go memory, for zero-copy
+// bulk transfer of unit and synapse values to and from Python.
+// Python wraps the Len values at Ptr directly as a numpy array (see
+// pyet.go_buf_to_numpy), so there are no per-element calls into Go.
+// The same buffer can be reused across calls, but any numpy view
+// on it is invalid after SetLen changes its length, as the memory can move.
+type Float32Buf struct {
+	Vals []float32 `desc:"the values"`
+}
+
+// NewFloat32Buf returns a new buffer with n values
+func NewFloat32Buf(n int) *Float32Buf {
+	return &Float32Buf{Vals: make([]float32, n)}
+}
+
+// SetLen sets the number of values, reusing existing memory if sufficient
+func (fb *Float32Buf) SetLen(n int) {
+	if cap(fb.Vals) >= n {
+		fb.Vals = fb.Vals[:n]
+		return
+	}
+	fb.Vals = make([]float32, n)
+}
+
+// Len returns the number of values
+func (fb *Float32Buf) Len() int {
+	return len(fb.Vals)
+}
+
+// Ptr returns the memory address of the first value, or 0 if empty.
+// The memory stays valid as long as the buffer is referenced and
+// its length is not changed.
+func (fb *Float32Buf) Ptr() uintptr {
+	if len(fb.Vals) == 0 {
+		return 0
+	}
+	return uintptr(unsafe.Pointer(&fb.Vals[0]))
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package leabra
+
+import (
+	"github.com/emer/etable/etable"
+	"github.com/emer/etable/etensor"
+)
+
+// GateStats are the trial-level gating results for a layer whose pools
+// represent separate gating domains, and the logging of these results,
+// shared by the GatingMonitor types in the pbwm and pcore packages,
+// which record GateCyc, GateAct and MaxAct for each pool over the cycles
+// of a trial, and call TrialEnd at the end of the trial.
+type GateStats struct {
+	GateCyc  []int     `inactive:"+" desc:"for each pool, cycle within the trial when the pool first gated, -1 if not gated"`
+	GateAct  []float32 `inactive:"+" desc:"for each pool, gating activation of the pool, 0 if not gated"`
+	MaxAct   []float32 `inactive:"+" desc:"for each pool, maximum of the pool max activation over the trial"`
+	NGated   int       `inactive:"+" desc:"number of pools that gated on this trial"`
+	Pool     int       `inactive:"+" desc:"pool that gated first on this trial (ties broken by greater GateAct), -1 if none"`
+	RT       int       `inactive:"+" desc:"reaction time: cycle within the trial when Pool gated, -1 if none"`
+	Strength float32   `inactive:"+" desc:"gating strength: GateAct of the gated Pool, 0 if none"`
+	Failed   bool      `inactive:"+" desc:"true if no pool gated on this trial"`
+}
+
+// Config allocates the per-pool state for given number of pools
+func (gs *GateStats) Config(np int) {
+	gs.GateCyc = make([]int, np)
+	gs.GateAct = make([]float32, np)
+	gs.MaxAct = make([]float32, np)
+	gs.Init()
+}
+
+// NPools returns the number of pools being monitored
+func (gs *GateStats) NPools() int {
+	return len(gs.GateCyc)
+}
+
+// Init initializes the state at the start of a trial
+func (gs *GateStats) Init() {
+	for pi := range gs.GateCyc {
+		gs.GateCyc[pi] = -1
+		gs.GateAct[pi] = 0
+		gs.MaxAct[pi] = 0
+	}
+	gs.NGated = 0
+	gs.Pool = -1
+	gs.RT = -1
+	gs.Strength = 0
+	gs.Failed = true
+}
+
+// TrialEnd computes the trial-level results -- call at the end of the trial.
+func (gs *GateStats) TrialEnd() {
+	gs.NGated = 0
+	gs.Pool = -1
+	for pi, gc := range gs.GateCyc {
+		if gc < 0 {
+			continue
+		}
+		gs.NGated++
+		if gs.Pool < 0 || gc < gs.GateCyc[gs.Pool] || (gc == gs.GateCyc[gs.Pool] && gs.GateAct[pi] > gs.GateAct[gs.Pool]) {
+			gs.Pool = pi
+		}
+	}
+	gs.Failed = gs.Pool < 0
+	if gs.Failed {
+		gs.RT = -1
+		gs.Strength = 0
+		return
+	}
+	gs.RT = gs.GateCyc[gs.Pool]
+	gs.Strength = gs.GateAct[gs.Pool]
+}
+
+// GatedPools returns the indexes of all pools that gated on this trial
+func (gs *GateStats) GatedPools() []int {
+	var gp []int
+	for pi, gc := range gs.GateCyc {
+		if gc >= 0 {
+			gp = append(gp, pi)
+		}
+	}
+	return gp
+}
+
+// LogSchema returns the etable columns recorded by LogTrial,
+// which can be appended to the schema for a trial log.
+func (gs *GateStats) LogSchema() etable.Schema {
+	np := gs.NPools()
+	return etable.Schema{
+		{Name: "GatePool", Type: etensor.INT64},
+		{Name: "GateRT", Type: etensor.INT64},
+		{Name: "GateStrength", Type: etensor.FLOAT64},
+		{Name: "GateFailed", Type: etensor.INT64},
+		{Name: "NGated", Type: etensor.INT64},
+		{Name: "GateCyc", Type: etensor.INT64, CellShape: []int{np}, DimNames: []string{"Pool"}},
+		{Name: "GateAct", Type: etensor.FLOAT64, CellShape: []int{np}, DimNames: []string{"Pool"}},
+		{Name: "GateMaxAct", Type: etensor.FLOAT64, CellShape: []int{np}, DimNames: []string{"Pool"}},
+	}
+}
+
+// ConfigLog configures given table with the LogSchema columns only,
+// named for given gating layer name.
+func (gs *GateStats) ConfigLog(dt *etable.Table, layNm string) {
+	dt.SetMetaData("name", layNm+"GateLog")
+	dt.SetMetaData("desc", "Record of gating in each trial")
+	dt.SetMetaData("read-only", "true")
+	dt.SetMetaData("precision", "4")
+	dt.SetFromSchema(gs.LogSchema(), 0)
+}
+
+// LogTrial records the current trial results into given row of table,
+// which must contain the LogSchema columns.
+func (gs *GateStats) LogTrial(dt *etable.Table, row int) {
+	if dt.Rows <= row {
+		dt.SetNumRows(row + 1)
+	}
+	failed := 0.0
+	if gs.Failed {
+		failed = 1
+	}
+	dt.SetCellFloat("GatePool", row, float64(gs.Pool))
+	dt.SetCellFloat("GateRT", row, float64(gs.RT))
+	dt.SetCellFloat("GateStrength", row, float64(gs.Strength))
+	dt.SetCellFloat("GateFailed", row, failed)
+	dt.SetCellFloat("NGated", row, float64(gs.NGated))
+	gc := dt.CellTensor("GateCyc", row)
+	ga := dt.CellTensor("GateAct", row)
+	ma := dt.CellTensor("GateMaxAct", row)
+	for pi := range gs.GateCyc {
+		gc.SetFloat1D(pi, float64(gs.GateCyc[pi]))
+		ga.SetFloat1D(pi, float64(gs.GateAct[pi]))
+		ma.SetFloat1D(pi, float64(gs.MaxAct[pi]))
+	}
+}
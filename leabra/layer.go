@@ -5,10 +5,12 @@
 package leabra
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"math"
 	"math/rand"
@@ -36,6 +38,7 @@ type Layer struct {
 	Neurons []Neuron        `desc:"slice of neurons for this layer -- flat list of len = Shp.Len(). You must iterate over index and use pointer to modify values."`
 	Pools   []Pool          `desc:"inhibition and other pooled, aggregate state variables -- flat list has at least of 1 for layer, and one for each sub-pool (unit group) if shape supports that (4D).  You must iterate over index and use pointer to modify values."`
 	CosDiff CosDiffStats    `desc:"cosine difference between ActM, ActP stats"`
+	Rnd     *rand.Rand      `view:"-" json:"-" desc:"random number stream for this layer, derived from the network seed and layer name (see Network.SetSeed) -- nil uses the global random number generator"`
 }
 
 var KiT_Layer = kit.Types.AddType(&Layer{}, LayerProps)
@@ -196,6 +199,61 @@ func (ly *Layer) UnitVal(varNm string, idx []int) float32 {
 	return ly.LeabraLay.UnitVal1D(vidx, fidx)
 }
 
+// UnitValsBuf fills in values of given variable name on unit
+// for each unit in the layer into given buffer, in the same order
+// as the layer units.  This is for efficient zero-copy bulk transfer
+// of values to Python (see pyet.layer_to_numpy).
+func (ly *Layer) UnitValsBuf(buf *Float32Buf, varNm string) error {
+	buf.SetLen(len(ly.Neurons))
+	return ly.UnitVals(&buf.Vals, varNm)
+}
+
+// SetUnitVals sets values of given variable name on unit,
+// for each unit in the layer, from given float32 slice, in the same
+// order as the layer units.  Only the basic Neuron variables can be set.
+// Returns error on invalid var name or if vals is too short.
+func (ly *Layer) SetUnitVals(vals []float32, varNm string) error {
+	vidx, err := NeuronVarIdxByName(varNm)
+	if err != nil {
+		return err
+	}
+	nn := len(ly.Neurons)
+	if len(vals) < nn {
+		return fmt.Errorf("leabra.SetUnitVals: number of values: %d is less than number of neurons: %d", len(vals), nn)
+	}
+	for i := range ly.Neurons {
+		ly.Neurons[i].SetVarByIndex(vidx, vals[i])
+	}
+	return nil
+}
+
+// SetUnitValsTensor sets values of given variable name on unit,
+// for each unit in the layer, from given tensor, using its flat 1D
+// values in the same order as the layer units.
+// Only the basic Neuron variables can be set.
+func (ly *Layer) SetUnitValsTensor(tsr etensor.Tensor, varNm string) error {
+	vidx, err := NeuronVarIdxByName(varNm)
+	if err != nil {
+		return err
+	}
+	nn := len(ly.Neurons)
+	if tsr.Len() < nn {
+		return fmt.Errorf("leabra.SetUnitValsTensor: tensor size: %d is less than number of neurons: %d", tsr.Len(), nn)
+	}
+	for i := range ly.Neurons {
+		ly.Neurons[i].SetVarByIndex(vidx, float32(tsr.FloatVal1D(i)))
+	}
+	return nil
+}
+
+// SetUnitValsBuf sets values of given variable name on unit,
+// for each unit in the layer, from given buffer, in the same order
+// as the layer units.  This is for efficient zero-copy bulk transfer
+// of values from Python (see pyet.numpy_to_layer).
+func (ly *Layer) SetUnitValsBuf(buf *Float32Buf, varNm string) error {
+	return ly.SetUnitVals(buf.Vals, varNm)
+}
+
 // RecvPrjnVals fills in values of given synapse variable name,
 // for projection into given sending layer and neuron 1D index,
 // for all receiving neurons in this layer,
@@ -373,9 +431,27 @@ func (ly *Layer) Build() error {
 		return err
 	}
 	err = ly.BuildPrjns()
+	if lnet, ok := ly.Network.(LeabraNetwork); ok {
+		net := lnet.AsLeabra()
+		ly.InitRnd(net.RndSeed, net.RndStreams)
+	}
 	return err
 }
 
+// InitRnd initializes the random number streams for this layer and its
+// receiving projections, derived from given network seed and their names
+// if streams is true -- otherwise they use the global random number generator.
+func (ly *Layer) InitRnd(seed int64, streams bool) {
+	ly.Rnd = nil
+	if streams {
+		ly.Rnd = NewRndStream(seed, ly.Nm)
+	}
+	ly.Act.Noise.Rnd = ly.Rnd
+	for _, p := range ly.RcvPrjns {
+		p.(LeabraPrjn).AsLeabra().InitRnd(seed, streams)
+	}
+}
+
 // WriteWtsJSON writes the weights from this layer from the receiver-side perspective
 // in a JSON text format.  We build in the indentation logic to make it much faster and
 // more efficient.
@@ -429,12 +505,28 @@ func (ly *Layer) WriteWtsJSON(w io.Writer, depth int) {
 // in a JSON text format.  This is for a set of weights that were saved *for one layer only*
 // and is not used for the network-level ReadWtsJSON, which reads into a separate
 // structure -- see SetWts method.
+// Any extra synaptic variables registered with AddWtsSynVar are also read.
 func (ly *Layer) ReadWtsJSON(r io.Reader) error {
-	lw, err := weights.LayReadJSON(r)
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	lw, err := weights.LayReadJSON(bytes.NewReader(b))
 	if err != nil {
 		return err // note: already logged
 	}
-	return ly.SetWts(lw)
+	err = ly.SetWts(lw)
+	if !ly.HasWtsVars() {
+		return err
+	}
+	vl := &WtsVarsLayer{}
+	if er := json.Unmarshal(b, vl); er != nil {
+		return er
+	}
+	if er := ly.SetWtsVars(lw, vl); er != nil {
+		err = er
+	}
+	return err
 }
 
 // SetWts sets the weights for this layer from weights.Layer decoded values
@@ -480,6 +572,47 @@ func (ly *Layer) SetWts(lw *weights.Layer) error {
 	return err
 }
 
+// HasWtsVars returns true if any receiving projection has extra synaptic
+// variables registered with AddWtsSynVar, to be saved in weight files.
+func (ly *Layer) HasWtsVars() bool {
+	for _, p := range ly.RcvPrjns {
+		if len(p.(LeabraPrjn).AsLeabra().WtsVars) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// SetWtsVars sets the extra synaptic variables registered with AddWtsSynVar
+// in the receiving projections from given decoded values, matching
+// projections in the same way as SetWts.
+func (ly *Layer) SetWtsVars(lw *weights.Layer, vl *WtsVarsLayer) error {
+	if ly.IsOff() {
+		return nil
+	}
+	if len(vl.Prjns) != len(lw.Prjns) {
+		return fmt.Errorf("leabra.Layer.SetWtsVars: %s: %d prjns for synapse variables, expected %d", ly.Nm, len(vl.Prjns), len(lw.Prjns))
+	}
+	var err error
+	rpjs := ly.RecvPrjns()
+	for pi := range lw.Prjns {
+		pw := &lw.Prjns[pi]
+		var pj emer.Prjn
+		if len(lw.Prjns) == len(*rpjs) {
+			pj = (*rpjs)[pi]
+		} else {
+			pj = rpjs.SendName(pw.From)
+		}
+		if pj == nil {
+			continue
+		}
+		if er := pj.(LeabraPrjn).AsLeabra().SetWtsVars(pw, &vl.Prjns[pi]); er != nil {
+			err = er
+		}
+	}
+	return err
+}
+
 // VarRange returns the min / max values for given variable
 // todo: support r. s. projection values
 func (ly *Layer) VarRange(varNm string) (min, max float32, err error) {
@@ -889,7 +1022,7 @@ func (ly *Layer) GScaleFmAvgAct() {
 func (ly *Layer) GenNoise() {
 	for ni := range ly.Neurons {
 		nrn := &ly.Neurons[ni]
-		nrn.Noise = float32(ly.Act.Noise.Gen(-1))
+		nrn.Noise = float32(ly.Act.Noise.GenNoise())
 	}
 }
 
@@ -1366,7 +1499,7 @@ func (ly *Layer) LesionNeurons(prop float32) int {
 	if nn == 0 {
 		return 0
 	}
-	p := rand.Perm(nn)
+	p := RndPerm(nn, ly.Rnd)
 	nl := int(prop * float32(nn))
 	for i := 0; i < nl; i++ {
 		nrn := &ly.Neurons[p[i]]
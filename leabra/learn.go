@@ -5,6 +5,8 @@
 package leabra
 
 import (
+	"math/rand"
+
 	"github.com/chewxy/math32"
 	"github.com/emer/emergent/erand"
 )
@@ -394,11 +396,12 @@ func (xc *XCalParams) LongLrate(avgLLrn float32) float32 {
 
 // WtSigParams are sigmoidal weight contrast enhancement function parameters
 type WtSigParams struct {
-	Gain       float32 `def:"1,6" min:"0" desc:"gain (contrast, sharpness) of the weight contrast function (1 = linear)"`
-	Off        float32 `def:"1" min:"0" desc:"offset of the function (1=centered at .5, >1=higher, <1=lower) -- 1 is standard for XCAL"`
-	SoftBound  bool    `def:"true" desc:"apply exponential soft bounding to the weight changes"`
-	PFail      float32 `desc:"probability of synaptic transmission failure -- if > 0, then weights are turned off at random as a function of PFail * (1-Min(Wt/Max, 1))^2"`
-	PFailWtMax float32 `desc:"maximum weight value that experiences no synaptic failure -- weights at or above this level never fail to communicate, while probability of failure increases parabolically below this level"`
+	Gain       float32    `def:"1,6" min:"0" desc:"gain (contrast, sharpness) of the weight contrast function (1 = linear)"`
+	Off        float32    `def:"1" min:"0" desc:"offset of the function (1=centered at .5, >1=higher, <1=lower) -- 1 is standard for XCAL"`
+	SoftBound  bool       `def:"true" desc:"apply exponential soft bounding to the weight changes"`
+	PFail      float32    `desc:"probability of synaptic transmission failure -- if > 0, then weights are turned off at random as a function of PFail * (1-Min(Wt/Max, 1))^2"`
+	PFailWtMax float32    `desc:"maximum weight value that experiences no synaptic failure -- weights at or above this level never fail to communicate, while probability of failure increases parabolically below this level"`
+	Rnd        *rand.Rand `view:"-" json:"-" desc:"random number stream for synaptic failures -- set to the projection's Rnd stream -- nil uses the global random number generator"`
 }
 
 func (ws *WtSigParams) Update() {
@@ -495,7 +498,10 @@ func (ws *WtSigParams) WtFail(wt float32) bool {
 	if fp == 0 {
 		return false
 	}
-	return erand.BoolP(fp)
+	if ws.Rnd == nil {
+		return erand.BoolP(fp)
+	}
+	return ws.Rnd.Float32() < fp
 }
 
 //////////////////////////////////////////////////////////////////////////////////////
@@ -6,6 +6,7 @@ package leabra
 
 import (
 	"fmt"
+	"log"
 	"math"
 	"math/rand"
 	"strings"
@@ -24,8 +25,10 @@ import (
 // leabra.Network has parameters for running a basic rate-coded Leabra network
 type Network struct {
 	NetworkStru
-	WtBalInterval int `def:"10" desc:"how frequently to update the weight balance average weight factor -- relatively expensive"`
-	WtBalCtr      int `inactive:"+" desc:"counter for how long it has been since last WtBal"`
+	WtBalInterval int   `def:"10" desc:"how frequently to update the weight balance average weight factor -- relatively expensive"`
+	WtBalCtr      int   `inactive:"+" desc:"counter for how long it has been since last WtBal"`
+	RndSeed       int64 `inactive:"+" desc:"network random seed, from which the random number streams for each layer and projection are derived, when RndStreams is on -- set using SetSeed"`
+	RndStreams    bool  `inactive:"+" desc:"if true, each layer and projection uses its own random number stream derived from RndSeed and its name, so that random numbers in one are not affected by changes elsewhere in the network -- otherwise the global math/rand generator is used -- set using SetSeed, SetGlobalRnd"`
 }
 
 var KiT_Network = kit.Types.AddType(&Network{}, NetworkProps)
@@ -164,6 +167,33 @@ func (nt *Network) WtFail() {
 //////////////////////////////////////////////////////////////////////////////////////
 //  Init methods
 
+// SetSeed sets the network random seed, and turns on RndStreams mode, where each
+// layer and projection has its own random number stream derived from the seed
+// and its name.  This means that adding or changing a layer or projection does not
+// affect the random numbers used by any others.  Typically called before InitWts
+// at the start of each run, with a different seed per run.
+func (nt *Network) SetSeed(seed int64) {
+	nt.RndSeed = seed
+	nt.RndStreams = true
+	nt.InitRnd()
+}
+
+// SetGlobalRnd turns off RndStreams mode, so all layers and projections use
+// the global math/rand random number generator (the default).
+func (nt *Network) SetGlobalRnd() {
+	nt.RndStreams = false
+	nt.InitRnd()
+}
+
+// InitRnd (re)initializes the random number streams for all layers and
+// projections according to RndSeed and RndStreams.  Called by SetSeed,
+// SetGlobalRnd, and automatically when layers are built.
+func (nt *Network) InitRnd() {
+	for _, ly := range nt.Layers {
+		ly.(LeabraLayer).AsLeabra().InitRnd(nt.RndSeed, nt.RndStreams)
+	}
+}
+
 // InitWts initializes synaptic weights and all other associated long-term state variables
 // including running-average state values (e.g., layer running average activations etc)
 func (nt *Network) InitWts() {
@@ -182,6 +212,20 @@ func (nt *Network) InitWts() {
 		}
 		ly.(LeabraLayer).InitWtSym()
 	}
+	// separate pass for prjns that copy weights from other prjns
+	for _, ly := range nt.Layers {
+		if ly.IsOff() {
+			continue
+		}
+		for _, p := range *ly.RecvPrjns() {
+			if p.IsOff() {
+				continue
+			}
+			if err := p.(LeabraPrjn).AsLeabra().InitWtsCopy(); err != nil {
+				log.Println(err)
+			}
+		}
+	}
 	// dur := time.Now().Sub(st)
 	// fmt.Printf("sym: %v\n", dur)
 }
@@ -6,10 +6,13 @@ package leabra
 
 import (
 	"bufio"
+	"bytes"
 	"compress/gzip"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
@@ -510,18 +513,64 @@ func (nt *NetworkStru) WriteWtsJSON(w io.Writer) error {
 // ReadWtsJSON reads network weights from the receiver-side perspective
 // in a JSON text format.  Reads entire file into a temporary weights.Weights
 // structure that is then passed to Layers etc using SetWts method.
+// Any extra synaptic variables registered with AddWtsSynVar are then
+// decoded into a WtsVarsNet and set using SetWtsVars.
 func (nt *NetworkStru) ReadWtsJSON(r io.Reader) error {
-	nw, err := weights.NetReadJSON(r)
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	nw, err := weights.NetReadJSON(bytes.NewReader(b))
 	if err != nil {
 		return err // note: already logged
 	}
 	err = nt.SetWts(nw)
+	if nt.HasWtsVars() {
+		vn := &WtsVarsNet{}
+		if er := json.Unmarshal(b, vn); er != nil {
+			err = er
+		} else if er := nt.SetWtsVars(nw, vn); er != nil {
+			err = er
+		}
+	}
 	if err != nil {
 		log.Println(err)
 	}
 	return err
 }
 
+// HasWtsVars returns true if any layer has projections with extra synaptic
+// variables registered with AddWtsSynVar.
+func (nt *NetworkStru) HasWtsVars() bool {
+	for _, ly := range nt.Layers {
+		if ly.(LeabraLayer).AsLeabra().HasWtsVars() {
+			return true
+		}
+	}
+	return false
+}
+
+// SetWtsVars sets the extra synaptic variables registered with AddWtsSynVar
+// from given decoded values, in parallel with the weights.Network Layers.
+func (nt *NetworkStru) SetWtsVars(nw *weights.Network, vn *WtsVarsNet) error {
+	if len(vn.Layers) != len(nw.Layers) {
+		return fmt.Errorf("leabra.Network.SetWtsVars: %d layers for synapse variables, expected %d", len(vn.Layers), len(nw.Layers))
+	}
+	var err error
+	for li := range nw.Layers {
+		lw := &nw.Layers[li]
+		ly, er := nt.LayerByNameTry(lw.Layer)
+		if er != nil {
+			continue // already reported by SetWts
+		}
+		if er := ly.(LeabraLayer).AsLeabra().SetWtsVars(lw, &vn.Layers[li]); er != nil {
+			err = er
+		}
+	}
+	return err
+}
+
 // SetWts sets the weights for this network from weights.Network decoded values
 func (nt *NetworkStru) SetWts(nw *weights.Network) error {
 	var err error
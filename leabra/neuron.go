@@ -112,6 +112,22 @@ func (nrn *Neuron) VarByName(varNm string) (float32, error) {
 	return nrn.VarByIndex(i), nil
 }
 
+// SetVarByIndex sets variable using index (0 = first variable in NeuronVars list)
+func (nrn *Neuron) SetVarByIndex(idx int, val float32) {
+	fv := (*float32)(unsafe.Pointer(uintptr(unsafe.Pointer(nrn)) + uintptr(NeuronVarStart+4*idx)))
+	*fv = val
+}
+
+// SetVarByName sets variable by name, or error
+func (nrn *Neuron) SetVarByName(varNm string, val float32) error {
+	i, err := NeuronVarIdxByName(varNm)
+	if err != nil {
+		return err
+	}
+	nrn.SetVarByIndex(i, val)
+	return nil
+}
+
 func (nrn *Neuron) HasFlag(flag NeurFlags) bool {
 	return bitflag.Has32(int32(nrn.Flags), int(flag))
 }
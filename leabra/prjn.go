@@ -5,10 +5,14 @@
 package leabra
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
+	"math"
+	"math/rand"
 	"strconv"
 	"strings"
 
@@ -31,9 +35,19 @@ type Prjn struct {
 	Syns    []Synapse      `desc:"synaptic state values, ordered by the sending layer units which owns them -- one-to-one with SConIdx array"`
 
 	// misc state variables below:
-	GScale float32         `desc:"scaling factor for integrating synaptic input conductances (G's) -- computed in AlphaCycInit, incorporates running-average activity levels"`
-	GInc   []float32       `desc:"local per-recv unit increment accumulator for synaptic conductance from sending units -- goes to either GeRaw or GiRaw on neuron depending on projection type -- this will be thread-safe"`
-	WbRecv []WtBalRecvPrjn `desc:"weight balance state variables for this projection, one per recv neuron"`
+	GScale  float32         `desc:"scaling factor for integrating synaptic input conductances (G's) -- computed in AlphaCycInit, incorporates running-average activity levels"`
+	GInc    []float32       `desc:"local per-recv unit increment accumulator for synaptic conductance from sending units -- goes to either GeRaw or GiRaw on neuron depending on projection type -- this will be thread-safe"`
+	WbRecv  []WtBalRecvPrjn `desc:"weight balance state variables for this projection, one per recv neuron"`
+	Rnd     *rand.Rand      `view:"-" json:"-" desc:"random number stream for this projection, derived from the network seed and projection name (see Network.SetSeed) -- nil uses the global random number generator"`
+	WtsVars []WtsSynVar     `view:"-" json:"-" desc:"extra per-synapse variables registered by derived projection types (see AddWtsSynVar), which are saved and loaded in weight files along with Wt"`
+}
+
+// WtsSynVar is an extra per-synapse state variable that is saved and loaded
+// in weight files along with the Wt values, for derived projection types that
+// maintain additional learning state (e.g., eligibility traces).
+type WtsSynVar struct {
+	Name string                    `desc:"name of the variable -- used as the key for its values in each Rs entry of the weights file"`
+	Ptr  func(synIdx int) *float32 `desc:"returns a pointer to the variable for given synapse index, in the same (sender-based) order as Syns"`
 }
 
 var KiT_Prjn = kit.Types.AddType(&Prjn{}, PrjnProps)
@@ -183,6 +197,90 @@ func (pj *Prjn) SetSynVal(varNm string, sidx, ridx int, val float32) error {
 	return nil
 }
 
+// SynValsTensor fills in values of given synapse variable name into given
+// tensor, as a dense matrix of shape [recv, send] number of neurons
+// (flat 1D indexes for each layer), with NaN for missing synapses.
+// This is for efficient bulk access to the full set of synaptic values.
+func (pj *Prjn) SynValsTensor(tsr *etensor.Float32, varNm string) error {
+	vidx, err := pj.LeabraPrj.SynVarIdx(varNm)
+	if err != nil {
+		return err
+	}
+	rn := pj.Recv.Shape().Len()
+	sn := pj.Send.Shape().Len()
+	tsr.SetShape([]int{rn, sn}, nil, []string{"Recv", "Send"})
+	nan := math32.NaN()
+	for i := range tsr.Values {
+		tsr.Values[i] = nan
+	}
+	for ri := 0; ri < rn; ri++ {
+		nc := int(pj.RConN[ri])
+		st := int(pj.RConIdxSt[ri])
+		for ci := 0; ci < nc; ci++ {
+			si := int(pj.RConIdx[st+ci])
+			rsi := int(pj.RSynIdx[st+ci])
+			tsr.Values[ri*sn+si] = pj.LeabraPrj.SynVal1D(vidx, rsi)
+		}
+	}
+	return nil
+}
+
+// SetSynValsTensor sets values of given synapse variable name from given
+// tensor, organized as a dense matrix of shape [recv, send] number of neurons
+// as returned by SynValsTensor.  Values for missing synapses are ignored,
+// as are any NaN values.  Setting Wt also updates LWt.
+func (pj *Prjn) SetSynValsTensor(tsr etensor.Tensor, varNm string) error {
+	vidx, err := pj.LeabraPrj.SynVarIdx(varNm)
+	if err != nil {
+		return err
+	}
+	if vidx >= len(SynapseVars) {
+		return fmt.Errorf("leabra.SetSynValsTensor: variable: %s cannot be set", varNm)
+	}
+	rn := pj.Recv.Shape().Len()
+	sn := pj.Send.Shape().Len()
+	if tsr.Len() < rn*sn {
+		return fmt.Errorf("leabra.SetSynValsTensor: tensor size: %d is less than recv x send: %d", tsr.Len(), rn*sn)
+	}
+	isWt := varNm == "Wt"
+	for ri := 0; ri < rn; ri++ {
+		nc := int(pj.RConN[ri])
+		st := int(pj.RConIdxSt[ri])
+		for ci := 0; ci < nc; ci++ {
+			si := int(pj.RConIdx[st+ci])
+			val := tsr.FloatVal1D(ri*sn + si)
+			if math.IsNaN(val) {
+				continue
+			}
+			sy := &pj.Syns[pj.RSynIdx[st+ci]]
+			sy.SetVarByIndex(vidx, float32(val))
+			if isWt {
+				pj.Learn.LWtFmWt(sy)
+			}
+		}
+	}
+	return nil
+}
+
+// SynValsBuf fills in values of given synapse variable name into given
+// buffer, as a dense [recv, send] matrix (see SynValsTensor), with NaN
+// for missing synapses.  This is for efficient zero-copy bulk transfer
+// to Python (see pyet.prjn_to_numpy).
+func (pj *Prjn) SynValsBuf(buf *Float32Buf, varNm string) error {
+	tsr := &etensor.Float32{Values: buf.Vals}
+	err := pj.SynValsTensor(tsr, varNm)
+	buf.Vals = tsr.Values
+	return err
+}
+
+// SetSynValsBuf sets values of given synapse variable name from a dense
+// [recv, send] matrix in given buffer, as filled by SynValsBuf
+// (see pyet.numpy_to_prjn).
+func (pj *Prjn) SetSynValsBuf(buf *Float32Buf, varNm string) error {
+	tsr := etensor.NewFloat32Shape(etensor.NewShape([]int{len(buf.Vals)}, nil, nil), buf.Vals)
+	return pj.SetSynValsTensor(tsr, varNm)
+}
+
 ///////////////////////////////////////////////////////////////////////
 //  Weights File
 
@@ -243,7 +341,13 @@ func (pj *Prjn) WriteWtsJSON(w io.Writer, depth int) {
 				w.Write([]byte(", "))
 			}
 		}
-		w.Write([]byte("]\n"))
+		w.Write([]byte("]"))
+		for vi := range pj.WtsVars {
+			w.Write([]byte(",\n"))
+			w.Write(indent.TabBytes(depth))
+			pj.WriteWtsSynVarJSON(w, &pj.WtsVars[vi], st, nc)
+		}
+		w.Write([]byte("\n"))
 		depth--
 		w.Write(indent.TabBytes(depth))
 		if ri == nr-1 {
@@ -260,19 +364,142 @@ func (pj *Prjn) WriteWtsJSON(w io.Writer, depth int) {
 	w.Write([]byte("}")) // note: leave unterminated as outer loop needs to add , or just \n depending
 }
 
+// AddWtsSynVar registers an extra per-synapse variable to be saved and loaded
+// in weight files along with Wt.  ptr must return a pointer to the variable
+// for a given synapse index (same order as Syns).  Derived projection types
+// should call this in their Build method, after calling Build on the base Prjn.
+func (pj *Prjn) AddWtsSynVar(name string, ptr func(synIdx int) *float32) {
+	pj.WtsVars = append(pj.WtsVars, WtsSynVar{Name: name, Ptr: ptr})
+}
+
+// WriteWtsSynVarJSON writes the values of given extra synaptic variable for
+// the nc synapses of one receiving unit starting at RConIdxSt st, as an array
+// in its Rs entry, in the same order as the Si and Wt values.
+func (pj *Prjn) WriteWtsSynVarJSON(w io.Writer, sv *WtsSynVar, st, nc int) {
+	w.Write([]byte(fmt.Sprintf("%q: [ ", sv.Name)))
+	for ci := 0; ci < nc; ci++ {
+		rsi := int(pj.RSynIdx[st+ci])
+		w.Write([]byte(strconv.FormatFloat(float64(*sv.Ptr(rsi)), 'g', weights.Prec, 32)))
+		if ci == nc-1 {
+			w.Write([]byte(" "))
+		} else {
+			w.Write([]byte(", "))
+		}
+	}
+	w.Write([]byte("]"))
+}
+
+// WtsVarsRecv holds the values of the extra synaptic variables registered with
+// AddWtsSynVar for one receiving unit, decoded from its Rs entry in a weights
+// file, by variable name -- weights.Recv only holds the Si and Wt values,
+// so these are decoded in parallel with it by the ReadWtsJSON methods.
+type WtsVarsRecv map[string][]float32
+
+// UnmarshalJSON decodes the arrays of values in an Rs entry, other than Si and Wt.
+func (rv *WtsVarsRecv) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*rv = make(WtsVarsRecv)
+	for k, r := range raw {
+		if k == "Si" || k == "Wt" {
+			continue
+		}
+		var vals []float32
+		if json.Unmarshal(r, &vals) == nil {
+			(*rv)[k] = vals
+		}
+	}
+	return nil
+}
+
+// WtsVarsPrjn holds the WtsVarsRecv for each receiving unit in a projection,
+// in parallel with the weights.Prjn Rs.
+type WtsVarsPrjn struct {
+	From string
+	Rs   []WtsVarsRecv
+}
+
+// WtsVarsLayer holds the WtsVarsPrjn for each projection in a layer,
+// in parallel with the weights.Layer Prjns.
+type WtsVarsLayer struct {
+	Layer string
+	Prjns []WtsVarsPrjn
+}
+
+// WtsVarsNet holds the WtsVarsLayer for each layer in a network,
+// in parallel with the weights.Network Layers.
+type WtsVarsNet struct {
+	Layers []WtsVarsLayer
+}
+
+// SetWtsVars sets the values of the extra synaptic variables registered with
+// AddWtsSynVar from given decoded values, using the Ri and Si indexes of the
+// parallel weights.Prjn Rs entries to locate each synapse.  Variables that are
+// not present in the weights file are left unchanged.
+func (pj *Prjn) SetWtsVars(pw *weights.Prjn, vp *WtsVarsPrjn) error {
+	if len(pj.WtsVars) == 0 {
+		return nil
+	}
+	if len(vp.Rs) != len(pw.Rs) {
+		return fmt.Errorf("leabra.Prjn.SetWtsVars: %s: %d Rs entries for synapse variables, expected %d", pj.Name(), len(vp.Rs), len(pw.Rs))
+	}
+	var err error
+	for vi := range pj.WtsVars {
+		sv := &pj.WtsVars[vi]
+		for i := range pw.Rs {
+			pr := &pw.Rs[i]
+			vals, ok := vp.Rs[i][sv.Name]
+			if !ok {
+				continue
+			}
+			if len(vals) != len(pr.Si) {
+				err = fmt.Errorf("leabra.Prjn.SetWtsVars: %s: synapse variable: %s has %d values for recv unit: %d, expected %d", pj.Name(), sv.Name, len(vals), pr.Ri, len(pr.Si))
+				continue
+			}
+			for si := range pr.Si {
+				synIdx := pj.SynIdx(pr.Si[si], pr.Ri)
+				if synIdx < 0 {
+					continue
+				}
+				*sv.Ptr(synIdx) = vals[si]
+			}
+		}
+	}
+	return err
+}
+
 // ReadWtsJSON reads the weights from this projection from the receiver-side perspective
 // in a JSON text format.  This is for a set of weights that were saved *for one prjn only*
 // and is not used for the network-level ReadWtsJSON, which reads into a separate
 // structure -- see SetWts method.
+// Any extra synaptic variables registered with AddWtsSynVar are also read.
 func (pj *Prjn) ReadWtsJSON(r io.Reader) error {
-	pw, err := weights.PrjnReadJSON(r)
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	pw, err := weights.PrjnReadJSON(bytes.NewReader(b))
 	if err != nil {
 		return err // note: already logged
 	}
-	return pj.SetWts(pw)
+	err = pj.SetWts(pw)
+	if len(pj.WtsVars) == 0 {
+		return err
+	}
+	vp := &WtsVarsPrjn{}
+	if er := json.Unmarshal(b, vp); er != nil {
+		return er
+	}
+	if er := pj.SetWtsVars(pw, vp); er != nil {
+		err = er
+	}
+	return err
 }
 
 // SetWts sets the weights for this projection from weights.Prjn decoded values
+// -- see SetWtsVars for any extra synaptic variables registered with AddWtsSynVar.
 func (pj *Prjn) SetWts(pw *weights.Prjn) error {
 	if pw.MetaData != nil {
 		if gs, ok := pw.MetaData["GScale"]; ok {
@@ -300,6 +527,7 @@ func (pj *Prjn) Build() error {
 		return err
 	}
 	pj.Syns = make([]Synapse, len(pj.SConIdx))
+	pj.WtsVars = nil
 	rsh := pj.Recv.Shape()
 	//	ssh := pj.Send.Shape()
 	rlen := rsh.Len()
@@ -394,10 +622,17 @@ func (pj *Prjn) SetScalesFunc(scaleFun func(si, ri int, send, recv *etensor.Shap
 // for an individual synapse.
 // It also updates the linear weight value based on the sigmoidal weight value.
 func (pj *Prjn) InitWtsSyn(syn *Synapse) {
+	pj.InitWtsSynVal(syn, float32(pj.WtInit.GenRand(pj.Rnd)))
+}
+
+// InitWtsSynVal initializes weight value for an individual synapse to given
+// value (prior to Scale), clipped to the 0-1 range.
+// It also updates the linear weight value based on the sigmoidal weight value.
+func (pj *Prjn) InitWtsSynVal(syn *Synapse, wt float32) {
 	if syn.Scale == 0 {
 		syn.Scale = 1
 	}
-	syn.Wt = float32(pj.WtInit.Gen(-1))
+	syn.Wt = wt
 	// enforce normalized weight range -- required for most uses and if not
 	// then a new type of prjn should be used:
 	if syn.Wt < 0 {
@@ -413,12 +648,10 @@ func (pj *Prjn) InitWtsSyn(syn *Synapse) {
 	syn.Moment = 0
 }
 
-// InitWts initializes weight values according to Learn.WtInit params
+// InitWts initializes weight values according to WtInit params,
+// using the WtInit.Strat strategy.
 func (pj *Prjn) InitWts() {
-	for si := range pj.Syns {
-		sy := &pj.Syns[si]
-		pj.InitWtsSyn(sy)
-	}
+	pj.InitWtsStrat()
 	for wi := range pj.WbRecv {
 		wb := &pj.WbRecv[wi]
 		wb.Init()
@@ -677,6 +910,17 @@ func (pj *Prjn) WtFail() {
 	}
 }
 
+// InitRnd initializes the random number stream for this projection,
+// derived from given network seed and projection name if streams is true --
+// otherwise it uses the global random number generator.
+func (pj *Prjn) InitRnd(seed int64, streams bool) {
+	pj.Rnd = nil
+	if streams {
+		pj.Rnd = NewRndStream(seed, pj.Name())
+	}
+	pj.Learn.WtSig.Rnd = pj.Rnd
+}
+
 // LrateMult sets the new Lrate parameter for Prjns to LrateInit * mult.
 // Useful for implementing learning rate schedules.
 func (pj *Prjn) LrateMult(mult float32) {
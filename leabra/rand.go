@@ -0,0 +1,150 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package leabra
+
+import (
+	"hash/fnv"
+	"math"
+	"math/rand"
+
+	"github.com/emer/emergent/erand"
+)
+
+///////////////////////////////////////////////////////////////////////
+//  rand.go contains support for per-layer and per-projection random
+//  number streams.  If a stream is nil, the global math/rand
+//  generator is used, which is the default (see Network.SetSeed).
+
+// RndSeedFmName returns the seed for the random number stream of an object
+// (layer or projection) with given name, derived from given network seed.
+// Using a hash of the name makes each stream independent of the number
+// and order of the other objects in the network.
+func RndSeedFmName(seed int64, name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return seed ^ int64(h.Sum64())
+}
+
+// NewRndStream returns a new random number stream for an object with given
+// name, derived from given network seed.
+func NewRndStream(seed int64, name string) *rand.Rand {
+	return rand.New(rand.NewSource(RndSeedFmName(seed, name)))
+}
+
+// RndGen generates a random value according to given parameters, using
+// given random number stream, or the global generator if nil.
+// All of the erand distributions are generated here from the stream,
+// so that they are all reproducible from the stream seed.
+func RndGen(rp *erand.RndParams, rnd *rand.Rand) float64 {
+	switch rp.Dist {
+	case erand.Uniform:
+		return rp.Mean + rp.Var*2.0*(RndFloat64(rnd)-0.5)
+	case erand.Binomial:
+		return rp.Mean + RndBinom(int(rp.Par), rp.Var, rnd)
+	case erand.Poisson:
+		return rp.Mean + RndPoiss(rp.Var, rnd)
+	case erand.Gamma:
+		return rp.Mean + RndGamma(rp.Par, rp.Var, rnd)
+	case erand.Gaussian:
+		return rp.Mean + rp.Var*RndNormFloat64(rnd)
+	case erand.Beta:
+		return rp.Mean + RndBeta(rp.Var, rp.Par, rnd)
+	}
+	return rp.Mean
+}
+
+// RndBinom returns the number of successes in n trials of probability p,
+// from given random number stream, or the global generator if nil.
+func RndBinom(n int, p float64, rnd *rand.Rand) float64 {
+	k := 0
+	for i := 0; i < n; i++ {
+		if RndFloat64(rnd) < p {
+			k++
+		}
+	}
+	return float64(k)
+}
+
+// RndPoiss returns the number of events in an interval with event rate
+// lambda, from given random number stream, or the global generator if nil.
+// Uses Knuth's method up to lambda = 30, and the normal approximation above.
+func RndPoiss(lambda float64, rnd *rand.Rand) float64 {
+	if lambda <= 0 {
+		return 0
+	}
+	if lambda > 30 {
+		return math.Max(0, math.Floor(lambda+math.Sqrt(lambda)*RndNormFloat64(rnd)+0.5))
+	}
+	l := math.Exp(-lambda)
+	k := 0
+	for p := RndFloat64(rnd); p > l; p *= RndFloat64(rnd) {
+		k++
+	}
+	return float64(k)
+}
+
+// RndGamma returns a gamma distributed value with given shape k and scale,
+// from given random number stream, or the global generator if nil.
+// Uses the Marsaglia and Tsang (2000) method.
+func RndGamma(k, scale float64, rnd *rand.Rand) float64 {
+	if k <= 0 || scale <= 0 {
+		return 0
+	}
+	if k < 1 { // boost: gamma(k) = gamma(k+1) * u^(1/k)
+		return RndGamma(k+1, scale, rnd) * math.Pow(RndFloat64(rnd), 1/k)
+	}
+	d := k - 1.0/3.0
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := RndNormFloat64(rnd)
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+		v = v * v * v
+		u := RndFloat64(rnd)
+		if math.Log(u) < 0.5*x*x+d-d*v+d*math.Log(v) {
+			return scale * d * v
+		}
+	}
+}
+
+// RndBeta returns a beta distributed value with shape parameters a and b,
+// from given random number stream, or the global generator if nil.
+func RndBeta(a, b float64, rnd *rand.Rand) float64 {
+	x := RndGamma(a, 1, rnd)
+	y := RndGamma(b, 1, rnd)
+	if x+y == 0 {
+		return 0
+	}
+	return x / (x + y)
+}
+
+// RndFloat64 returns a uniform random number in [0,1) from given
+// random number stream, or the global generator if nil.
+func RndFloat64(rnd *rand.Rand) float64 {
+	if rnd == nil {
+		return rand.Float64()
+	}
+	return rnd.Float64()
+}
+
+// RndNormFloat64 returns a normally-distributed random number (mean 0, sd 1)
+// from given random number stream, or the global generator if nil.
+func RndNormFloat64(rnd *rand.Rand) float64 {
+	if rnd == nil {
+		return rand.NormFloat64()
+	}
+	return rnd.NormFloat64()
+}
+
+// RndPerm returns a random permutation of the integers [0,n) from given
+// random number stream, or the global generator if nil.
+func RndPerm(n int, rnd *rand.Rand) []int {
+	if rnd == nil {
+		return rand.Perm(n)
+	}
+	return rnd.Perm(n)
+}
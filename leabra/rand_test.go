@@ -0,0 +1,117 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package leabra
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/erand"
+	"github.com/emer/emergent/prjn"
+	"github.com/emer/etable/etensor"
+)
+
+func newRndNet(t *testing.T, extra bool, seed int64) (*Network, *Prjn) {
+	net := &Network{}
+	net.InitName(net, "RndNet")
+	inLay := net.AddLayer2D("Input", 4, 5, emer.Input)
+	if extra {
+		xLay := net.AddLayer2D("Extra", 3, 3, emer.Hidden)
+		net.ConnectLayers(inLay, xLay, prjn.NewFull(), emer.Forward)
+	}
+	hidLay := net.AddLayer2D("Hidden", 3, 4, emer.Hidden)
+	inHid := net.ConnectLayers(inLay, hidLay, prjn.NewFull(), emer.Forward).(*Prjn)
+	net.Defaults()
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.SetSeed(seed)
+	net.InitWts()
+	return net, inHid
+}
+
+func TestRndStreams(t *testing.T) {
+	_, p1 := newRndNet(t, false, 10)
+	w1 := &etensor.Float32{}
+	p1.SynValsTensor(w1, "Wt")
+
+	// adding a layer + prjn should not affect this prjn's weights
+	net, p2 := newRndNet(t, true, 10)
+	w2 := &etensor.Float32{}
+	p2.SynValsTensor(w2, "Wt")
+	CmprFloats(w2.Values, w1.Values, "streams independent of other layers", t)
+
+	// a different seed gives different weights
+	_, p3 := newRndNet(t, false, 11)
+	w3 := &etensor.Float32{}
+	p3.SynValsTensor(w3, "Wt")
+	if w3.Values[0] == w1.Values[0] && w3.Values[1] == w1.Values[1] {
+		t.Errorf("different seeds should give different weights")
+	}
+
+	// lesions are reproducible from the layer stream
+	hid := net.LayerByName("Hidden").(LeabraLayer).AsLeabra()
+	net.SetSeed(10)
+	hid.LesionNeurons(0.5)
+	les1 := make([]bool, len(hid.Neurons))
+	for ni := range hid.Neurons {
+		les1[ni] = hid.Neurons[ni].IsOff()
+	}
+	hid.UnLesionNeurons()
+	net.SetSeed(10)
+	hid.LesionNeurons(0.5)
+	for ni := range hid.Neurons {
+		if hid.Neurons[ni].IsOff() != les1[ni] {
+			t.Errorf("lesion not reproducible at neuron: %d", ni)
+		}
+	}
+
+	net.SetGlobalRnd()
+	if hid.Rnd != nil || p2.Rnd != nil || hid.Act.Noise.Rnd != nil {
+		t.Errorf("SetGlobalRnd should clear the streams")
+	}
+}
+
+func TestRndGenDists(t *testing.T) {
+	dists := []struct {
+		rp   erand.RndParams
+		mean float64
+	}{
+		{erand.RndParams{Dist: erand.Uniform, Mean: 0.5, Var: 0.25}, 0.5},
+		{erand.RndParams{Dist: erand.Binomial, Mean: 0.1, Var: 0.3, Par: 10}, 3.1},
+		{erand.RndParams{Dist: erand.Poisson, Mean: 0.1, Var: 4}, 4.1},
+		{erand.RndParams{Dist: erand.Poisson, Var: 50}, 50},
+		{erand.RndParams{Dist: erand.Gamma, Var: 0.5, Par: 3}, 1.5},
+		{erand.RndParams{Dist: erand.Gamma, Var: 2, Par: 0.5}, 1},
+		{erand.RndParams{Dist: erand.Gaussian, Mean: 0.5, Var: 0.1}, 0.5},
+		{erand.RndParams{Dist: erand.Beta, Mean: 0.1, Var: 2, Par: 6}, 0.35},
+		{erand.RndParams{Dist: erand.Mean, Mean: 0.3, Var: 2}, 0.3},
+	}
+	n := 5000
+	for _, ds := range dists {
+		rand.Seed(1)
+		glob := rand.Int63()
+		rand.Seed(1)
+		r1 := rand.New(rand.NewSource(5))
+		r2 := rand.New(rand.NewSource(5))
+		sum := 0.0
+		for i := 0; i < n; i++ {
+			v1 := RndGen(&ds.rp, r1)
+			if v2 := RndGen(&ds.rp, r2); v1 != v2 {
+				t.Errorf("%v: not reproducible from stream: %g != %g", ds.rp.Dist, v1, v2)
+				break
+			}
+			sum += v1
+		}
+		if rand.Int63() != glob {
+			t.Errorf("%v: used the global generator", ds.rp.Dist)
+		}
+		if mn := sum / float64(n); math.Abs(mn-ds.mean) > 0.05*math.Max(1, ds.mean) {
+			t.Errorf("%v: mean %g != %g", ds.rp.Dist, mn, ds.mean)
+		}
+	}
+}
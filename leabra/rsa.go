@@ -0,0 +1,268 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package leabra
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/emer/emergent/emer"
+	"github.com/emer/etable/clust"
+	"github.com/emer/etable/etable"
+	"github.com/emer/etable/etensor"
+	"github.com/emer/etable/metric"
+	"github.com/emer/etable/simat"
+)
+
+// RSA performs representational similarity analysis on the activation
+// patterns of selected layers.  Activations are recorded per item
+// (e.g., each trial of a testing epoch) into the Acts table, and
+// SimMatsFmActs then computes the item x item similarity matrix for
+// each layer.  These similarity matrices can be compared across layers
+// (CompareLayers) or saved as named checkpoints (e.g., per epoch) and
+// compared across training time (CompareCheckpts).
+// All results are simat.SimMat matrices, with etensor.Float64 values
+// and row / column labels, suitable for etview.SimMatGrid display,
+// and ClustPlot generates a cluster plot table suitable for eplot.
+type RSA struct {
+	Layers   []string                            `desc:"names of layers to record activations from"`
+	Var      string                              `desc:"neuron variable to record -- typically ActM (minus phase) or ActP (plus phase)"`
+	Metric   metric.StdMetrics                   `desc:"similarity metric for computing the item x item similarity matrices -- typically Correlation or Cosine"`
+	CmpMet   metric.StdMetrics                   `desc:"metric used for comparing similarity matrices with each other (second-order similarity) -- typically Correlation"`
+	ClustDst clust.StdDists                      `desc:"cluster distance function for ClustPlot"`
+	Acts     *etable.Table                       `view:"no-inline" desc:"recorded activation patterns, one row per item, with an Item label column and one column per layer"`
+	SimMats  map[string]*simat.SimMat            `view:"-" desc:"current similarity matrices per layer, computed by SimMatsFmActs"`
+	Checkpts map[string]map[string]*simat.SimMat `view:"-" desc:"saved similarity matrices, by checkpoint name and then layer name"`
+	CkNames  []string                            `desc:"names of saved checkpoints, in order saved"`
+	Tsr      etensor.Float32                     `view:"-" desc:"temporary tensor for reading values"`
+}
+
+func (rs *RSA) Defaults() {
+	rs.Var = "ActM"
+	rs.Metric = metric.Correlation
+	rs.CmpMet = metric.Correlation
+	rs.ClustDst = clust.Contrast
+}
+
+// Init configures the RSA to record from given layers in network,
+// setting up the Acts table according to the layer shapes.
+// Calls Defaults if Var has not been set.
+func (rs *RSA) Init(net emer.Network, lays []string) error {
+	if rs.Var == "" {
+		rs.Defaults()
+	}
+	rs.Layers = lays
+	sch := etable.Schema{
+		{"Item", etensor.STRING, nil, nil},
+	}
+	var lasterr error
+	for _, lnm := range lays {
+		ly, err := net.LayerByNameTry(lnm)
+		if err != nil {
+			lasterr = err
+			continue
+		}
+		sch = append(sch, etable.Column{lnm, etensor.FLOAT32, ly.Shape().Shp, nil})
+	}
+	if rs.Acts == nil {
+		rs.Acts = &etable.Table{}
+	}
+	rs.Acts.SetFromSchema(sch, 0)
+	rs.Acts.SetMetaData("name", "RSAActs")
+	rs.SimMats = make(map[string]*simat.SimMat)
+	rs.Checkpts = make(map[string]map[string]*simat.SimMat)
+	rs.CkNames = nil
+	return lasterr
+}
+
+// Reset clears the recorded activations -- call at start of each
+// testing epoch.  Saved checkpoints are not affected.
+func (rs *RSA) Reset() {
+	if rs.Acts == nil {
+		return
+	}
+	rs.Acts.SetNumRows(0)
+}
+
+// Record adds a new row to the Acts table with the current values of
+// Var for each of the layers, labeled with given item name.
+// Typically called at the end of each trial in a testing epoch.
+func (rs *RSA) Record(net emer.Network, item string) error {
+	if rs.Acts == nil {
+		err := fmt.Errorf("leabra.RSA: Init must be called before Record")
+		log.Println(err)
+		return err
+	}
+	row := rs.Acts.Rows
+	rs.Acts.SetNumRows(row + 1)
+	rs.Acts.SetCellString("Item", row, item)
+	var lasterr error
+	for _, lnm := range rs.Layers {
+		ly, err := net.LayerByNameTry(lnm)
+		if err != nil {
+			lasterr = err
+			continue
+		}
+		err = ly.UnitValsTensor(&rs.Tsr, rs.Var)
+		if err != nil {
+			lasterr = err
+			continue
+		}
+		rs.Acts.SetCellTensor(lnm, row, &rs.Tsr)
+	}
+	return lasterr
+}
+
+// SimMatsFmActs computes the item x item similarity matrix for each layer
+// from the recorded activations, using Metric.
+func (rs *RSA) SimMatsFmActs() error {
+	if rs.SimMats == nil {
+		rs.SimMats = make(map[string]*simat.SimMat)
+	}
+	ix := etable.NewIdxView(rs.Acts)
+	var lasterr error
+	for _, lnm := range rs.Layers {
+		sm := &simat.SimMat{}
+		err := sm.TableColStd(ix, lnm, "Item", false, rs.Metric)
+		if err != nil {
+			lasterr = err
+			continue
+		}
+		rs.SimMats[lnm] = sm
+	}
+	return lasterr
+}
+
+// SimMat returns the current similarity matrix for given layer,
+// as computed by the last SimMatsFmActs call.
+func (rs *RSA) SimMat(lay string) (*simat.SimMat, error) {
+	sm, ok := rs.SimMats[lay]
+	if !ok {
+		return nil, fmt.Errorf("leabra.RSA: SimMat for layer: %s not found -- must call SimMatsFmActs", lay)
+	}
+	return sm, nil
+}
+
+// Checkpt saves a copy of the current similarity matrices under given
+// name (e.g., "Epoch 10"), for later comparison using CompareCheckpts.
+// An existing checkpoint of the same name is replaced.
+func (rs *RSA) Checkpt(name string) {
+	if rs.Checkpts == nil {
+		rs.Checkpts = make(map[string]map[string]*simat.SimMat)
+	}
+	if _, has := rs.Checkpts[name]; !has {
+		rs.CkNames = append(rs.CkNames, name)
+	}
+	cm := make(map[string]*simat.SimMat, len(rs.SimMats))
+	for lnm, sm := range rs.SimMats {
+		csm := &simat.SimMat{Mat: sm.Mat.Clone()}
+		csm.Rows = append([]string(nil), sm.Rows...)
+		csm.Cols = append([]string(nil), sm.Cols...)
+		cm[lnm] = csm
+	}
+	rs.Checkpts[name] = cm
+}
+
+// CompareLayers returns the second-order similarity among the current
+// similarity matrices for all of the layers, as a layer x layer SimMat,
+// using CmpMet.
+func (rs *RSA) CompareLayers() *simat.SimMat {
+	sms := make([]*simat.SimMat, len(rs.Layers))
+	for li, lnm := range rs.Layers {
+		sms[li] = rs.SimMats[lnm]
+	}
+	return CompareSimMats(sms, rs.Layers, rs.CmpMet)
+}
+
+// CompareCheckpts returns the second-order similarity among the saved
+// checkpoint similarity matrices for given layer, as a checkpoint x
+// checkpoint SimMat, using CmpMet.
+func (rs *RSA) CompareCheckpts(lay string) *simat.SimMat {
+	sms := make([]*simat.SimMat, len(rs.CkNames))
+	for ci, cnm := range rs.CkNames {
+		sms[ci] = rs.Checkpts[cnm][lay]
+	}
+	return CompareSimMats(sms, rs.CkNames, rs.CmpMet)
+}
+
+// ClustPlot configures given table with a cluster plot of the item
+// activation patterns for given layer, using ClustDst to cluster.
+// Clustering requires a distance metric, so Correlation and Cosine
+// are automatically converted to their Inv versions.
+func (rs *RSA) ClustPlot(pt *etable.Table, lay string) error {
+	dmet := rs.Metric
+	switch dmet {
+	case metric.Correlation:
+		dmet = metric.InvCorrelation
+	case metric.Cosine:
+		dmet = metric.InvCosine
+	}
+	if !metric.Increasing(dmet) {
+		return fmt.Errorf("leabra.RSA: ClustPlot cannot convert Metric: %v into a distance metric", rs.Metric)
+	}
+	ix := etable.NewIdxView(rs.Acts)
+	sm := &simat.SimMat{}
+	err := sm.TableColStd(ix, lay, "Item", false, dmet)
+	if err != nil {
+		return err
+	}
+	clust.Plot(pt, clust.GlomStd(sm, rs.ClustDst), sm)
+	pt.SetMetaData("name", lay+"Clust")
+	pt.SetMetaData("XAxisCol", "X")
+	pt.SetMetaData("Points", "true")
+	return nil
+}
+
+// CompareSimMats returns the second-order similarity between each pair
+// of given similarity matrices, computed using given metric over the
+// off-diagonal (lower triangle) values of each matrix.
+// labels are used for the rows and columns of the resulting SimMat.
+// nil or mismatched-size matrices result in NaN values.
+func CompareSimMats(sms []*simat.SimMat, labels []string, met metric.StdMetrics) *simat.SimMat {
+	n := len(sms)
+	res := &simat.SimMat{}
+	res.Init()
+	res.Mat.SetShape([]int{n, n}, nil, nil)
+	res.Rows = append([]string(nil), labels...)
+	res.Cols = append([]string(nil), labels...)
+	mfun := metric.StdFunc64(met)
+	tris := make([][]float64, n)
+	for i, sm := range sms {
+		tris[i] = SimMatLowerTri(sm)
+	}
+	for ai := 0; ai < n; ai++ {
+		for bi := 0; bi < n; bi++ {
+			av := tris[ai]
+			bv := tris[bi]
+			sv := math.NaN()
+			if av != nil && bv != nil && len(av) == len(bv) {
+				sv = mfun(av, bv)
+			}
+			res.Mat.SetFloat([]int{ai, bi}, sv)
+		}
+	}
+	return res
+}
+
+// SimMatLowerTri returns the values in the lower triangle (below the
+// diagonal) of given similarity matrix, which are the unique values
+// for a symmetric matrix.  Returns nil if sm is nil or empty.
+func SimMatLowerTri(sm *simat.SimMat) []float64 {
+	if sm == nil || sm.Mat == nil || sm.Mat.NumDims() != 2 {
+		return nil
+	}
+	n := sm.Mat.Dim(0)
+	if n < 2 {
+		return nil
+	}
+	vals := make([]float64, 0, n*(n-1)/2)
+	for ai := 1; ai < n; ai++ {
+		for bi := 0; bi < ai; bi++ {
+			vals = append(vals, sm.Mat.FloatVal([]int{ai, bi}))
+		}
+	}
+	return vals
+}
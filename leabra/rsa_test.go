@@ -0,0 +1,129 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package leabra
+
+import (
+	"math"
+	"testing"
+
+	"github.com/emer/emergent/emer"
+	"github.com/emer/etable/etable"
+	"github.com/emer/etable/metric"
+	"github.com/emer/etable/simat"
+)
+
+// rsaPats are the ActM patterns for 3 items in each layer:
+// A has items 0 and 2 the same, B has items 0 and 1 the same, C = A
+var rsaPats = map[string][][]float32{
+	"A": {{1, 1, 0, 0}, {0, 0, 1, 1}, {1, 1, 0, 0}},
+	"B": {{1, 0, 1, 0}, {1, 0, 1, 0}, {0, 1, 0, 1}},
+	"C": {{1, 1, 0, 0}, {0, 0, 1, 1}, {1, 1, 0, 0}},
+}
+
+func newRSANet(t *testing.T) *Network {
+	net := &Network{}
+	net.InitName(net, "RSANet")
+	for _, lnm := range []string{"A", "B", "C"} {
+		net.AddLayer2D(lnm, 1, 4, emer.Hidden)
+	}
+	net.Defaults()
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	return net
+}
+
+// recordRSA records the rsaPats for each item
+func recordRSA(t *testing.T, net *Network, rs *RSA) {
+	items := []string{"i0", "i1", "i2"}
+	rs.Reset()
+	for ii, item := range items {
+		for lnm, pats := range rsaPats {
+			ly := net.LayerByName(lnm).(LeabraLayer).AsLeabra()
+			for ni := range ly.Neurons {
+				ly.Neurons[ni].ActM = pats[ii][ni]
+			}
+		}
+		if err := rs.Record(net, item); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// simMatVals returns the values of the SimMat as float32
+func simMatVals(sm *simat.SimMat) []float32 {
+	vals := make([]float32, sm.Mat.Len())
+	for i := range vals {
+		vals[i] = float32(sm.Mat.FloatVal1D(i))
+	}
+	return vals
+}
+
+func TestRSA(t *testing.T) {
+	net := newRSANet(t)
+	rs := &RSA{}
+	if err := rs.Init(net, []string{"A", "B", "C"}); err != nil {
+		t.Fatal(err)
+	}
+	recordRSA(t, net, rs)
+	if rs.Acts.Rows != 3 {
+		t.Fatalf("Acts rows: %d != 3", rs.Acts.Rows)
+	}
+	if err := rs.SimMatsFmActs(); err != nil {
+		t.Fatal(err)
+	}
+	sma, err := rs.SimMat("A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sma.Rows[2] != "i2" || sma.Cols[1] != "i1" {
+		t.Errorf("SimMat labels: rows %v cols %v", sma.Rows, sma.Cols)
+	}
+	CmprFloats(simMatVals(sma), []float32{1, -1, 1, -1, 1, -1, 1, -1, 1}, "SimMat A correlation", t)
+	smb, _ := rs.SimMat("B")
+	CmprFloats(simMatVals(smb), []float32{1, 1, -1, 1, 1, -1, -1, -1, 1}, "SimMat B correlation", t)
+	if _, err := rs.SimMat("D"); err == nil {
+		t.Errorf("SimMat did not fail on missing layer D")
+	}
+
+	CmprFloats(toFloat32s(SimMatLowerTri(sma)), []float32{-1, 1, -1}, "SimMatLowerTri A", t)
+	// A and B lower triangles are [-1 1 -1] and [1 -1 -1]: correlation -.5
+	lc := rs.CompareLayers()
+	CmprFloats(simMatVals(lc), []float32{1, -0.5, 1, -0.5, 1, -0.5, 1, -0.5, 1}, "CompareLayers", t)
+
+	rs.Checkpt("first")
+	rsaPats["C"] = rsaPats["B"] // C now has B structure, A is unchanged
+	defer func() { rsaPats["C"] = rsaPats["A"] }()
+	recordRSA(t, net, rs)
+	rs.SimMatsFmActs()
+	rs.Checkpt("second")
+	CmprFloats(simMatVals(rs.CompareCheckpts("A")), []float32{1, 1, 1, 1}, "CompareCheckpts A", t)
+	CmprFloats(simMatVals(rs.CompareCheckpts("C")), []float32{1, -0.5, -0.5, 1}, "CompareCheckpts C", t)
+	if len(rs.CkNames) != 2 {
+		t.Errorf("CkNames: %v", rs.CkNames)
+	}
+
+	// mismatched sizes are NaN
+	cs := CompareSimMats([]*simat.SimMat{sma, nil}, []string{"a", "nil"}, metric.Correlation)
+	if !math.IsNaN(cs.Mat.FloatVal([]int{0, 1})) {
+		t.Errorf("CompareSimMats with nil: %g != NaN", cs.Mat.FloatVal([]int{0, 1}))
+	}
+
+	pt := &etable.Table{}
+	if err := rs.ClustPlot(pt, "A"); err != nil {
+		t.Fatal(err)
+	}
+	if pt.Rows == 0 {
+		t.Errorf("ClustPlot table is empty")
+	}
+}
+
+func toFloat32s(vals []float64) []float32 {
+	fv := make([]float32, len(vals))
+	for i, v := range vals {
+		fv[i] = float32(v)
+	}
+	return fv
+}
@@ -0,0 +1,268 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package leabra
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/chewxy/math32"
+	"github.com/emer/etable/etensor"
+)
+
+///////////////////////////////////////////////////////////////////////
+//  wtinit.go contains the weight initialization strategies for Prjn
+
+// Rand returns a new random number generator seeded with Seed if it is
+// non-zero, for initializing the weights reproducibly -- otherwise returns
+// nil, which means the global random number generator is used.
+func (wp *WtInitParams) Rand() *rand.Rand {
+	if wp.Seed == 0 {
+		return nil
+	}
+	return rand.New(rand.NewSource(wp.Seed))
+}
+
+// GenRand generates a random value from the distribution, using given
+// random number generator, or the global one if nil.
+func (wp *WtInitParams) GenRand(rnd *rand.Rand) float64 {
+	return RndGen(&wp.RndParams, rnd)
+}
+
+// InitWtsStrat initializes the weights according to the WtInit.Strat strategy.
+// Random numbers come from the WtInit.Seed generator if set, otherwise
+// from the projection's Rnd stream (see Network.SetSeed).
+// CopyWts is initialized as RndWts here -- the copy is done by InitWtsCopy,
+// which is called by Network.InitWts after all weights have been initialized.
+func (pj *Prjn) InitWtsStrat() {
+	rnd := pj.WtInit.Rand()
+	if rnd == nil {
+		rnd = pj.Rnd
+	}
+	switch pj.WtInit.Strat {
+	case FanInWts:
+		pj.InitWtsFanIn(rnd)
+	case SparseWts:
+		pj.InitWtsSparse(rnd)
+	case TopoWts:
+		pj.InitWtsTopo()
+	case OrthoWts:
+		pj.InitWtsOrtho(rnd)
+	default:
+		for si := range pj.Syns {
+			sy := &pj.Syns[si]
+			pj.InitWtsSynVal(sy, float32(pj.WtInit.GenRand(rnd)))
+		}
+	}
+}
+
+// InitWtsFanIn initializes weights using the FanInWts strategy:
+// random values with the deviation from Mean scaled by 1 / sqrt(fan-in).
+func (pj *Prjn) InitWtsFanIn(rnd *rand.Rand) {
+	rn := len(pj.RConN)
+	for ri := 0; ri < rn; ri++ {
+		nc := int(pj.RConN[ri])
+		if nc == 0 {
+			continue
+		}
+		st := int(pj.RConIdxSt[ri])
+		sc := 1 / math.Sqrt(float64(nc))
+		for ci := 0; ci < nc; ci++ {
+			sy := &pj.Syns[pj.RSynIdx[st+ci]]
+			wt := pj.WtInit.Mean + sc*(pj.WtInit.GenRand(rnd)-pj.WtInit.Mean)
+			pj.InitWtsSynVal(sy, float32(wt))
+		}
+	}
+}
+
+// InitWtsSparse initializes weights using the SparseWts strategy:
+// NNonZero randomly-selected random weights per receiving unit, others 0.
+func (pj *Prjn) InitWtsSparse(rnd *rand.Rand) {
+	rn := len(pj.RConN)
+	for ri := 0; ri < rn; ri++ {
+		nc := int(pj.RConN[ri])
+		st := int(pj.RConIdxSt[ri])
+		perm := RndPerm(nc, rnd)
+		for pi, ci := range perm {
+			sy := &pj.Syns[pj.RSynIdx[st+ci]]
+			if pi < pj.WtInit.NNonZero {
+				pj.InitWtsSynVal(sy, float32(pj.WtInit.GenRand(rnd)))
+			} else {
+				pj.InitWtsSynVal(sy, 0)
+			}
+		}
+	}
+}
+
+// TopoPos returns the normalized (0-1) Y, X position of given unit index
+// within a layer of given shape -- for 4D layers, this is the position
+// of the unit's pool.  Positions along a dimension of size 1 are 0.5.
+func TopoPos(shp *etensor.Shape, idx int) (y, x float32) {
+	var ny, nx, iy, ix int
+	switch shp.NumDims() {
+	case 4:
+		ny = shp.Dim(0)
+		nx = shp.Dim(1)
+		pi := idx / (shp.Dim(2) * shp.Dim(3))
+		iy = pi / nx
+		ix = pi % nx
+	case 2:
+		ny = shp.Dim(0)
+		nx = shp.Dim(1)
+		iy = idx / nx
+		ix = idx % nx
+	default:
+		ny = 1
+		nx = shp.Len()
+		ix = idx
+	}
+	y, x = 0.5, 0.5
+	if ny > 1 {
+		y = float32(iy) / float32(ny-1)
+	}
+	if nx > 1 {
+		x = float32(ix) / float32(nx-1)
+	}
+	return
+}
+
+// InitWtsTopo initializes weights using the TopoWts strategy:
+// a gaussian function of the distance between normalized unit (pool) positions.
+func (pj *Prjn) InitWtsTopo() {
+	sig := pj.WtInit.Sigma
+	if sig <= 0 {
+		sig = 0.3
+	}
+	mn := float32(pj.WtInit.Mean)
+	vr := float32(pj.WtInit.Var)
+	rsh := pj.Recv.Shape()
+	ssh := pj.Send.Shape()
+	rn := rsh.Len()
+	for ri := 0; ri < rn; ri++ {
+		nc := int(pj.RConN[ri])
+		st := int(pj.RConIdxSt[ri])
+		ry, rx := TopoPos(rsh, ri)
+		for ci := 0; ci < nc; ci++ {
+			si := int(pj.RConIdx[st+ci])
+			sy, sx := TopoPos(ssh, si)
+			dy := ry - sy
+			dx := rx - sx
+			g := math32.Exp(-(dy*dy + dx*dx) / (2 * sig * sig))
+			pj.InitWtsSynVal(&pj.Syns[pj.RSynIdx[st+ci]], mn-vr+2*vr*g)
+		}
+	}
+}
+
+// InitWtsOrtho initializes weights using the OrthoWts strategy:
+// deviations from Mean form an orthogonal matrix, with max deviation = Var.
+func (pj *Prjn) InitWtsOrtho(rnd *rand.Rand) {
+	rn := pj.Recv.Shape().Len()
+	sn := pj.Send.Shape().Len()
+	mat := OrthoMatrix(rn, sn, rnd)
+	mx := 0.0
+	for _, v := range mat {
+		mx = math.Max(mx, math.Abs(v))
+	}
+	if mx == 0 {
+		mx = 1
+	}
+	for ri := 0; ri < rn; ri++ {
+		nc := int(pj.RConN[ri])
+		st := int(pj.RConIdxSt[ri])
+		for ci := 0; ci < nc; ci++ {
+			si := int(pj.RConIdx[st+ci])
+			sy := &pj.Syns[pj.RSynIdx[st+ci]]
+			wt := pj.WtInit.Mean + pj.WtInit.Var*mat[ri*sn+si]/mx
+			pj.InitWtsSynVal(sy, float32(wt))
+		}
+	}
+}
+
+// OrthoMatrix returns a row-major rows x cols matrix with orthonormal rows
+// (if rows <= cols) or orthonormal columns (otherwise), computed by
+// Gram-Schmidt orthogonalization of gaussian random values, using given
+// random number generator, or the global one if nil.
+func OrthoMatrix(rows, cols int, rnd *rand.Rand) []float64 {
+	mat := make([]float64, rows*cols)
+	for i := range mat {
+		mat[i] = RndNormFloat64(rnd)
+	}
+	// vectors are rows if rows <= cols, else columns
+	nv, vl, vst, est := rows, cols, cols, 1
+	if rows > cols {
+		nv, vl, vst, est = cols, rows, 1, cols
+	}
+	for vi := 0; vi < nv; vi++ {
+		vo := vi * vst
+		for pi := 0; pi < vi; pi++ {
+			po := pi * vst
+			dp := 0.0
+			for e := 0; e < vl; e++ {
+				dp += mat[vo+e*est] * mat[po+e*est]
+			}
+			for e := 0; e < vl; e++ {
+				mat[vo+e*est] -= dp * mat[po+e*est]
+			}
+		}
+		nrm := 0.0
+		for e := 0; e < vl; e++ {
+			nrm += mat[vo+e*est] * mat[vo+e*est]
+		}
+		nrm = math.Sqrt(nrm)
+		if nrm < 1.0e-10 {
+			nrm = 1
+		}
+		for e := 0; e < vl; e++ {
+			mat[vo+e*est] /= nrm
+		}
+	}
+	return mat
+}
+
+// InitWtsCopy copies the weights from the WtInit.CopyFrom projection
+// if WtInit.Strat is CopyWts -- called by Network.InitWts after all
+// other weights are initialized.  Synapses not present in the
+// CopyFrom projection retain their current weights.
+// Returns error if the projection is not found or has a different size.
+func (pj *Prjn) InitWtsCopy() error {
+	if pj.WtInit.Strat != CopyWts {
+		return nil
+	}
+	net := pj.Recv.(LeabraLayer).AsLeabra().Network
+	var src *Prjn
+	for li := 0; li < net.NLayers() && src == nil; li++ {
+		rpjs := net.Layer(li).RecvPrjns()
+		for _, p := range *rpjs {
+			if p.Name() == pj.WtInit.CopyFrom {
+				src = p.(LeabraPrjn).AsLeabra()
+				break
+			}
+		}
+	}
+	if src == nil {
+		return fmt.Errorf("leabra.InitWtsCopy: prjn: %s CopyFrom prjn: %s not found", pj.Name(), pj.WtInit.CopyFrom)
+	}
+	rn := pj.Recv.Shape().Len()
+	sn := pj.Send.Shape().Len()
+	if src.Recv.Shape().Len() != rn || src.Send.Shape().Len() != sn {
+		return fmt.Errorf("leabra.InitWtsCopy: prjn: %s CopyFrom prjn: %s has different layer sizes", pj.Name(), src.Name())
+	}
+	for ri := 0; ri < rn; ri++ {
+		nc := int(pj.RConN[ri])
+		st := int(pj.RConIdxSt[ri])
+		for ci := 0; ci < nc; ci++ {
+			si := int(pj.RConIdx[st+ci])
+			ssi := src.SynIdx(si, ri)
+			if ssi < 0 {
+				continue
+			}
+			sy := &pj.Syns[pj.RSynIdx[st+ci]]
+			sy.LWt = src.Syns[ssi].LWt
+			pj.Learn.WtFmLWt(sy)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package leabra
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/erand"
+	"github.com/emer/emergent/params"
+	"github.com/emer/emergent/prjn"
+	"github.com/emer/etable/etensor"
+)
+
+func newWtInitNet(t *testing.T, sheet *params.Sheet) (*Network, *Prjn, *Prjn) {
+	net := &Network{}
+	net.InitName(net, "WtInitNet")
+	inLay := net.AddLayer2D("Input", 4, 5, emer.Input)
+	hidLay := net.AddLayer2D("Hidden", 3, 4, emer.Hidden)
+	outLay := net.AddLayer2D("Output", 3, 4, emer.Target)
+	inHid := net.ConnectLayers(inLay, hidLay, prjn.NewFull(), emer.Forward).(*Prjn)
+	inOut := net.ConnectLayers(inLay, outLay, prjn.NewFull(), emer.Forward).(*Prjn)
+	net.Defaults()
+	if sheet != nil {
+		net.ApplyParams(sheet, false)
+	}
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	return net, inHid, inOut
+}
+
+func TestWtInitSeed(t *testing.T) {
+	sheet := &params.Sheet{
+		{Sel: "#InputToHidden", Desc: "seeded",
+			Params: params.Params{
+				"Prjn.WtInit.Seed": "42",
+			}},
+	}
+	net, inHid, inOut := newWtInitNet(t, sheet)
+	w1 := &etensor.Float32{}
+	inHid.SynValsTensor(w1, "Wt")
+	o1 := &etensor.Float32{}
+	inOut.SynValsTensor(o1, "Wt")
+
+	// changing the other prjn's init should not affect the seeded prjn
+	inOut.WtInit.Strat = SparseWts
+	net.InitWts()
+	w2 := &etensor.Float32{}
+	inHid.SynValsTensor(w2, "Wt")
+	o2 := &etensor.Float32{}
+	inOut.SynValsTensor(o2, "Wt")
+	CmprFloats(w2.Values, w1.Values, "seeded weights reproducible", t)
+	same := true
+	for i := range o1.Values {
+		if o1.Values[i] != o2.Values[i] {
+			same = false
+			break
+		}
+	}
+	if same {
+		t.Errorf("unseeded weights should differ after re-init")
+	}
+}
+
+// TestWtInitSeedDists checks that all distributions are reproducible from WtInit.Seed
+func TestWtInitSeedDists(t *testing.T) {
+	for _, dist := range []erand.RndDists{erand.Binomial, erand.Poisson, erand.Gamma, erand.Beta} {
+		sheet := &params.Sheet{
+			{Sel: "#InputToHidden", Desc: "seeded",
+				Params: params.Params{
+					"Prjn.WtInit.Seed": "42",
+					"Prjn.WtInit.Dist": dist.String(),
+					"Prjn.WtInit.Mean": "0.1",
+					"Prjn.WtInit.Var":  "0.5",
+					"Prjn.WtInit.Par":  "2",
+				}},
+		}
+		net, inHid, _ := newWtInitNet(t, sheet)
+		if inHid.WtInit.Dist != dist {
+			t.Fatalf("WtInit.Dist: %v != %v", inHid.WtInit.Dist, dist)
+		}
+		w1 := &etensor.Float32{}
+		inHid.SynValsTensor(w1, "Wt")
+		rand.Seed(int64(dist) + 10) // global generator must not matter
+		net.InitWts()
+		w2 := &etensor.Float32{}
+		inHid.SynValsTensor(w2, "Wt")
+		CmprFloats(w2.Values, w1.Values, dist.String()+" seeded weights reproducible", t)
+		nsame := 0
+		for i := range w1.Values {
+			if w1.Values[i] == w1.Values[0] {
+				nsame++
+			}
+		}
+		if nsame == len(w1.Values) {
+			t.Errorf("%v: weights are not random: all %g", dist, w1.Values[0])
+		}
+	}
+}
+
+func TestWtInitSparse(t *testing.T) {
+	sheet := &params.Sheet{
+		{Sel: "Prjn", Desc: "sparse",
+			Params: params.Params{
+				"Prjn.WtInit.Strat":    "SparseWts",
+				"Prjn.WtInit.NNonZero": "3",
+				"Prjn.WtInit.Var":      "0.1",
+			}},
+	}
+	_, inHid, _ := newWtInitNet(t, sheet)
+	if inHid.WtInit.Strat != SparseWts {
+		t.Fatalf("Strat not set from params: %v", inHid.WtInit.Strat)
+	}
+	wts := &etensor.Float32{}
+	inHid.SynValsTensor(wts, "Wt")
+	rn := wts.Dim(0)
+	sn := wts.Dim(1)
+	for ri := 0; ri < rn; ri++ {
+		nnz := 0
+		for si := 0; si < sn; si++ {
+			if wts.Values[ri*sn+si] != 0 {
+				nnz++
+			}
+		}
+		if nnz != 3 {
+			t.Errorf("recv: %d number of non-zero weights: %d != 3", ri, nnz)
+		}
+	}
+}
+
+func TestWtInitOrtho(t *testing.T) {
+	sheet := &params.Sheet{
+		{Sel: "Prjn", Desc: "ortho",
+			Params: params.Params{
+				"Prjn.WtInit.Strat": "OrthoWts",
+				"Prjn.WtInit.Seed":  "1",
+			}},
+	}
+	_, inHid, _ := newWtInitNet(t, sheet)
+	wts := &etensor.Float32{}
+	inHid.SynValsTensor(wts, "Wt")
+	// orthogonality is on deviations from Mean
+	rn := wts.Dim(0)
+	sn := wts.Dim(1)
+	dev := make([]float64, rn*sn)
+	for i := range dev {
+		dev[i] = float64(wts.Values[i]) - inHid.WtInit.Mean
+	}
+	for ai := 0; ai < rn; ai++ {
+		for bi := 0; bi < ai; bi++ {
+			dp := 0.0
+			for si := 0; si < sn; si++ {
+				dp += dev[ai*sn+si] * dev[bi*sn+si]
+			}
+			if math.Abs(dp) > 1.0e-4 {
+				t.Errorf("recv rows: %d, %d not orthogonal: %g", ai, bi, dp)
+			}
+		}
+	}
+}
+
+func TestWtInitTopoFanInCopy(t *testing.T) {
+	sheet := &params.Sheet{
+		{Sel: "#InputToHidden", Desc: "topo",
+			Params: params.Params{
+				"Prjn.WtInit.Strat": "TopoWts",
+				"Prjn.WtInit.Sigma": "0.2",
+			}},
+		{Sel: "#InputToOutput", Desc: "copy",
+			Params: params.Params{
+				"Prjn.WtInit.Strat":    "CopyWts",
+				"Prjn.WtInit.CopyFrom": "InputToHidden",
+			}},
+	}
+	_, inHid, inOut := newWtInitNet(t, sheet)
+	// corner to corner is the max weight, opposite corner the min
+	cc := inHid.SynVal("Wt", 0, 0)
+	co := inHid.SynVal("Wt", 19, 0)
+	if cc != 0.75 || co >= 0.26 {
+		t.Errorf("topo weights not correct: corner: %g opposite: %g", cc, co)
+	}
+	hw := &etensor.Float32{}
+	inHid.SynValsTensor(hw, "Wt")
+	ow := &etensor.Float32{}
+	inOut.SynValsTensor(ow, "Wt")
+	for i := range ow.Values { // copied via LWt, so allow for sigmoid round-trip diffs
+		if math.Abs(float64(ow.Values[i]-hw.Values[i])) > 1.0e-6 {
+			t.Errorf("copied weights err: out: %v, cor: %v\n", ow.Values[i], hw.Values[i])
+		}
+	}
+
+	inHid.WtInit.Strat = FanInWts
+	inHid.WtInit.Var = 0.4
+	inHid.WtInit.Dist = erand.Gaussian
+	inHid.WtInit.Seed = 2
+	inHid.InitWts()
+	inHid.SynValsTensor(hw, "Wt")
+	vr := 0.0
+	for _, w := range hw.Values {
+		vr += (float64(w) - 0.5) * (float64(w) - 0.5)
+	}
+	sd := math.Sqrt(vr / float64(len(hw.Values)))
+	if sd > 0.4/math.Sqrt(20)*1.5 {
+		t.Errorf("fan-in scaled weight sd: %g too large", sd)
+	}
+}
@@ -0,0 +1,44 @@
+// Code generated by "stringer -type=WtInitStrat"; DO NOT EDIT.
+
+package leabra
+
+import (
+	"errors"
+	"strconv"
+)
+
+var _ = errors.New("dummy error")
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[RndWts-0]
+	_ = x[FanInWts-1]
+	_ = x[SparseWts-2]
+	_ = x[TopoWts-3]
+	_ = x[OrthoWts-4]
+	_ = x[CopyWts-5]
+	_ = x[WtInitStratN-6]
+}
+
+const _WtInitStrat_name = "RndWtsFanInWtsSparseWtsTopoWtsOrthoWtsCopyWtsWtInitStratN"
+
+var _WtInitStrat_index = [...]uint8{0, 6, 14, 23, 30, 38, 45, 57}
+
+func (i WtInitStrat) String() string {
+	if i < 0 || i >= WtInitStrat(len(_WtInitStrat_index)-1) {
+		return "WtInitStrat(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _WtInitStrat_name[_WtInitStrat_index[i]:_WtInitStrat_index[i+1]]
+}
+
+func (i *WtInitStrat) FromString(s string) error {
+	for j := 0; j < len(_WtInitStrat_index)-1; j++ {
+		if s == _WtInitStrat_name[_WtInitStrat_index[j]:_WtInitStrat_index[j+1]] {
+			*i = WtInitStrat(j)
+			return nil
+		}
+	}
+	return errors.New("String: " + s + " is not a valid option for type: WtInitStrat")
+}
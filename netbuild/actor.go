@@ -0,0 +1,49 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netbuild
+
+import (
+	"github.com/ccnlab/leabrax/actor"
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+	"github.com/emer/emergent/relpos"
+)
+
+// ActorBuilder provides the actor package network configuration helpers.
+type ActorBuilder struct {
+	Net *leabra.Network `desc:"the network being built"`
+}
+
+// AddActorLayer adds an ActorLayer with one action per unit, using 2D shape
+func (ab *ActorBuilder) AddActorLayer(name string, nY, nX int) *actor.ActorLayer {
+	return actor.AddActorLayer(ab.Net, name, nY, nX)
+}
+
+// AddActorLayer4D adds an ActorLayer with one action per pool, using 4D shape
+func (ab *ActorBuilder) AddActorLayer4D(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int) *actor.ActorLayer {
+	return actor.AddActorLayer4D(ab.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX)
+}
+
+// ConnectToActor adds an ActorPrjn from given sending layer to an actor layer
+func (ab *ActorBuilder) ConnectToActor(send, recv emer.Layer, pat prjn.Pattern) emer.Prjn {
+	return actor.ConnectToActor(ab.Net, send, recv, pat)
+}
+
+// AddTDCritic adds the rl TD layers as a critic for the given actor layer.
+// See actor.AddTDCritic.
+// Returns [rew, rp, ri, td] layers.
+func (ab *ActorBuilder) AddTDCritic(prefix string, act emer.Layer, rel relpos.Relations, space float32) []leabra.LeabraLayer {
+	rew, rp, ri, td := actor.AddTDCritic(ab.Net, prefix, act, rel, space)
+	return []leabra.LeabraLayer{rew, rp, ri, td}
+}
+
+// AddRWCritic adds the rl Rescorla-Wagner layers as a critic for the given actor layer.
+// See actor.AddRWCritic.
+// Returns [rew, rp, da] layers.
+func (ab *ActorBuilder) AddRWCritic(prefix string, act emer.Layer, rel relpos.Relations, space float32) []leabra.LeabraLayer {
+	rew, rp, da := actor.AddRWCritic(ab.Net, prefix, act, rel, space)
+	return []leabra.LeabraLayer{rew, rp, da}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netbuild
+
+import (
+	"github.com/ccnlab/leabrax/agate"
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/ccnlab/leabrax/pcore"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+)
+
+// AGateBuilder provides the agate package network configuration helpers.
+type AGateBuilder struct {
+	Net *leabra.Network `desc:"the network being built"`
+}
+
+// AddBG adds the pcore BG layers, with given optional prefix.  See pcore.AddBG.
+// Returns [mtxGo, mtxNo, cin, gpeOut, gpeIn, gpeTA, stnp, stns, gpi, vthal] layers.
+func (ab *AGateBuilder) AddBG(prefix string, nPoolsY, nPoolsX, nNeurY, nNeurX int, space float32) []leabra.LeabraLayer {
+	mtxGo, mtxNo, cin, gpeOut, gpeIn, gpeTA, stnp, stns, gpi, vthal := pcore.AddBG(ab.Net, prefix, nPoolsY, nPoolsX, nNeurY, nNeurX, space)
+	return []leabra.LeabraLayer{mtxGo, mtxNo, cin, gpeOut, gpeIn, gpeTA, stnp, stns, gpi, vthal}
+}
+
+// ConnectToMatrix adds a MatrixTracePrjn from given sending layer to a matrix layer
+func (ab *AGateBuilder) ConnectToMatrix(send, recv emer.Layer, pat prjn.Pattern) emer.Prjn {
+	return pcore.ConnectToMatrix(ab.Net, send, recv, pat)
+}
+
+// AddMaintLayer adds a MaintLayer using 4D shape with pools,
+// and lateral NMDAMaint PoolOneToOne connectivity.
+func (ab *AGateBuilder) AddMaintLayer(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int) *agate.MaintLayer {
+	return agate.AddMaintLayer(ab.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX)
+}
+
+// AddOutLayer adds a OutLayer using 4D shape with pools.
+func (ab *AGateBuilder) AddOutLayer(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int) *agate.OutLayer {
+	return agate.AddOutLayer(ab.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX)
+}
+
+// AddPFC adds a PFC system including SuperLayer, CT with CTCtxtPrjn, MaintLayer,
+// and OutLayer which is gated by BG, and optional TRC Pulvinar.  See agate.AddPFC.
+// Returns [super, ct, maint, out, pulv] layers, with pulv nil if not created.
+func (ab *AGateBuilder) AddPFC(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int, pulvLay bool) []emer.Layer {
+	super, ct, maint, out, pulv := agate.AddPFC(ab.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX, pulvLay)
+	return []emer.Layer{super, ct, maint, out, pulv}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netbuild
+
+import (
+	"github.com/ccnlab/leabrax/deep"
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+)
+
+// DeepBuilder provides the deep package network configuration helpers.
+type DeepBuilder struct {
+	Net *leabra.Network `desc:"the network being built"`
+}
+
+// AddSuperLayer2D adds a SuperLayer of given size, with given name.
+func (db *DeepBuilder) AddSuperLayer2D(name string, nNeurY, nNeurX int) *deep.SuperLayer {
+	return deep.AddSuperLayer2D(db.Net, name, nNeurY, nNeurX)
+}
+
+// AddSuperLayer4D adds a SuperLayer of given size, with given name.
+func (db *DeepBuilder) AddSuperLayer4D(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int) *deep.SuperLayer {
+	return deep.AddSuperLayer4D(db.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX)
+}
+
+// AddCTLayer2D adds a CTLayer of given size, with given name.
+func (db *DeepBuilder) AddCTLayer2D(name string, nNeurY, nNeurX int) *deep.CTLayer {
+	return deep.AddCTLayer2D(db.Net, name, nNeurY, nNeurX)
+}
+
+// AddCTLayer4D adds a CTLayer of given size, with given name.
+func (db *DeepBuilder) AddCTLayer4D(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int) *deep.CTLayer {
+	return deep.AddCTLayer4D(db.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX)
+}
+
+// AddTRCLayer2D adds a TRCLayer of given size, with given name.
+func (db *DeepBuilder) AddTRCLayer2D(name string, nNeurY, nNeurX int) *deep.TRCLayer {
+	return deep.AddTRCLayer2D(db.Net, name, nNeurY, nNeurX)
+}
+
+// AddTRCLayer4D adds a TRCLayer of given size, with given name.
+func (db *DeepBuilder) AddTRCLayer4D(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int) *deep.TRCLayer {
+	return deep.AddTRCLayer4D(db.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX)
+}
+
+// ConnectSuperToCT adds a CTCtxtPrjn from given sending Super layer to a CT layer.
+// See deep.ConnectSuperToCT.
+func (db *DeepBuilder) ConnectSuperToCT(send, recv emer.Layer) emer.Prjn {
+	return deep.ConnectSuperToCT(db.Net, send, recv)
+}
+
+// ConnectCtxtToCT adds a CTCtxtPrjn from given sending layer to a CT layer.
+// See deep.ConnectCtxtToCT.
+func (db *DeepBuilder) ConnectCtxtToCT(send, recv emer.Layer, pat prjn.Pattern) emer.Prjn {
+	return deep.ConnectCtxtToCT(db.Net, send, recv, pat)
+}
+
+// ConnectSuperToCTFake adds a FAKE CTCtxtPrjn from given sending Super layer
+// to a CT layer, for testing.  See deep.ConnectSuperToCTFake.
+func (db *DeepBuilder) ConnectSuperToCTFake(send, recv emer.Layer) emer.Prjn {
+	return deep.ConnectSuperToCTFake(db.Net, send, recv)
+}
+
+// ConnectCtxtToCTFake adds a FAKE CTCtxtPrjn from given sending layer
+// to a CT layer, for testing.  See deep.ConnectCtxtToCTFake.
+func (db *DeepBuilder) ConnectCtxtToCTFake(send, recv emer.Layer, pat prjn.Pattern) emer.Prjn {
+	return deep.ConnectCtxtToCTFake(db.Net, send, recv, pat)
+}
+
+// AddDeep2D adds a superficial (SuperLayer) and corresponding CT (CT suffix) layer
+// and TRC Pulvinar for Super (P suffix).  See deep.AddDeep2D.
+// Returns [super, ct, trc] layers.
+func (db *DeepBuilder) AddDeep2D(name string, shapeY, shapeX int) []emer.Layer {
+	super, ct, trc := deep.AddDeep2D(db.Net, name, shapeY, shapeX)
+	return []emer.Layer{super, ct, trc}
+}
+
+// AddDeep4D adds a superficial (SuperLayer) and corresponding CT (CT suffix) layer
+// and TRC Pulvinar for Super (P suffix).  See deep.AddDeep4D.
+// Returns [super, ct, trc] layers.
+func (db *DeepBuilder) AddDeep4D(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int) []emer.Layer {
+	super, ct, trc := deep.AddDeep4D(db.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX)
+	return []emer.Layer{super, ct, trc}
+}
+
+// AddDeepNoTRC2D adds a superficial (SuperLayer) and corresponding CT (CT suffix) layer
+// without a TRC Pulvinar.  See deep.AddDeepNoTRC2D.
+// Returns [super, ct] layers.
+func (db *DeepBuilder) AddDeepNoTRC2D(name string, shapeY, shapeX int) []emer.Layer {
+	super, ct := deep.AddDeepNoTRC2D(db.Net, name, shapeY, shapeX)
+	return []emer.Layer{super, ct}
+}
+
+// AddDeepNoTRC4D adds a superficial (SuperLayer) and corresponding CT (CT suffix) layer
+// without a TRC Pulvinar.  See deep.AddDeepNoTRC4D.
+// Returns [super, ct] layers.
+func (db *DeepBuilder) AddDeepNoTRC4D(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int) []emer.Layer {
+	super, ct := deep.AddDeepNoTRC4D(db.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX)
+	return []emer.Layer{super, ct}
+}
+
+// AddDeep2DFakeCT adds a superficial (SuperLayer) and corresponding CT (CT suffix) layer
+// with FAKE CTCtxtPrjn projections, for testing.  See deep.AddDeep2DFakeCT.
+// Returns [super, ct, trc] layers.
+func (db *DeepBuilder) AddDeep2DFakeCT(name string, shapeY, shapeX int) []emer.Layer {
+	super, ct, trc := deep.AddDeep2DFakeCT(db.Net, name, shapeY, shapeX)
+	return []emer.Layer{super, ct, trc}
+}
+
+// AddDeep4DFakeCT adds a superficial (SuperLayer) and corresponding CT (CT suffix) layer
+// with FAKE CTCtxtPrjn projections, for testing.  See deep.AddDeep4DFakeCT.
+// Returns [super, ct, trc] layers.
+func (db *DeepBuilder) AddDeep4DFakeCT(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int) []emer.Layer {
+	super, ct, trc := deep.AddDeep4DFakeCT(db.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX)
+	return []emer.Layer{super, ct, trc}
+}
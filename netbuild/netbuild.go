@@ -0,0 +1,153 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package netbuild provides the NetBuilder, which exposes all of the
+network configuration helpers from the leabra, deep, pbwm, pcore, pvlv,
+rl, agate and actor packages through a single consistent API that is usable
+from Python via gopy.
+
+gopy cannot handle multiple return values, so each helper that returns
+multiple layers or projections in Go returns a slice here, in the same
+order as the Go return values, and helpers that return a single layer or
+projection return that handle directly.  Helpers for each package are
+organized into a sub-builder with the same method names as the
+corresponding Go functions, e.g., in Python:
+
+	nb = netbuild.NewNetBuilder(net)
+	super, ct, trc = nb.Deep.AddDeep2D("Hidden", 10, 10)
+	inp = nb.AddLayer2D("Input", 5, 5, emer.Input)
+	nb.BidirConnectLayers(inp, super, prjn.NewFull())
+
+The netbuild tests verify that every Add* and Connect* helper in each
+package has a corresponding NetBuilder method, so new helpers must be
+added here as well.
+*/
+package netbuild
+
+import (
+	"log"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/ccnlab/leabrax/pvlv"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+)
+
+// NetBuilder provides methods for configuring a network that return
+// a single handle or a slice of handles, suitable for use from Python.
+// The basic leabra.Network methods are available directly on NetBuilder,
+// and those from other packages are available on the corresponding
+// sub-builder (e.g., Deep, PBWM).
+type NetBuilder struct {
+	Net   *leabra.Network `desc:"the network being built"`
+	Deep  *DeepBuilder    `desc:"deep package helpers"`
+	PBWM  *PBWMBuilder    `desc:"pbwm package helpers"`
+	PCore *PCoreBuilder   `desc:"pcore package helpers"`
+	PVLV  *PVLVBuilder    `desc:"pvlv package helpers -- nil unless the network is a pvlv.Network"`
+	RL    *RLBuilder      `desc:"rl package helpers"`
+	AGate *AGateBuilder   `desc:"agate package helpers"`
+	Actor *ActorBuilder   `desc:"actor package helpers"`
+}
+
+// NewNetBuilder returns a new NetBuilder for given network,
+// which must be a leabra.Network or a type derived from it
+// (e.g., deep.Network, pbwm.Network, pvlv.Network).
+// InitName must have already been called on the network.
+func NewNetBuilder(net emer.Network) *NetBuilder {
+	nb := &NetBuilder{}
+	nb.Init(net)
+	return nb
+}
+
+// Init initializes the builder for given network,
+// which must be a leabra.Network or a type derived from it.
+// Otherwise, all of the builders are left nil.
+func (nb *NetBuilder) Init(net emer.Network) {
+	*nb = NetBuilder{}
+	lnet, ok := net.(leabra.LeabraNetwork)
+	if !ok {
+		log.Printf("netbuild.NetBuilder: network %s is not a leabra.LeabraNetwork\n", net.Name())
+		return
+	}
+	nb.Net = lnet.AsLeabra()
+	nb.Deep = &DeepBuilder{Net: nb.Net}
+	nb.PBWM = &PBWMBuilder{Net: nb.Net}
+	nb.PCore = &PCoreBuilder{Net: nb.Net}
+	nb.RL = &RLBuilder{Net: nb.Net}
+	nb.AGate = &AGateBuilder{Net: nb.Net}
+	nb.Actor = &ActorBuilder{Net: nb.Net}
+	if pnet, ok := net.(*pvlv.Network); ok {
+		nb.PVLV = &PVLVBuilder{Net: pnet}
+	}
+}
+
+// AddLayerInit is implementation routine that takes a given layer and
+// adds it to the network, and initializes and configures it properly.
+func (nb *NetBuilder) AddLayerInit(ly emer.Layer, name string, shape []int, typ emer.LayerType) {
+	nb.Net.AddLayerInit(ly, name, shape, typ)
+}
+
+// AddLayer adds a new layer with given name and shape to the network.
+func (nb *NetBuilder) AddLayer(name string, shape []int, typ emer.LayerType) emer.Layer {
+	return nb.Net.AddLayer(name, shape, typ)
+}
+
+// AddLayer2D adds a new layer with given name and 2D shape to the network.
+func (nb *NetBuilder) AddLayer2D(name string, shapeY, shapeX int, typ emer.LayerType) emer.Layer {
+	return nb.Net.AddLayer2D(name, shapeY, shapeX, typ)
+}
+
+// AddLayer4D adds a new layer with given name and 4D shape to the network.
+func (nb *NetBuilder) AddLayer4D(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int, typ emer.LayerType) emer.Layer {
+	return nb.Net.AddLayer4D(name, nPoolsY, nPoolsX, nNeurY, nNeurX, typ)
+}
+
+// ConnectLayerNames establishes a projection between two layers, referenced by name,
+// adding to the recv and send projection lists on each side of the connection.
+// Returns the projection, or error if not successful.
+func (nb *NetBuilder) ConnectLayerNames(send, recv string, pat prjn.Pattern, typ emer.PrjnType) (emer.Prjn, error) {
+	_, _, pj, err := nb.Net.ConnectLayerNames(send, recv, pat, typ)
+	return pj, err
+}
+
+// ConnectLayers establishes a projection between two layers,
+// adding to the recv and send projection lists on each side of the connection.
+func (nb *NetBuilder) ConnectLayers(send, recv emer.Layer, pat prjn.Pattern, typ emer.PrjnType) emer.Prjn {
+	return nb.Net.ConnectLayers(send, recv, pat, typ)
+}
+
+// ConnectLayersPrjn makes connection using given projection between two layers,
+// adding given prjn to the recv and send projection lists on each side of the connection.
+func (nb *NetBuilder) ConnectLayersPrjn(send, recv emer.Layer, pat prjn.Pattern, typ emer.PrjnType, pj emer.Prjn) emer.Prjn {
+	return nb.Net.ConnectLayersPrjn(send, recv, pat, typ, pj)
+}
+
+// BidirConnectLayerNames establishes bidirectional projections between two layers,
+// referenced by name, with low = the lower layer that sends a Forward projection
+// to the high layer, and receives a Back projection in the opposite direction.
+// Returns the [fwd, back] projections, or error if not successful.
+func (nb *NetBuilder) BidirConnectLayerNames(low, high string, pat prjn.Pattern) ([]emer.Prjn, error) {
+	_, _, fwd, back, err := nb.Net.BidirConnectLayerNames(low, high, pat)
+	return []emer.Prjn{fwd, back}, err
+}
+
+// BidirConnectLayers establishes bidirectional projections between two layers,
+// with low = lower layer that sends a Forward projection to the high layer,
+// and receives a Back projection in the opposite direction.
+// Returns the [fwd, back] projections.
+func (nb *NetBuilder) BidirConnectLayers(low, high emer.Layer, pat prjn.Pattern) []emer.Prjn {
+	fwd, back := nb.Net.BidirConnectLayers(low, high, pat)
+	return []emer.Prjn{fwd, back}
+}
+
+// LateralConnectLayer establishes a self-projection within given layer.
+func (nb *NetBuilder) LateralConnectLayer(lay emer.Layer, pat prjn.Pattern) emer.Prjn {
+	return nb.Net.LateralConnectLayer(lay, pat)
+}
+
+// LateralConnectLayerPrjn makes lateral self-projection using given projection.
+func (nb *NetBuilder) LateralConnectLayerPrjn(lay emer.Layer, pat prjn.Pattern, pj emer.Prjn) emer.Prjn {
+	return nb.Net.LateralConnectLayerPrjn(lay, pat, pj)
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netbuild
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/ccnlab/leabrax/deep"
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/ccnlab/leabrax/pbwm"
+	"github.com/ccnlab/leabrax/pcore"
+	"github.com/ccnlab/leabrax/pvlv"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+	"github.com/emer/emergent/relpos"
+)
+
+// legacyPy are the existing *Py slice-returning helper wrappers, which
+// are kept for older Python code -- new Python entry points must go
+// through the NetBuilder instead.
+var legacyPy = map[string]bool{
+	"BidirConnectLayersPy": true,
+	"AddDeep2DPy":          true,
+	"AddDeep4DPy":          true,
+	"AddDeepNoTRC2DPy":     true,
+	"AddDeepNoTRC4DPy":     true,
+	"AddDorsalBGPy":        true,
+	"AddPFCPy":             true,
+	"AddPBWMPy":            true,
+	"AddBGPy":              true,
+	"AddTDLayersPy":        true,
+	"AddRWLayersPy":        true,
+}
+
+// isHelperName returns true if name is a network configuration helper
+// name, including *Py versions.
+func isHelperName(name string) bool {
+	if !ast.IsExported(name) {
+		return false
+	}
+	for _, pfx := range []string{"Add", "Connect", "BidirConnect", "LateralConnect"} {
+		if strings.HasPrefix(name, pfx) {
+			return true
+		}
+	}
+	return false
+}
+
+// recvTypeName returns the name of the receiver type for given method, or "".
+func recvTypeName(fd *ast.FuncDecl) string {
+	if fd.Recv == nil || len(fd.Recv.List) == 0 {
+		return ""
+	}
+	typ := fd.Recv.List[0].Type
+	if st, ok := typ.(*ast.StarExpr); ok {
+		typ = st.X
+	}
+	if id, ok := typ.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+// firstParamIsNet returns true if the first parameter of given func is
+// a pointer to a Network (leabra.Network or the package's own Network).
+func firstParamIsNet(fd *ast.FuncDecl) bool {
+	if fd.Type.Params == nil || len(fd.Type.Params.List) == 0 {
+		return false
+	}
+	st, ok := fd.Type.Params.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	switch x := st.X.(type) {
+	case *ast.Ident:
+		return x.Name == "Network"
+	case *ast.SelectorExpr:
+		return x.Sel.Name == "Network"
+	}
+	return false
+}
+
+// helperNames returns the sorted list of network configuration helper
+// names in given package directory, including package-level functions
+// taking a network as first arg, and methods on given network receiver type.
+func helperNames(t *testing.T, dir, recvType string) []string {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nms := map[string]bool{}
+	for _, pkg := range pkgs {
+		for _, fl := range pkg.Files {
+			for _, dcl := range fl.Decls {
+				fd, ok := dcl.(*ast.FuncDecl)
+				if !ok || !isHelperName(fd.Name.Name) {
+					continue
+				}
+				rt := recvTypeName(fd)
+				if (rt == "" && firstParamIsNet(fd)) || (rt != "" && rt == recvType) {
+					nms[fd.Name.Name] = true
+				}
+			}
+		}
+	}
+	var res []string
+	for nm := range nms {
+		res = append(res, nm)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func TestCoverage(t *testing.T) {
+	nb := &NetBuilder{}
+	cases := []struct {
+		dir  string
+		recv string
+		bld  interface{}
+	}{
+		{"../leabra", "NetworkStru", nb},
+		{"../deep", "Network", &DeepBuilder{}},
+		{"../pbwm", "Network", &PBWMBuilder{}},
+		{"../pcore", "Network", &PCoreBuilder{}},
+		{"../pvlv", "Network", &PVLVBuilder{}},
+		{"../rl", "Network", &RLBuilder{}},
+		{"../agate", "Network", &AGateBuilder{}},
+		{"../actor", "Network", &ActorBuilder{}},
+	}
+	for _, cs := range cases {
+		nms := helperNames(t, cs.dir, cs.recv)
+		if len(nms) == 0 {
+			t.Errorf("no helpers found in: %s", cs.dir)
+		}
+		bt := reflect.TypeOf(cs.bld)
+		for _, nm := range nms {
+			if strings.HasSuffix(nm, "Py") {
+				if !legacyPy[nm] {
+					t.Errorf("%s helper: %s is a new *Py wrapper -- add it to %s instead", cs.dir, nm, bt.Elem().Name())
+				}
+				continue
+			}
+			if _, has := bt.MethodByName(nm); !has {
+				t.Errorf("%s is missing method for %s helper: %s", bt.Elem().Name(), cs.dir, nm)
+			}
+		}
+		for i := 0; i < bt.NumMethod(); i++ {
+			mt := bt.Method(i).Type
+			if mt.NumOut() > 2 || (mt.NumOut() == 2 && mt.Out(1).Name() != "error") {
+				t.Errorf("%s.%s has multiple return values", bt.Elem().Name(), bt.Method(i).Name)
+			}
+		}
+	}
+}
+
+func TestBuild(t *testing.T) {
+	net := &deep.Network{}
+	net.InitName(net, "DeepNet")
+	nb := NewNetBuilder(net)
+	inp := nb.AddLayer2D("Input", 4, 4, emer.Input)
+	dls := nb.Deep.AddDeep2D("Hidden", 4, 4)
+	if len(dls) != 3 || dls[0].Name() != "Hidden" || dls[1].Name() != "HiddenCT" || dls[2].Name() != "HiddenP" {
+		t.Errorf("AddDeep2D layers not correct: %v", dls)
+	}
+	pjs := nb.BidirConnectLayers(inp, dls[0], prjn.NewFull())
+	if len(pjs) != 2 || pjs[0].Type() != emer.Forward || pjs[1].Type() != emer.Back {
+		t.Errorf("BidirConnectLayers prjns not correct: %v", pjs)
+	}
+	if _, err := nb.ConnectLayerNames("Input", "HiddenCT", prjn.NewFull(), emer.Forward); err != nil {
+		t.Error(err)
+	}
+	if _, err := nb.ConnectLayerNames("Input", "Foo", prjn.NewFull(), emer.Forward); err == nil {
+		t.Errorf("ConnectLayerNames should fail for missing layer")
+	}
+	rls := nb.RL.AddRWLayers("", relpos.Behind, 2)
+	if len(rls) != 3 || rls[2].Name() != "DA" {
+		t.Errorf("AddRWLayers layers not correct: %v", rls)
+	}
+	if nb.PVLV != nil {
+		t.Errorf("PVLV builder should be nil for non-pvlv network")
+	}
+	net.Defaults()
+	if err := net.Build(); err != nil {
+		t.Error(err)
+	}
+
+	pnet := &pbwm.Network{}
+	pnet.InitName(pnet, "PBWMNet")
+	nb.Init(pnet)
+	pls := nb.PBWM.AddPBWM("", 1, 1, 1, 1, 4, 1, 4)
+	if len(pls) != 9 {
+		t.Errorf("AddPBWM returned: %d layers, not 9", len(pls))
+	}
+	pnet.Defaults()
+	if err := pnet.Build(); err != nil {
+		t.Error(err)
+	}
+
+	gnet := &pbwm.Network{}
+	gnet.InitName(gnet, "PBWMGroupsNet")
+	nb.Init(gnet)
+	groups := []pbwm.GateGroup{{Name: "mnt", Type: pbwm.Maint, X: 2}, {Name: "out", Type: pbwm.Out, X: 1}}
+	gls := nb.PBWM.AddPBWMGroups("", 1, groups, 1, 2, 2, 2)
+	gnms := []string{"GPeNoGo", "GPiThal", "CIN", "MatrixGomnt", "MatrixNoGomnt", "MatrixGoout", "MatrixNoGoout", "PFCmnt", "PFCmntD", "PFCout", "PFCoutD"}
+	if len(gls) != len(gnms) {
+		t.Fatalf("AddPBWMGroups returned: %d layers, not %d", len(gls), len(gnms))
+	}
+	for i, nm := range gnms {
+		if gls[i].Name() != nm {
+			t.Errorf("AddPBWMGroups layer: %d name: %s != %s", i, gls[i].Name(), nm)
+		}
+	}
+
+	cnet := &leabra.Network{}
+	cnet.InitName(cnet, "PCoreNet")
+	nb.Init(cnet)
+	bls := nb.PCore.AddBG("", 1, 2, 1, 1, 2)
+	if len(bls) != 10 || bls[9].Name() != "VThal" {
+		t.Errorf("AddBG layers not correct: %v", bls)
+	}
+	mtx := nb.PCore.AddMatrixLayer("Mtx", 1, 2, 2, 2, pcore.D1R)
+	if mtx == nil || mtx.DaR != pcore.D1R {
+		t.Errorf("AddMatrixLayer not correct")
+	}
+	cnet.Defaults()
+	if err := cnet.Build(); err != nil {
+		t.Error(err)
+	}
+
+	vnet := &pvlv.Network{}
+	vnet.InitName(vnet, "PVLVNet")
+	nb.Init(vnet)
+	vta := nb.PVLV.AddVTALayer("VTAp", pvlv.POS)
+	if vta == nil || vnet.LayerByName("VTAp") == nil {
+		t.Errorf("AddVTALayer failed on pvlv.Network")
+	}
+}
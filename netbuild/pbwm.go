@@ -0,0 +1,110 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netbuild
+
+import (
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/ccnlab/leabrax/pbwm"
+)
+
+// PBWMBuilder provides the pbwm package network configuration helpers.
+type PBWMBuilder struct {
+	Net *leabra.Network `desc:"the network being built"`
+}
+
+// AddCINLayer adds a CINLayer, with a single neuron.
+func (pb *PBWMBuilder) AddCINLayer(name string) *pbwm.CINLayer {
+	return pbwm.AddCINLayer(pb.Net, name)
+}
+
+// AddMatrixLayer adds a MatrixLayer of given size, with given name.
+// See pbwm.AddMatrixLayer.
+func (pb *PBWMBuilder) AddMatrixLayer(name string, nY, nMaint, nOut, nNeurY, nNeurX int, da pbwm.DaReceptors) *pbwm.MatrixLayer {
+	return pbwm.AddMatrixLayer(pb.Net, name, nY, nMaint, nOut, nNeurY, nNeurX, da)
+}
+
+// AddGPeLayer adds a pbwm.Layer to serve as a GPe layer, with given name.
+// See pbwm.AddGPeLayer.
+func (pb *PBWMBuilder) AddGPeLayer(name string, nY, nMaint, nOut int) *pbwm.Layer {
+	return pbwm.AddGPeLayer(pb.Net, name, nY, nMaint, nOut)
+}
+
+// AddGPiThalLayer adds a GPiThalLayer of given size, with given name.
+// See pbwm.AddGPiThalLayer.
+func (pb *PBWMBuilder) AddGPiThalLayer(name string, nY, nMaint, nOut int) *pbwm.GPiThalLayer {
+	return pbwm.AddGPiThalLayer(pb.Net, name, nY, nMaint, nOut)
+}
+
+// AddDorsalBG adds MatrixGo, NoGo, GPe, GPiThal, and CIN layers, with given optional prefix.
+// See pbwm.AddDorsalBG.
+// Returns [mtxGo, mtxNoGo, gpe, gpi, cin] layers.
+func (pb *PBWMBuilder) AddDorsalBG(prefix string, nY, nMaint, nOut, nNeurY, nNeurX int) []leabra.LeabraLayer {
+	mtxGo, mtxNoGo, gpe, gpi, cin := pbwm.AddDorsalBG(pb.Net, prefix, nY, nMaint, nOut, nNeurY, nNeurX)
+	return []leabra.LeabraLayer{mtxGo, mtxNoGo, gpe, gpi, cin}
+}
+
+// AddPFCLayer adds a PFCLayer, super and deep, of given size, with given name.
+// See pbwm.AddPFCLayer.
+// Returns [super, deep] layers.
+func (pb *PBWMBuilder) AddPFCLayer(name string, nY, nX, nNeurY, nNeurX int, out, dynMaint bool) []leabra.LeabraLayer {
+	sp, dp := pbwm.AddPFCLayer(pb.Net, name, nY, nX, nNeurY, nNeurX, out, dynMaint)
+	return []leabra.LeabraLayer{sp, dp}
+}
+
+// AddPFC adds paired PFCmnt, PFCout and associated Deep layers,
+// with given optional prefix.  See pbwm.AddPFC.
+// Returns [pfcMnt, pfcMntD, pfcOut, pfcOutD] layers.
+func (pb *PBWMBuilder) AddPFC(prefix string, nY, nMaint, nOut, nNeurY, nNeurX int, dynMaint bool) []leabra.LeabraLayer {
+	pfcMnt, pfcMntD, pfcOut, pfcOutD := pbwm.AddPFC(pb.Net, prefix, nY, nMaint, nOut, nNeurY, nNeurX, dynMaint)
+	return []leabra.LeabraLayer{pfcMnt, pfcMntD, pfcOut, pfcOutD}
+}
+
+// AddPBWM adds a DorsalBG and PFC with given params.  See pbwm.AddPBWM.
+// Returns [mtxGo, mtxNoGo, gpe, gpi, cin, pfcMnt, pfcMntD, pfcOut, pfcOutD] layers.
+func (pb *PBWMBuilder) AddPBWM(prefix string, nY, nMaint, nOut, nNeurBgY, nNeurBgX, nNeurPfcY, nNeurPfcX int) []leabra.LeabraLayer {
+	mtxGo, mtxNoGo, gpe, gpi, cin, pfcMnt, pfcMntD, pfcOut, pfcOutD := pbwm.AddPBWM(pb.Net, prefix, nY, nMaint, nOut, nNeurBgY, nNeurBgX, nNeurPfcY, nNeurPfcX)
+	return []leabra.LeabraLayer{mtxGo, mtxNoGo, gpe, gpi, cin, pfcMnt, pfcMntD, pfcOut, pfcOutD}
+}
+
+// AddDorsalBGGroups adds MatrixGo and NoGo layers for each of given gating groups,
+// and GPe, GPiThal, and CIN layers, with given optional prefix.
+// See pbwm.AddDorsalBGGroups.
+// Returns [gpe, gpi, cin] followed by [mtxGo, mtxNoGo] layers for each group.
+func (pb *PBWMBuilder) AddDorsalBGGroups(prefix string, nY int, groups []pbwm.GateGroup, nNeurY, nNeurX int) []leabra.LeabraLayer {
+	mtxGos, mtxNoGos, gpe, gpi, cin := pbwm.AddDorsalBGGroups(pb.Net, prefix, nY, groups, nNeurY, nNeurX)
+	lays := []leabra.LeabraLayer{gpe, gpi, cin}
+	for gi := range mtxGos {
+		lays = append(lays, mtxGos[gi], mtxNoGos[gi])
+	}
+	return lays
+}
+
+// AddPFCLayerDyns adds a PFCLayer, super and deep, of given size, with given name,
+// and given deep maintenance dynamics.  See pbwm.AddPFCLayerDyns.
+// Returns [super, deep] layers.
+func (pb *PBWMBuilder) AddPFCLayerDyns(name string, nY, nX, nNeurY, nNeurX int, out bool, dyns pbwm.PFCDyns) []leabra.LeabraLayer {
+	sp, dp := pbwm.AddPFCLayerDyns(pb.Net, name, nY, nX, nNeurY, nNeurX, out, dyns)
+	return []leabra.LeabraLayer{sp, dp}
+}
+
+// AddPFCGroups adds PFC super and deep layers for each of given gating groups.
+// See pbwm.AddPFCGroups.
+// Returns [super, deep] layers for each group in order.
+func (pb *PBWMBuilder) AddPFCGroups(prefix string, nY int, groups []pbwm.GateGroup, nNeurY, nNeurX int) []leabra.LeabraLayer {
+	return pbwm.AddPFCGroups(pb.Net, prefix, nY, groups, nNeurY, nNeurX)
+}
+
+// AddPBWMGroups adds a DorsalBG and PFC layers for given gating groups.
+// See pbwm.AddPBWMGroups.
+// Returns [gpe, gpi, cin], followed by [mtxGo, mtxNoGo] layers for each group,
+// then [super, deep] layers for each group.
+func (pb *PBWMBuilder) AddPBWMGroups(prefix string, nY int, groups []pbwm.GateGroup, nNeurBgY, nNeurBgX, nNeurPfcY, nNeurPfcX int) []leabra.LeabraLayer {
+	mtxGos, mtxNoGos, gpe, gpi, cin, pfcs := pbwm.AddPBWMGroups(pb.Net, prefix, nY, groups, nNeurBgY, nNeurBgX, nNeurPfcY, nNeurPfcX)
+	lays := []leabra.LeabraLayer{gpe, gpi, cin}
+	for gi := range mtxGos {
+		lays = append(lays, mtxGos[gi], mtxNoGos[gi])
+	}
+	return append(lays, pfcs...)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netbuild
+
+import (
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/ccnlab/leabrax/pcore"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+)
+
+// PCoreBuilder provides the pcore package network configuration helpers.
+type PCoreBuilder struct {
+	Net *leabra.Network `desc:"the network being built"`
+}
+
+// AddCINLayer adds a CINLayer, with a single neuron.
+func (pb *PCoreBuilder) AddCINLayer(name string) *pcore.CINLayer {
+	return pcore.AddCINLayer(pb.Net, name)
+}
+
+// AddMatrixLayer adds a MatrixLayer of given size, with given name.
+// See pcore.AddMatrixLayer.
+func (pb *PCoreBuilder) AddMatrixLayer(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int, da pcore.DaReceptors) *pcore.MatrixLayer {
+	return pcore.AddMatrixLayer(pb.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX, da)
+}
+
+// ConnectToMatrix adds a MatrixTracePrjn from given sending layer to a matrix layer
+func (pb *PCoreBuilder) ConnectToMatrix(send, recv emer.Layer, pat prjn.Pattern) emer.Prjn {
+	return pcore.ConnectToMatrix(pb.Net, send, recv, pat)
+}
+
+// AddGPeLayer adds a GPLayer to serve as a GPe layer, with given name.
+// See pcore.AddGPeLayer.
+func (pb *PCoreBuilder) AddGPeLayer(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int) *pcore.GPLayer {
+	return pcore.AddGPeLayer(pb.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX)
+}
+
+// AddGPiLayer adds a GPiLayer, with given name.  See pcore.AddGPiLayer.
+func (pb *PCoreBuilder) AddGPiLayer(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int) *pcore.GPiLayer {
+	return pcore.AddGPiLayer(pb.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX)
+}
+
+// AddSTNLayer adds a subthalamic nucleus Layer, with given name.
+// See pcore.AddSTNLayer.
+func (pb *PCoreBuilder) AddSTNLayer(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int) *pcore.STNLayer {
+	return pcore.AddSTNLayer(pb.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX)
+}
+
+// AddVThalLayer adds a ventral thalamus (VA/VL/VM) Layer, with given name.
+// See pcore.AddVThalLayer.
+func (pb *PCoreBuilder) AddVThalLayer(name string, nPoolsY, nPoolsX, nNeurY, nNeurX int) *pcore.VThalLayer {
+	return pcore.AddVThalLayer(pb.Net, name, nPoolsY, nPoolsX, nNeurY, nNeurX)
+}
+
+// AddBG adds MtxGo, No, CIN, GPeOut, GPeIn, GPeTA, STNp, STNs, GPi, and VThal layers,
+// with given optional prefix.  See pcore.AddBG.
+// Returns [mtxGo, mtxNo, cin, gpeOut, gpeIn, gpeTA, stnp, stns, gpi, vthal] layers.
+func (pb *PCoreBuilder) AddBG(prefix string, nPoolsY, nPoolsX, nNeurY, nNeurX int, space float32) []leabra.LeabraLayer {
+	mtxGo, mtxNo, cin, gpeOut, gpeIn, gpeTA, stnp, stns, gpi, vthal := pcore.AddBG(pb.Net, prefix, nPoolsY, nPoolsX, nNeurY, nNeurX, space)
+	return []leabra.LeabraLayer{mtxGo, mtxNo, cin, gpeOut, gpeIn, gpeTA, stnp, stns, gpi, vthal}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netbuild
+
+import (
+	"github.com/ccnlab/leabrax/pvlv"
+	"github.com/emer/emergent/emer"
+)
+
+// PVLVBuilder provides the pvlv package network configuration helpers.
+// These require the network to be a pvlv.Network, so NetBuilder.PVLV
+// is nil for any other network.
+type PVLVBuilder struct {
+	Net *pvlv.Network `desc:"the network being built"`
+}
+
+// AddVTALayer adds a positive or negative Valence VTA layer
+func (pb *PVLVBuilder) AddVTALayer(name string, val pvlv.Valence) *pvlv.VTALayer {
+	return pvlv.AddVTALayer(pb.Net, name, val)
+}
+
+// AddLHbRMTgLayer adds a lateral habenula / RMTg layer
+func (pb *PVLVBuilder) AddLHbRMTgLayer(name string) *pvlv.LHbRMTgLayer {
+	return pvlv.AddLHbRMTgLayer(pb.Net, name)
+}
+
+// AddPPTgLayer adds a Pedunculopontine Gyrus layer.
+func (pb *PVLVBuilder) AddPPTgLayer(name string, nY, nX int) *pvlv.PPTgLayer {
+	return pvlv.AddPPTgLayer(pb.Net, name, nY, nX)
+}
+
+// AddPVLayer adds a primary value (PV) layer of given layer type.
+func (pb *PVLVBuilder) AddPVLayer(name string, nY, nX int, typ emer.LayerType) *pvlv.PVLayer {
+	return pvlv.AddPVLayer(pb.Net, name, nY, nX, typ)
+}
+
+// AddMSNLayer adds a MSNLayer of given size, with given name.
+// See pvlv.AddMSNLayer.
+func (pb *PVLVBuilder) AddMSNLayer(name string, nY, nX, nNeurY, nNeurX int, cpmt pvlv.StriatalCompartment, da pvlv.DaRType) *pvlv.MSNLayer {
+	return pvlv.AddMSNLayer(pb.Net, name, nY, nX, nNeurY, nNeurX, cpmt, da)
+}
+
+// AddCElAmygLayer adds a CentroLateral Amygdala layer with specified 4D geometry,
+// acquisition/extinction, valence, and DA receptor type.
+// See pvlv.Network.AddCElAmygLayer.
+func (pb *PVLVBuilder) AddCElAmygLayer(name string, nY, nX, nNeurY, nNeurX int, acqExt pvlv.AcqExt, val pvlv.Valence, dar pvlv.DaRType) *pvlv.CElAmygLayer {
+	return pb.Net.AddCElAmygLayer(name, nY, nX, nNeurY, nNeurX, acqExt, val, dar)
+}
+
+// AddBlAmygLayer adds a Basolateral Amygdala layer with specified 4D geometry,
+// valence, DA receptor type and layer type.
+// See pvlv.Network.AddBlAmygLayer.
+func (pb *PVLVBuilder) AddBlAmygLayer(name string, nY, nX, nNeurY, nNeurX int, val pvlv.Valence, dar pvlv.DaRType, lTyp emer.LayerType) *pvlv.BlAmygLayer {
+	return pb.Net.AddBlAmygLayer(name, nY, nX, nNeurY, nNeurX, val, dar, lTyp)
+}
+
+// ConnectLayersActMod adds rcvr as a modulatory receiver of sender's activity,
+// with given scale.
+func (pb *PVLVBuilder) ConnectLayersActMod(sender pvlv.ModSender, rcvr pvlv.ModReceiver, scale float32) {
+	pb.Net.ConnectLayersActMod(sender, rcvr, scale)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netbuild
+
+import (
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/ccnlab/leabrax/rl"
+	"github.com/emer/emergent/relpos"
+)
+
+// RLBuilder provides the rl package network configuration helpers.
+type RLBuilder struct {
+	Net *leabra.Network `desc:"the network being built"`
+}
+
+// AddClampDaLayer adds a ClampDaLayer of given name
+func (rb *RLBuilder) AddClampDaLayer(name string) *rl.ClampDaLayer {
+	return rl.AddClampDaLayer(rb.Net, name)
+}
+
+// AddTDLayers adds the standard TD temporal differences layers, generating a DA signal.
+// See rl.AddTDLayers.
+// Returns [rew, rp, ri, td] layers.
+func (rb *RLBuilder) AddTDLayers(prefix string, rel relpos.Relations, space float32) []leabra.LeabraLayer {
+	rew, rp, ri, td := rl.AddTDLayers(rb.Net, prefix, rel, space)
+	return []leabra.LeabraLayer{rew, rp, ri, td}
+}
+
+// AddRWLayers adds simple Rescorla-Wagner (PV only) dopamine system.
+// See rl.AddRWLayers.
+// Returns [rew, rp, da] layers.
+func (rb *RLBuilder) AddRWLayers(prefix string, rel relpos.Relations, space float32) []leabra.LeabraLayer {
+	rew, rp, da := rl.AddRWLayers(rb.Net, prefix, rel, space)
+	return []leabra.LeabraLayer{rew, rp, da}
+}
+
+// AddTDLayersScalar adds population-coded TD temporal differences layers,
+// generating a DA signal.  See rl.AddTDLayersScalar.
+// Returns [rew, rp, ri, td] layers.
+func (rb *RLBuilder) AddTDLayersScalar(prefix string, rel relpos.Relations, space float32, nUnits int) []leabra.LeabraLayer {
+	rew, rp, ri, td := rl.AddTDLayersScalar(rb.Net, prefix, rel, space, nUnits)
+	return []leabra.LeabraLayer{rew, rp, ri, td}
+}
+
+// AddRWLayersScalar adds a population-coded Rescorla-Wagner (PV only) dopamine system.
+// See rl.AddRWLayersScalar.
+// Returns [rew, rp, da] layers.
+func (rb *RLBuilder) AddRWLayersScalar(prefix string, rel relpos.Relations, space float32, nUnits int) []leabra.LeabraLayer {
+	rew, rp, da := rl.AddRWLayersScalar(rb.Net, prefix, rel, space, nUnits)
+	return []leabra.LeabraLayer{rew, rp, da}
+}
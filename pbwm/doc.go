@@ -41,7 +41,7 @@ Naming rule: DA when a singleton, DaMod (lowercase a) when CamelCased with somet
 
 * MatrixLayer for dorsal striatum gating of DLPFC areas, separate D1R = Go, D2R = NoGo
 	Each layer contains Maint and Out GateTypes, as function of outer 4D Pool X dimension
-	(Maint on the left, Out on the right)
+	(Maint on the left, Out on the right by default), or one Maint or Out GateGroup in GateShape
 
 * GPiThalLayer receives from Matrix Go and GPe NoGo to compute final WTA gating, and
    broadcasts GateState info to its SendTo layers.  See Timing params for timing.
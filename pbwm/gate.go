@@ -10,26 +10,130 @@ import (
 	"github.com/goki/ki/kit"
 )
 
+// GateGroup is a named group of gating pools (stripes) arrayed along the X axis
+// of the overall GateShape, with its own type of gating.  Each group is typically
+// gated by its own Matrix Go and NoGo layers into its own PFC layer,
+// all named with the group Name as a suffix.
+type GateGroup struct {
+	Name  string    `desc:"name of the group -- the Matrix and PFC layers for this group are named with this suffix (e.g., MatrixGomnt, MatrixNoGomnt, PFCmnt, PFCmntD)"`
+	Type  GateTypes `desc:"type of gating for this group: Maint or Out"`
+	X     int       `desc:"number of pools in the X dimension for this group"`
+	Dyns  PFCDyns   `desc:"PFC maintenance dynamics for this group -- if empty, the default maintenance-only dynamics are used"`
+	Maint string    `desc:"for Out groups, name of the Maint group whose corresponding pools (by index) are cleared by output gating in this group (see OutClearMaint) -- if empty, the first Maint group is used"`
+	StX   int       `inactive:"+" desc:"starting X index of this group in the overall gating shape -- computed in SetGroups"`
+}
+
 // GateShape defines the shape of the outer pool dimensions of gating layers,
-// organized into Maint and Out subsets which are arrayed along the X axis
-// with Maint first (to the left) then Out.  Individual layers may only
-// represent Maint or Out subsets of this overall shape, but all need
-// to have this coordinated shape information to be able to share gating
-// state information.  Each layer represents gate state information in
-// their native geometry -- FullIndex1D provides access from a subset
-// to full set.
+// organized into named groups of Maint or Out gating pools which are arrayed
+// along the X axis in order.  The standard configuration has one Maint group
+// first (to the left) then one Out group.  Individual layers may only
+// represent one group, or the Maint or Out subsets of this overall shape,
+// but all need to have this coordinated shape information to be able to share
+// gating state information.  Each layer represents gate state information in
+// their native geometry -- FullIndex1D and FullIndex1DGroup provide access
+// from a subset to full set.
 type GateShape struct {
-	Y      int `desc:"overall shape dimensions for the full set of gating pools, e.g., as present in the Matrix and GPiThal levels"`
-	MaintX int `desc:"how many pools in the X dimension are Maint gating pools -- rest are Out"`
-	OutX   int `desc:"how many pools in the X dimension are Out gating pools -- comes after Maint"`
+	Y      int         `desc:"overall shape dimensions for the full set of gating pools, e.g., as present in the Matrix and GPiThal levels"`
+	MaintX int         `desc:"how many pools in the X dimension are Maint gating pools -- total across all Maint groups"`
+	OutX   int         `desc:"how many pools in the X dimension are Out gating pools -- total across all Out groups"`
+	Groups []GateGroup `desc:"groups of gating pools arrayed in order along the X axis"`
 }
 
 // Set sets the shape parameters: number of Y dimension pools, and
-// numbers of maint and out pools along X axis
+// numbers of maint and out pools along X axis, using the standard
+// groups: mnt (Maint) followed by out (Out)
 func (gs *GateShape) Set(nY, maintX, outX int) {
+	var grps []GateGroup
+	if maintX > 0 {
+		grps = append(grps, GateGroup{Name: "mnt", Type: Maint, X: maintX})
+	}
+	if outX > 0 {
+		grps = append(grps, GateGroup{Name: "out", Type: Out, X: outX})
+	}
+	gs.SetGroups(nY, grps)
+}
+
+// SetGroups sets the shape from given number of Y dimension pools,
+// and given groups arrayed in order along the X axis, which must each
+// be of type Maint or Out.
+func (gs *GateShape) SetGroups(nY int, grps []GateGroup) {
 	gs.Y = nY
-	gs.MaintX = maintX
-	gs.OutX = outX
+	gs.Groups = make([]GateGroup, len(grps))
+	copy(gs.Groups, grps)
+	gs.MaintX = 0
+	gs.OutX = 0
+	stx := 0
+	for gi := range gs.Groups {
+		gp := &gs.Groups[gi]
+		gp.StX = stx
+		stx += gp.X
+		if gp.Type == Out {
+			gs.OutX += gp.X
+		} else {
+			gs.MaintX += gp.X
+		}
+	}
+}
+
+// GroupByName returns the index of the group with given name, -1 if not found
+func (gs *GateShape) GroupByName(name string) int {
+	for gi := range gs.Groups {
+		if gs.Groups[gi].Name == name {
+			return gi
+		}
+	}
+	return -1
+}
+
+// MaintGroup returns the index of the Maint group whose maintenance is cleared
+// by output gating in given Out group index, -1 if none (see GateGroup Maint).
+func (gs *GateShape) MaintGroup(grp int) int {
+	gp := &gs.Groups[grp]
+	if gp.Type != Out {
+		return -1
+	}
+	if gp.Maint != "" {
+		return gs.GroupByName(gp.Maint)
+	}
+	for gi := range gs.Groups {
+		if gs.Groups[gi].Type == Maint {
+			return gi
+		}
+	}
+	return -1
+}
+
+// GroupAtX returns the index of the group containing given X pool index, -1 if none
+func (gs *GateShape) GroupAtX(pX int) int {
+	for gi := range gs.Groups {
+		gp := &gs.Groups[gi]
+		if pX >= gp.StX && pX < gp.StX+gp.X {
+			return gi
+		}
+	}
+	return -1
+}
+
+// typeX returns the full X index of the tX'th pool of given type (Maint or Out),
+// counting across all the groups of that type in order.
+func (gs *GateShape) typeX(tX int, typ GateTypes) int {
+	if len(gs.Groups) == 0 { // not configured via Set: Maint then Out
+		if typ == Out {
+			return tX + gs.MaintX
+		}
+		return tX
+	}
+	for gi := range gs.Groups {
+		gp := &gs.Groups[gi]
+		if (gp.Type == Out) != (typ == Out) {
+			continue
+		}
+		if tX < gp.X {
+			return gp.StX + tX
+		}
+		tX -= gp.X
+	}
+	return 0
 }
 
 // TotX returns the total number of X-axis pools (Maint + Out)
@@ -67,7 +171,7 @@ func (gs *GateShape) FullIndex1D(idx int, fmTyp GateTypes) int {
 		}
 		// convert to 2D and use that
 		pY := idx / gs.MaintX
-		pX := idx % gs.MaintX
+		pX := gs.typeX(idx%gs.MaintX, Maint)
 		return gs.Index(pY, pX, MaintOut)
 	case Out:
 		if gs.OutX == 0 {
@@ -75,7 +179,7 @@ func (gs *GateShape) FullIndex1D(idx int, fmTyp GateTypes) int {
 		}
 		// convert to 2D and use that
 		pY := idx / gs.OutX
-		pX := idx%gs.OutX + gs.MaintX
+		pX := gs.typeX(idx%gs.OutX, Out)
 		return gs.Index(pY, pX, MaintOut)
 	case MaintOut:
 		return idx
@@ -83,6 +187,18 @@ func (gs *GateShape) FullIndex1D(idx int, fmTyp GateTypes) int {
 	return 0
 }
 
+// FullIndex1DGroup returns the index into full MaintOut GateStates
+// for given 1D pool idx (0-based) *from given group* index.
+func (gs *GateShape) FullIndex1DGroup(idx int, grp int) int {
+	gp := &gs.Groups[grp]
+	if gp.X == 0 {
+		return 0
+	}
+	pY := idx / gp.X
+	pX := idx%gp.X + gp.StX
+	return gs.Index(pY, pX, MaintOut)
+}
+
 //////////////////////////////////////////////////////////////////////////////
 // GateState
 
@@ -115,6 +231,7 @@ func (gs *GateState) CopyFrom(fm *GateState) {
 type GateLayer struct {
 	Layer
 	GateShp    GateShape   `desc:"shape of overall Maint + Out gating system that this layer is part of"`
+	GateGrp    string      `desc:"name of the gating group in GateShp.Groups that this layer represents -- if empty, the layer represents all the pools of its GateType"`
 	GateStates []GateState `desc:"slice of gating state values for this layer, one for each separate gating pool, according to its GateType.  For MaintOut, it is ordered such that 0:MaintN are Maint and MaintN:n are Out"`
 }
 
@@ -154,10 +271,19 @@ func (ly *GateLayer) SetGateStates(states []GateState, typ GateTypes) {
 			ly.SetGateState(i, &states[i])
 		}
 	default: // typ == MaintOut, myt = Maint or Out
+		grp := -1
+		if ly.GateGrp != "" {
+			grp = ly.GateShp.GroupByName(ly.GateGrp)
+		}
 		mx := len(ly.GateStates)
 		for i := 0; i < mx; i++ {
 			gs := &ly.GateStates[i]
-			si := ly.GateShp.FullIndex1D(i, myt)
+			var si int
+			if grp >= 0 {
+				si = ly.GateShp.FullIndex1DGroup(i, grp)
+			} else {
+				si = ly.GateShp.FullIndex1D(i, myt)
+			}
 			src := &states[si]
 			gs.CopyFrom(src)
 		}
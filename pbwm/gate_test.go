@@ -6,6 +6,8 @@ package pbwm
 
 import (
 	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
 )
 
 func TestShape(t *testing.T) {
@@ -30,3 +32,146 @@ func TestShape(t *testing.T) {
 		// fmt.Printf("%v \t %v\n", i, fi)
 	}
 }
+
+func TestShapeGroups(t *testing.T) {
+	gs := GateShape{}
+	gs.SetGroups(2, []GateGroup{{Name: "mntA", Type: Maint, X: 2}, {Name: "out", Type: Out, X: 1}, {Name: "mntB", Type: Maint, X: 2}})
+	if gs.TotX() != 5 || gs.MaintX != 4 || gs.OutX != 1 {
+		t.Errorf("shape: TotX: %d MaintX: %d OutX: %d", gs.TotX(), gs.MaintX, gs.OutX)
+	}
+	ans := []int{0, 1, 3, 4, 5, 6, 8, 9}
+	for i := 0; i < gs.Y*gs.MaintX; i++ {
+		fi := gs.FullIndex1D(i, Maint)
+		if fi != ans[i] {
+			t.Errorf("Maint idx: %v: %v != %v\n", i, fi, ans[i])
+		}
+	}
+	ans = []int{3, 4, 8, 9}
+	gi := gs.GroupByName("mntB")
+	for i := 0; i < gs.Y*gs.Groups[gi].X; i++ {
+		fi := gs.FullIndex1DGroup(i, gi)
+		if fi != ans[i] {
+			t.Errorf("mntB idx: %v: %v != %v\n", i, fi, ans[i])
+		}
+	}
+	if gs.GroupAtX(2) != gs.GroupByName("out") {
+		t.Errorf("GroupAtX(2): %d", gs.GroupAtX(2))
+	}
+
+	std := GateShape{}
+	std.Set(2, 3, 2)
+	gs.SetGroups(2, []GateGroup{{Name: "mnt", Type: Maint, X: 3}, {Name: "out", Type: Out, X: 2}})
+	for i := 0; i < 4; i++ {
+		if std.FullIndex1D(i, Out) != gs.FullIndex1DGroup(i, 1) {
+			t.Errorf("Out idx: %v: %v != %v\n", i, std.FullIndex1D(i, Out), gs.FullIndex1DGroup(i, 1))
+		}
+	}
+}
+
+func TestPBWMGroups(t *testing.T) {
+	net := &Network{}
+	net.InitName(net, "PBWMGroups")
+	var slow, fast PFCDyns
+	slow.MaintOnly()
+	fast.FullDyn(5)
+	groups := []GateGroup{{Name: "mntA", Type: Maint, X: 2, Dyns: slow}, {Name: "mntB", Type: Maint, X: 1, Dyns: fast}, {Name: "out", Type: Out, X: 1, Maint: "mntB"}}
+	mtxGos, mtxNoGos, _, gpii, _, pfcs := net.AddPBWMGroups("", 1, groups, 1, 2, 2, 2)
+	if len(pfcs) != 6 {
+		t.Fatalf("AddPBWMGroups returned: %d PFC layers, not 6", len(pfcs))
+	}
+	if len(mtxGos) != 3 || len(mtxNoGos) != 3 {
+		t.Fatalf("AddPBWMGroups returned: %d Go, %d NoGo Matrix layers, not 3", len(mtxGos), len(mtxNoGos))
+	}
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	gpi := gpii.(*GPiThalLayer)
+	if len(gpi.GateStates) != 4 || len(gpi.SendTo) != 9 {
+		t.Errorf("GPiThal GateStates: %d SendTo: %v", len(gpi.GateStates), gpi.SendTo)
+	}
+	goPrjns, nogoPrjns, err := gpi.MatrixPrjns()
+	if err != nil || len(goPrjns) != 3 || len(nogoPrjns) != 1 {
+		t.Errorf("GPiThal MatrixPrjns: %d Go %d NoGo, err: %v", len(goPrjns), len(nogoPrjns), err)
+	}
+	mtxB := net.LayerByName("MatrixGomntB").(*MatrixLayer)
+	if mtxB.Shp.Dim(1) != 1 || mtxB.GateType() != Maint || net.LayerByName("MatrixNoGoout").(*MatrixLayer).GateType() != Out {
+		t.Errorf("MatrixGomntB shape: %v GateType: %v", mtxB.Shp.Shp, mtxB.GateType())
+	}
+	// each Go pool drives only its own group's GPiThal pool
+	for gi, pj := range goPrjns {
+		mly := pj.SendLay().(*MatrixLayer)
+		gp := &gpi.GateShp.Groups[gpi.GateShp.GroupByName(mly.GateGrp)]
+		for ri := range gpi.Neurons {
+			px := int(gpi.Neurons[ri].SubPool) - 1
+			in := px >= gp.StX && px < gp.StX+gp.X
+			if (pj.RConN[ri] > 0) != in {
+				t.Errorf("Go prjn: %d from: %s recv pool: %d has %d cons", gi, mly.Name(), px, pj.RConN[ri])
+			}
+		}
+	}
+	mntB := net.LayerByName("PFCmntBD").(*PFCDeepLayer)
+	if mntB.Shp.Dim(2) != 5*2 || len(mntB.Dyns) != 5 || mntB.GateType() != Maint {
+		t.Errorf("PFCmntBD shape: %v Dyns: %d", mntB.Shp.Shp, len(mntB.Dyns))
+	}
+	out := net.LayerByName("PFCoutD").(*PFCDeepLayer)
+	if out.GateType() != Out {
+		t.Errorf("PFCoutD GateType: %v", out.GateType())
+	}
+
+	ltime := leabra.NewTime()
+	net.AlphaCycInit()
+	for cyc := 0; cyc < 10; cyc++ { // runs GPiThal from all the Go prjns
+		net.Cycle(ltime)
+		ltime.CycleInc()
+	}
+
+	for gi := range gpi.GateStates {
+		gs := &gpi.GateStates[gi]
+		gs.Now = true
+		gs.Act = float32(gi + 1)
+	}
+	gpi.SendGateStates()
+	ans := map[string][]float32{"PFCmntAD": {1, 2}, "PFCmntBD": {3}, "PFCoutD": {4}, "MatrixGomntA": {1, 2}, "MatrixNoGomntB": {3}, "MatrixGoout": {4}}
+	for lnm, acts := range ans {
+		gl := net.LayerByName(lnm).(GateLayerer).AsGate()
+		for i, act := range acts {
+			if gl.GateStates[i].Act != act {
+				t.Errorf("layer: %s GateState: %d Act: %g != %g", lnm, i, gl.GateStates[i].Act, act)
+			}
+		}
+	}
+}
+
+// TestPBWMGroupsOutClearMaint checks that output gating clears maintenance
+// in the Maint group of its GateGroup
+func TestPBWMGroupsOutClearMaint(t *testing.T) {
+	net := &Network{}
+	net.InitName(net, "PBWMGroups")
+	groups := []GateGroup{{Name: "mntA", Type: Maint, X: 1}, {Name: "mntB", Type: Maint, X: 1}, {Name: "out", Type: Out, X: 1, Maint: "mntB"}}
+	net.AddPBWMGroups("", 1, groups, 1, 2, 2, 2)
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	mntA := net.LayerByName("PFCmntAD").(*PFCDeepLayer)
+	mntB := net.LayerByName("PFCmntBD").(*PFCDeepLayer)
+	out := net.LayerByName("PFCoutD").(*PFCDeepLayer)
+	if out.MaintPFC() != mntB || mntA.MaintPFC() != nil {
+		t.Errorf("MaintPFC: %v != PFCmntBD", out.MaintPFC())
+	}
+	out.Maint.OutClearMaint = true
+	mntA.GateStates[0].Cnt = 2 // established maintenance in both
+	mntB.GateStates[0].Cnt = 2
+	gs := &out.GateStates[0]
+	gs.Now = true
+	gs.Act = 1
+	ltime := leabra.NewTime()
+	out.Gating(ltime)
+	if mntB.GateStates[0].Cnt != -1 {
+		t.Errorf("output gating did not clear PFCmntBD: Cnt: %d", mntB.GateStates[0].Cnt)
+	}
+	if mntA.GateStates[0].Cnt != 2 {
+		t.Errorf("output gating cleared other group PFCmntAD: Cnt: %d", mntA.GateStates[0].Cnt)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbwm
+
+import (
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/etable/etable"
+)
+
+// GatingMonitor monitors the GPiThal layer of a BG circuit over the cycles
+// of a trial, and reports which pool(s) gated, the cycle of gating
+// (reaction time), the gating strength, and whether gating failed,
+// in the embedded leabra.GateStats.
+// Pools are indexed as in GPiThalLayer GateStates (in GateShape X order).
+// Gating is determined by the GateState at the gating cycle of each gating
+// quarter, and the reaction time is the cycle when the pool activation
+// first exceeded the gating threshold within that quarter.
+// Call Init at the start of each trial, Cycle after each network Cycle,
+// and TrialEnd at the end of the trial, after which the results are available
+// as fields and can be recorded in a log with LogTrial.
+type GatingMonitor struct {
+	leabra.GateStats
+	Lay    *GPiThalLayer `view:"-" desc:"the GPiThal layer being monitored"`
+	ThrCyc []int         `view:"-" desc:"for each pool, cycle within the current quarter when activation first exceeded the gating threshold, -1 if not"`
+}
+
+// Config configures the monitor for given GPiThal layer, which must already be built.
+func (gm *GatingMonitor) Config(ly *GPiThalLayer) {
+	gm.Lay = ly
+	np := len(ly.GateStates)
+	gm.ThrCyc = make([]int, np)
+	gm.GateStats.Config(np)
+}
+
+// Init initializes the state at the start of a trial
+func (gm *GatingMonitor) Init() {
+	gm.GateStats.Init()
+	for pi := range gm.ThrCyc {
+		gm.ThrCyc[pi] = -1
+	}
+}
+
+// Cycle updates the monitor from current GPiThal activations and GateStates --
+// call after each network Cycle.
+func (gm *GatingMonitor) Cycle(ltime *leabra.Time) {
+	ly := gm.Lay
+	gateQtr := ly.Timing.GateQtr.Has(ltime.Quarter)
+	qtrCyc := ltime.QuarterCycle()
+	for pi := range gm.GateCyc {
+		if qtrCyc == 0 {
+			gm.ThrCyc[pi] = -1
+		}
+		act := ly.Pools[pi+1].Inhib.Act.Max
+		if act > gm.MaxAct[pi] {
+			gm.MaxAct[pi] = act
+		}
+		if gateQtr && gm.ThrCyc[pi] < 0 && act >= ly.Gate.Thr {
+			gm.ThrCyc[pi] = ltime.Cycle
+		}
+		gs := &ly.GateStates[pi]
+		if !gs.Now || gs.Cnt != 0 || gm.GateCyc[pi] >= 0 {
+			continue
+		}
+		gm.GateAct[pi] = gs.Act
+		gm.GateCyc[pi] = gm.ThrCyc[pi]
+		if gm.GateCyc[pi] < 0 {
+			gm.GateCyc[pi] = ltime.Cycle
+		}
+	}
+}
+
+// ConfigLog configures given table with the LogSchema columns only
+func (gm *GatingMonitor) ConfigLog(dt *etable.Table) {
+	gm.GateStats.ConfigLog(dt, gm.Lay.Name())
+}
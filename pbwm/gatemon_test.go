@@ -0,0 +1,86 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbwm
+
+import (
+	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/etable/etable"
+	"github.com/emer/etable/etensor"
+)
+
+func TestGatingMonitor(t *testing.T) {
+	ly := &GPiThalLayer{}
+	ly.Timing.Defaults()
+	ly.Gate.Defaults()
+	np := 3
+	ly.Pools = make([]leabra.Pool, np+1)
+	ly.GateStates = make([]GateState, np)
+	for pi := range ly.GateStates {
+		ly.GateStates[pi].Init()
+	}
+
+	gm := &GatingMonitor{}
+	gm.Config(ly)
+
+	// pool 1 crosses threshold at cycle 5, pool 2 at cycle 10, pool 0 never
+	ltime := leabra.NewTime()
+	ltime.AlphaCycStart()
+	gm.Init()
+	for cyc := 0; cyc < ltime.CycPerQtr; cyc++ {
+		switch {
+		case cyc >= 10:
+			ly.Pools[3].Inhib.Act.Max = 0.3
+			fallthrough
+		case cyc >= 5:
+			ly.Pools[2].Inhib.Act.Max = 0.5
+		}
+		ly.Pools[1].Inhib.Act.Max = 0.1
+		for pi := range ly.GateStates {
+			gs := &ly.GateStates[pi]
+			gs.Now = cyc == ly.Timing.Cycle
+			if gs.Now {
+				if ly.Pools[pi+1].Inhib.Act.Max >= ly.Gate.Thr {
+					gs.Cnt = 0
+					gs.Act = ly.Pools[pi+1].Inhib.Act.Max
+				} else {
+					gs.Cnt--
+				}
+			}
+		}
+		gm.Cycle(ltime)
+		ltime.CycleInc()
+	}
+	gm.TrialEnd()
+
+	if gm.Failed || gm.Pool != 1 || gm.RT != 5 || gm.Strength != 0.5 || gm.NGated != 2 {
+		t.Errorf("gating results: Failed: %v Pool: %d RT: %d Strength: %g NGated: %d", gm.Failed, gm.Pool, gm.RT, gm.Strength, gm.NGated)
+	}
+	if gm.GateCyc[0] != -1 || gm.GateCyc[2] != 10 {
+		t.Errorf("GateCyc: %v", gm.GateCyc)
+	}
+
+	dt := &etable.Table{}
+	gm.ConfigLog(dt)
+	gm.LogTrial(dt, 0)
+	if dt.Rows != 1 || dt.CellFloat("GateRT", 0) != 5 || dt.CellTensor("GateCyc", 0).FloatVal1D(2) != 10 {
+		t.Errorf("log not recorded: %v", dt)
+	}
+	if dt.CellFloat("GateFailed", 0) != 0 || dt.CellTensor("GateMaxAct", 0).FloatVal1D(2) != float64(float32(0.3)) {
+		t.Errorf("log not recorded: %v", dt)
+	}
+	for _, cn := range []string{"GatePool", "GateRT", "GateFailed", "NGated", "GateCyc"} {
+		if dt.ColByName(cn).DataType() != etensor.INT64 {
+			t.Errorf("log column: %s type: %v != INT64", cn, dt.ColByName(cn).DataType())
+		}
+	}
+
+	gm.Init()
+	gm.TrialEnd()
+	if !gm.Failed || gm.Pool != -1 || gm.RT != -1 {
+		t.Errorf("no gating results: Failed: %v Pool: %d RT: %d", gm.Failed, gm.Pool, gm.RT)
+	}
+}
@@ -151,28 +151,35 @@ func (ly *GPiThalLayer) UnitValByIdx(vidx NeurVars, idx int) float32 {
 	return gnrn.ActG
 }
 
-// SendToMatrixPFC adds standard SendTo layers for PBWM: MatrixGo, NoGo, PFCmntD, PFCoutD
-// with optional prefix -- excludes mnt, out cases if corresp shape = 0
+// SendToMatrixPFC adds standard SendTo layers for PBWM: MatrixGo, NoGo, and
+// the PFC deep layer for each gating group in GateShp (PFCmntD, PFCoutD
+// for the standard groups), with optional prefix.
 func (ly *GPiThalLayer) SendToMatrixPFC(prefix string) {
 	pfcprefix := "PFC"
 	if prefix != "" {
 		pfcprefix = prefix
 	}
-	std := []string{prefix + "MatrixGo", prefix + "MatrixNoGo", pfcprefix + "mntD", pfcprefix + "outD"}
-	ly.SendTo = make([]string, 2)
-	for i, s := range std {
-		nm := s
-		switch {
-		case i < 2:
-			ly.SendTo[i] = nm
-		case i == 2:
-			if ly.GateShp.MaintX > 0 {
-				ly.SendTo = append(ly.SendTo, nm)
-			}
-		case i == 3:
-			if ly.GateShp.OutX > 0 {
-				ly.SendTo = append(ly.SendTo, nm)
-			}
+	ly.SendTo = []string{prefix + "MatrixGo", prefix + "MatrixNoGo"}
+	for _, gp := range ly.GateShp.Groups {
+		if gp.X > 0 {
+			ly.SendTo = append(ly.SendTo, pfcprefix+gp.Name+"D")
+		}
+	}
+}
+
+// SendToMatrixPFCGroups adds SendTo layers for PBWM with separate Matrix
+// layers for each gating group in GateShp: the MatrixGo, MatrixNoGo and
+// PFC deep layer for each group (e.g., MatrixGomnt, MatrixNoGomnt, PFCmntD),
+// with optional prefix.
+func (ly *GPiThalLayer) SendToMatrixPFCGroups(prefix string) {
+	pfcprefix := "PFC"
+	if prefix != "" {
+		pfcprefix = prefix
+	}
+	ly.SendTo = nil
+	for _, gp := range ly.GateShp.Groups {
+		if gp.X > 0 {
+			ly.SendTo = append(ly.SendTo, prefix+"MatrixGo"+gp.Name, prefix+"MatrixNoGo"+gp.Name, pfcprefix+gp.Name+"D")
 		}
 	}
 }
@@ -201,8 +208,9 @@ func (ly *GPiThalLayer) SendGateShape() error {
 }
 
 // MatrixPrjns returns the recv prjns from Go and NoGo MatrixLayer pathways -- error if not
-// found or if prjns are not of the GPiThalPrjn type
-func (ly *GPiThalLayer) MatrixPrjns() (goPrjn, nogoPrjn *GPiThalPrjn, err error) {
+// found or if prjns are not of the GPiThalPrjn type.  There is one Go prjn for each
+// Matrix Go layer (e.g., one per GateGroup), and NoGo prjns are from GPe or Matrix NoGo.
+func (ly *GPiThalLayer) MatrixPrjns() (goPrjns, nogoPrjns []*GPiThalPrjn, err error) {
 	for _, p := range ly.RcvPrjns {
 		if p.IsOff() {
 			continue
@@ -214,17 +222,13 @@ func (ly *GPiThalLayer) MatrixPrjns() (goPrjn, nogoPrjn *GPiThalPrjn, err error)
 		}
 		slay := p.SendLay()
 		mlay, ok := slay.(*MatrixLayer)
-		if ok {
-			if mlay.DaR == D1R {
-				goPrjn = gp
-			} else {
-				nogoPrjn = gp
-			}
+		if ok && mlay.DaR == D1R {
+			goPrjns = append(goPrjns, gp)
 		} else {
-			nogoPrjn = gp
+			nogoPrjns = append(nogoPrjns, gp)
 		}
 	}
-	if goPrjn == nil || nogoPrjn == nil {
+	if len(goPrjns) == 0 || len(nogoPrjns) == 0 {
 		err = fmt.Errorf("GPiThalLayer must have RecvPrjn's from a MatrixLayer D1R (Go) and another NoGo layer")
 	}
 	return
@@ -298,14 +302,20 @@ func (ly *GPiThalLayer) AlphaCycInit() {
 // GFmInc integrates new synaptic conductances from increments sent during last SendGDelta.
 func (ly *GPiThalLayer) GFmInc(ltime *leabra.Time) {
 	ly.RecvGInc(ltime)
-	goPrjn, nogoPrjn, _ := ly.MatrixPrjns()
+	goPrjns, nogoPrjns, _ := ly.MatrixPrjns()
 	for ni := range ly.Neurons {
 		nrn := &ly.Neurons[ni]
 		if nrn.IsOff() {
 			continue
 		}
-		goRaw := goPrjn.GeRaw[ni]
-		nogoRaw := nogoPrjn.GeRaw[ni]
+		goRaw := float32(0)
+		for _, pj := range goPrjns {
+			goRaw += pj.GeRaw[ni]
+		}
+		nogoRaw := float32(0)
+		for _, pj := range nogoPrjns {
+			nogoRaw += pj.GeRaw[ni]
+		}
 		nrn.GeRaw = ly.Gate.GeRaw(goRaw, nogoRaw)
 		ly.Act.GeFmRaw(nrn, nrn.GeRaw)
 		ly.Act.GiFmRaw(nrn, nrn.GiRaw)
@@ -82,8 +82,15 @@ func (ly *MatrixLayer) Defaults() {
 	ly.Inhib.ActAvg.Init = 0.2
 }
 
+// GateType returns the type of the GateGrp group for a layer representing
+// one gating group, and otherwise MaintOut, for a layer with both types.
 func (ly *MatrixLayer) GateType() GateTypes {
-	return MaintOut // always both
+	if ly.GateGrp != "" {
+		if grp := ly.GateShp.GroupByName(ly.GateGrp); grp >= 0 {
+			return ly.GateShp.Groups[grp].Type
+		}
+	}
+	return MaintOut
 }
 
 // DALrnFmDA returns effective learning dopamine value from given raw DA value
@@ -175,8 +182,15 @@ func (ly *MatrixLayer) InhibFmGeAct(ltime *leabra.Time) {
 	xpN := ly.Shp.Dim(1)
 	ynN := ly.Shp.Dim(2)
 	xnN := ly.Shp.Dim(3)
+	outSt := ly.MaintN
+	switch ly.GateType() {
+	case Maint:
+		outSt = xpN
+	case Out:
+		outSt = 0
+	}
 	for yp := 0; yp < ypN; yp++ {
-		for xp := ly.MaintN; xp < xpN; xp++ {
+		for xp := outSt; xp < xpN; xp++ {
 			for yn := 0; yn < ynN; yn++ {
 				for xn := 0; xn < xnN; xn++ {
 					ni := ly.Shp.Offset([]int{yp, xp, yn, xn})
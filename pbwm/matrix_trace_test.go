@@ -0,0 +1,46 @@
+// Copyright (c) 2019, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbwm
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+)
+
+func TestTraceWts(t *testing.T) {
+	mknet := func() (*leabra.Network, *MatrixTracePrjn) {
+		net := &leabra.Network{}
+		net.InitName(net, "TraceNet")
+		inLay := net.AddLayer("Input", []int{2, 2}, emer.Input)
+		mtx := net.AddLayer("Matrix", []int{2, 2}, emer.Hidden)
+		pj := net.ConnectLayersPrjn(inLay, mtx, prjn.NewFull(), emer.Forward, &MatrixTracePrjn{}).(*MatrixTracePrjn)
+		net.Defaults()
+		net.Build()
+		net.InitWts()
+		return net, pj
+	}
+	net, pj := mknet()
+	for si := range pj.TrSyns {
+		sy := &pj.TrSyns[si]
+		sy.NTr = float32(si) * .5
+		sy.Tr = -float32(si) * .25
+	}
+	var buf bytes.Buffer
+	net.WriteWtsJSON(&buf)
+
+	net2, pj2 := mknet()
+	if err := net2.ReadWtsJSON(&buf); err != nil {
+		t.Error(err)
+	}
+	for si := range pj.TrSyns {
+		if pj2.TrSyns[si] != pj.TrSyns[si] {
+			t.Errorf("trace syn %d not restored from weights: %v != %v", si, pj2.TrSyns[si], pj.TrSyns[si])
+		}
+	}
+}
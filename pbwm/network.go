@@ -121,12 +121,43 @@ func (nt *Network) AddPFC(prefix string, nY, nMaint, nOut, nNeurY, nNeurX int, d
 	return AddPFC(&nt.Network, prefix, nY, nMaint, nOut, nNeurY, nNeurX, dynMaint)
 }
 
+// AddDorsalBGGroups adds MatrixGo and NoGo layers for each of given gating groups
+// arrayed in order along the pool X dimension (see GateGroup), and GPe, GPiThal,
+// and CIN layers spanning all the groups, with given optional prefix.
+// nY = number of pools in Y dimension, and each pool has nNeurY, nNeurX neurons.
+func (nt *Network) AddDorsalBGGroups(prefix string, nY int, groups []GateGroup, nNeurY, nNeurX int) (mtxGos, mtxNoGos []leabra.LeabraLayer, gpe, gpi, cin leabra.LeabraLayer) {
+	return AddDorsalBGGroups(&nt.Network, prefix, nY, groups, nNeurY, nNeurX)
+}
+
+// AddPFCLayerDyns adds a PFCLayer, super and deep, of given size, with given name,
+// using given maintenance dynamics in the deep layer (MaintOnly if empty).
+// See AddPFCLayer for details.
+func (nt *Network) AddPFCLayerDyns(name string, nY, nX, nNeurY, nNeurX int, out bool, dyns PFCDyns) (sp, dp leabra.LeabraLayer) {
+	return AddPFCLayerDyns(&nt.Network, name, nY, nX, nNeurY, nNeurX, out, dyns)
+}
+
+// AddPFCGroups adds PFC super and deep layers for each of given gating groups,
+// named with optional prefix (PFC by default) plus the group name.
+// Returns the super, deep layers for each group in order.
+func (nt *Network) AddPFCGroups(prefix string, nY int, groups []GateGroup, nNeurY, nNeurX int) []leabra.LeabraLayer {
+	return AddPFCGroups(&nt.Network, prefix, nY, groups, nNeurY, nNeurX)
+}
+
 // AddPBWM adds a DorsalBG and PFC with given params
 // Defaults to simple case of basic maint dynamics in Deep
 func (nt *Network) AddPBWM(prefix string, nY, nMaint, nOut, nNeurBgY, nNeurBgX, nNeurPfcY, nNeurPfcX int) (mtxGo, mtxNoGo, gpe, gpi, cin, pfcMnt, pfcMntD, pfcOut, pfcOutD leabra.LeabraLayer) {
 	return AddPBWM(&nt.Network, prefix, nY, nMaint, nOut, nNeurBgY, nNeurBgX, nNeurPfcY, nNeurPfcX)
 }
 
+// AddPBWMGroups adds a DorsalBG and PFC layers for given gating groups,
+// arrayed in order along the pool X dimension.  Each group has its own
+// Matrix Go and NoGo layers, and PFC super and deep layers, with the group's
+// gating type and dynamics.  Returns the Go and NoGo layers for each group,
+// the other BG layers, and the super, deep PFC layers for each group in order.
+func (nt *Network) AddPBWMGroups(prefix string, nY int, groups []GateGroup, nNeurBgY, nNeurBgX, nNeurPfcY, nNeurPfcX int) (mtxGos, mtxNoGos []leabra.LeabraLayer, gpe, gpi, cin leabra.LeabraLayer, pfcs []leabra.LeabraLayer) {
+	return AddPBWMGroups(&nt.Network, prefix, nY, groups, nNeurBgY, nNeurBgX, nNeurPfcY, nNeurPfcX)
+}
+
 ////////////////////////////////////////////////////////////////////////
 // Network functions available here as standalone functions
 //         for mixing in to other models
@@ -206,22 +237,33 @@ func AddDorsalBG(nt *leabra.Network, prefix string, nY, nMaint, nOut, nNeurY, nN
 // else Full set of 5 dynamic maintenance types. Both have the class "PFC" set.
 // deep is positioned behind super.
 func AddPFCLayer(nt *leabra.Network, name string, nY, nX, nNeurY, nNeurX int, out, dynMaint bool) (sp, dp leabra.LeabraLayer) {
+	var dyns PFCDyns
+	if dynMaint {
+		dyns.MaintOnly()
+	} else {
+		dyns.FullDyn(10)
+	}
+	return AddPFCLayerDyns(nt, name, nY, nX, nNeurY, nNeurX, out, dyns)
+}
+
+// AddPFCLayerDyns adds a PFCLayer, super and deep, of given size, with given name,
+// using given maintenance dynamics in the deep layer (MaintOnly if empty).
+// nY, nX = number of pools in Y, X dimensions, and each pool has nNeurY, nNeurX neurons,
+// with deep having len(dyns) * nNeurY neurons in the Y dimension.
+// out is true for output-gating layer. Both have the class "PFC" set.
+// deep is positioned behind super.
+func AddPFCLayerDyns(nt *leabra.Network, name string, nY, nX, nNeurY, nNeurX int, out bool, dyns PFCDyns) (sp, dp leabra.LeabraLayer) {
+	if len(dyns) == 0 {
+		dyns.MaintOnly()
+	}
 	sp = nt.AddLayer(name, []int{nY, nX, nNeurY, nNeurX}, emer.Hidden).(leabra.LeabraLayer)
 	ddp := &PFCDeepLayer{}
 	dp = ddp
-	dym := 1
-	if !dynMaint {
-		dym = 5
-	}
-	nt.AddLayerInit(ddp, name+"D", []int{nY, nX, dym * nNeurY, nNeurX}, emer.Hidden)
+	nt.AddLayerInit(ddp, name+"D", []int{nY, nX, len(dyns) * nNeurY, nNeurX}, emer.Hidden)
 	sp.SetClass("PFC")
 	ddp.SetClass("PFC")
 	ddp.Gate.OutGate = out
-	if dynMaint {
-		ddp.Dyns.MaintOnly()
-	} else {
-		ddp.Dyns.FullDyn(10)
-	}
+	ddp.Dyns = dyns
 	dp.SetRelPos(relpos.Rel{Rel: relpos.Behind, Other: name, XAlign: relpos.Left, Space: 2})
 	return
 }
@@ -267,6 +309,120 @@ func AddPBWM(nt *leabra.Network, prefix string, nY, nMaint, nOut, nNeurBgY, nNeu
 	return
 }
 
+// AddDorsalBGGroups adds MatrixGo and NoGo layers for each of given gating groups
+// arrayed in order along the pool X dimension (see GateGroup), named with the group
+// name as a suffix (e.g., MatrixGomnt, MatrixNoGomnt), and GPe, GPiThal, and CIN
+// layers spanning all the groups, with given optional prefix.
+// nY = number of pools in Y dimension, and each pool has nNeurY, nNeurX neurons.
+// Each Matrix layer represents the pools of its group, which are connected to the
+// corresponding pools of the GPe and GPiThal layers (see NewGroupPoolRect).
+// The GateShape of all the layers is set from the groups.
+// Returns the Go and NoGo layers for each group in order, and the other BG layers.
+func AddDorsalBGGroups(nt *leabra.Network, prefix string, nY int, groups []GateGroup, nNeurY, nNeurX int) (mtxGos, mtxNoGos []leabra.LeabraLayer, gpe, gpi, cin leabra.LeabraLayer) {
+	gs := GateShape{}
+	gs.SetGroups(nY, groups)
+	gpe = AddGPeLayer(nt, prefix+"GPeNoGo", nY, gs.MaintX, gs.OutX)
+	gpil := AddGPiThalLayer(nt, prefix+"GPiThal", nY, gs.MaintX, gs.OutX)
+	gpil.GateShp = gs
+	gpi = gpil
+	cini := AddCINLayer(nt, prefix+"CIN")
+	cin = cini
+
+	for gi := range gs.Groups {
+		gp := &gs.Groups[gi]
+		if gp.X == 0 {
+			continue
+		}
+		nMaint, nOut := gp.X, 0
+		if gp.Type == Out {
+			nMaint, nOut = 0, gp.X
+		}
+		mtxGo := AddMatrixLayer(nt, prefix+"MatrixGo"+gp.Name, nY, nMaint, nOut, nNeurY, nNeurX, D1R)
+		mtxNoGo := AddMatrixLayer(nt, prefix+"MatrixNoGo"+gp.Name, nY, nMaint, nOut, nNeurY, nNeurX, D2R)
+		mtxGo.GateShp, mtxNoGo.GateShp = gs, gs
+		mtxGo.GateGrp, mtxNoGo.GateGrp = gp.Name, gp.Name
+		cini.SendACh.Add(mtxGo.Name(), mtxNoGo.Name())
+
+		if len(mtxGos) > 0 {
+			mtxGo.SetRelPos(relpos.Rel{Rel: relpos.RightOf, Other: mtxGos[len(mtxGos)-1].Name(), YAlign: relpos.Front, Space: 2})
+		}
+		mtxNoGo.SetRelPos(relpos.Rel{Rel: relpos.Behind, Other: mtxGo.Name(), XAlign: relpos.Left, Space: 2})
+
+		pj := nt.ConnectLayersPrjn(mtxGo, gpi, NewGroupPoolRect(gp), emer.Forward, &GPiThalPrjn{})
+		pj.SetClass("BgFixed")
+		pj = nt.ConnectLayers(mtxNoGo, gpe, NewGroupPoolRect(gp), emer.Forward)
+		pj.SetClass("BgFixed")
+		mtxGos = append(mtxGos, mtxGo)
+		mtxNoGos = append(mtxNoGos, mtxNoGo)
+	}
+	if len(mtxGos) > 0 {
+		gpe.SetRelPos(relpos.Rel{Rel: relpos.RightOf, Other: mtxNoGos[len(mtxNoGos)-1].Name(), YAlign: relpos.Front, Space: 2})
+		gpi.SetRelPos(relpos.Rel{Rel: relpos.RightOf, Other: mtxGos[len(mtxGos)-1].Name(), YAlign: relpos.Front, Space: 2})
+	}
+	cin.SetRelPos(relpos.Rel{Rel: relpos.Behind, Other: gpe.Name(), XAlign: relpos.Left, Space: 2})
+
+	pj := nt.ConnectLayersPrjn(gpe, gpi, prjn.NewPoolOneToOne(), emer.Forward, &GPiThalPrjn{})
+	pj.SetClass("BgFixed")
+	return
+}
+
+// NewGroupPoolRect returns a PoolRect projection pattern connecting each pool
+// of a layer representing given gating group to the corresponding pool of the
+// group in a layer with the full GateShape (e.g., Matrix to GPiThal).
+func NewGroupPoolRect(gp *GateGroup) *prjn.PoolRect {
+	pr := prjn.NewPoolRect()
+	pr.Wrap = false
+	pr.Start.Set(-gp.StX, 0)
+	pr.RecvStart.Set(gp.StX, 0)
+	pr.RecvN.Set(gp.X, 0)
+	return pr
+}
+
+// AddPFCGroups adds PFC super and deep layers for each of given gating groups,
+// named with optional prefix (PFC by default) plus the group name
+// (e.g., PFCmnt, PFCmntD).  nY = number of pools in Y dimension, the group X
+// gives the number of pools in the X dimension, and each pool has nNeurY, nNeurX
+// neurons.  The deep layers use the group Dyns, and represent the group's
+// pools of the overall GateShape.  Returns the super, deep layers for each group in order.
+func AddPFCGroups(nt *leabra.Network, prefix string, nY int, groups []GateGroup, nNeurY, nNeurX int) []leabra.LeabraLayer {
+	if prefix == "" {
+		prefix = "PFC"
+	}
+	var pfcs []leabra.LeabraLayer
+	prv := ""
+	for _, gp := range groups {
+		if gp.X == 0 {
+			continue
+		}
+		sp, dp := AddPFCLayerDyns(nt, prefix+gp.Name, nY, gp.X, nNeurY, nNeurX, gp.Type == Out, gp.Dyns)
+		dp.(*PFCDeepLayer).GateGrp = gp.Name
+		if prv != "" {
+			sp.SetRelPos(relpos.Rel{Rel: relpos.RightOf, Other: prv, YAlign: relpos.Front, Space: 2})
+		}
+		prv = sp.Name()
+		pfcs = append(pfcs, sp, dp)
+	}
+	return pfcs
+}
+
+// AddPBWMGroups adds a DorsalBG and PFC layers for given gating groups,
+// arrayed in order along the pool X dimension.  Each group has its own
+// Matrix Go and NoGo layers, and PFC super and deep layers, with the group's
+// gating type and dynamics (see AddDorsalBGGroups, AddPFCGroups).
+// Returns the Go and NoGo layers for each group, the other BG layers,
+// and the super, deep PFC layers for each group in order.
+func AddPBWMGroups(nt *leabra.Network, prefix string, nY int, groups []GateGroup, nNeurBgY, nNeurBgX, nNeurPfcY, nNeurPfcX int) (mtxGos, mtxNoGos []leabra.LeabraLayer, gpe, gpi, cin leabra.LeabraLayer, pfcs []leabra.LeabraLayer) {
+	mtxGos, mtxNoGos, gpe, gpi, cin = AddDorsalBGGroups(nt, prefix, nY, groups, nNeurBgY, nNeurBgX)
+	pfcs = AddPFCGroups(nt, prefix, nY, groups, nNeurPfcY, nNeurPfcX)
+	if len(pfcs) > 0 && len(mtxGos) > 0 {
+		pfcs[0].SetRelPos(relpos.Rel{Rel: relpos.Above, Other: mtxGos[0].Name(), YAlign: relpos.Front, XAlign: relpos.Left})
+	}
+	gpl := gpi.(*GPiThalLayer)
+	gpl.SendToMatrixPFCGroups(prefix) // sends gating to all these layers
+	gpl.SendGateShape()
+	return
+}
+
 //////////////////////////////////////////////////////////////////////////////////////
 //  Python versions
 
@@ -5,6 +5,8 @@
 package pbwm
 
 import (
+	"strings"
+
 	"github.com/ccnlab/leabrax/leabra"
 	"github.com/goki/ki/kit"
 )
@@ -113,9 +115,17 @@ func (ly *PFCDeepLayer) Build() error {
 	return nil
 }
 
-// MaintPFC returns corresponding PFCDeep maintenance layer with same name but outD -> mntD
+// MaintPFC returns corresponding PFCDeep maintenance layer: for a layer in
+// a GateGroup, the deep layer of its Maint group (see GateShape MaintGroup),
+// otherwise the layer with same name but outD -> mntD.
 // could be nil
 func (ly *PFCDeepLayer) MaintPFC() *PFCDeepLayer {
+	if ly.GateGrp != "" {
+		return ly.MaintPFCGroup()
+	}
+	if !strings.HasSuffix(ly.Nm, "outD") {
+		return nil
+	}
 	sz := len(ly.Nm)
 	mnm := ly.Nm[:sz-4] + "mntD"
 	li := ly.Network.LayerByName(mnm)
@@ -125,6 +135,26 @@ func (ly *PFCDeepLayer) MaintPFC() *PFCDeepLayer {
 	return li.(*PFCDeepLayer)
 }
 
+// MaintPFCGroup returns the PFCDeep layer representing the Maint group
+// for the GateGrp of this layer -- nil if none.
+func (ly *PFCDeepLayer) MaintPFCGroup() *PFCDeepLayer {
+	grp := ly.GateShp.GroupByName(ly.GateGrp)
+	if grp < 0 {
+		return nil
+	}
+	mgrp := ly.GateShp.MaintGroup(grp)
+	if mgrp < 0 {
+		return nil
+	}
+	mnm := ly.GateShp.Groups[mgrp].Name
+	for li := 0; li < ly.Network.NLayers(); li++ {
+		if pfcm, ok := ly.Network.Layer(li).(*PFCDeepLayer); ok && pfcm.GateGrp == mnm {
+			return pfcm
+		}
+	}
+	return nil
+}
+
 // SuperPFC returns corresponding PFC super layer with same name without D
 // should not be nil.  Super can be any layer type.
 func (ly *PFCDeepLayer) SuperPFC() leabra.LeabraLayer {
@@ -212,6 +242,9 @@ func (ly *PFCDeepLayer) ClearMaint(pool int) {
 	if pfcm == nil {
 		return
 	}
+	if pool >= len(pfcm.GateStates) {
+		return
+	}
 	gs := &pfcm.GateStates[pool] // 0 based
 	if gs.Cnt >= 1 {             // important: only for established maint, not just gated..
 		gs.Cnt = -1 // reset
@@ -0,0 +1,74 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pcore
+
+import (
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/etable/etable"
+)
+
+// GatingMonitor monitors the VThal layer of a BG circuit over the cycles
+// of a trial, and reports which pool(s) gated, the cycle of gating
+// (reaction time), the gating strength, and whether gating failed,
+// in the embedded leabra.GateStats.  The gating activation of a pool
+// is its max activation over the trial.
+// Call Init at the start of each trial, Cycle after each network Cycle,
+// and TrialEnd at the end of the trial, after which the results are available
+// as fields and can be recorded in a log with LogTrial.
+type GatingMonitor struct {
+	leabra.GateStats
+	Thr float32     `desc:"threshold on VThal pool max activation for counting as gated -- defaults to the ThalThr value of the MatrixLayer(s) using this VThal layer"`
+	Lay *VThalLayer `view:"-" desc:"the VThal layer being monitored"`
+}
+
+// Config configures the monitor for given VThal layer, which must already be built.
+func (gm *GatingMonitor) Config(ly *VThalLayer) {
+	gm.Lay = ly
+	if gm.Thr == 0 {
+		gm.Thr = 0.25
+		net := ly.Network
+		for li := 0; li < net.NLayers(); li++ {
+			if mly, ok := net.Layer(li).(*MatrixLayer); ok && mly.Matrix.ThalLay == ly.Name() {
+				gm.Thr = mly.Matrix.ThalThr
+				break
+			}
+		}
+	}
+	np := len(ly.Pools) - 1
+	if np < 1 {
+		np = 1
+	}
+	gm.GateStats.Config(np)
+}
+
+// poolMax returns the current max activation of given pool (0 based)
+func (gm *GatingMonitor) poolMax(pi int) float32 {
+	if len(gm.Lay.Pools) == 1 {
+		return gm.Lay.Pools[0].Inhib.Act.Max
+	}
+	return gm.Lay.Pools[pi+1].Inhib.Act.Max
+}
+
+// Cycle updates the monitor from current VThal activations --
+// call after each network Cycle.
+func (gm *GatingMonitor) Cycle(ltime *leabra.Time) {
+	for pi := range gm.GateCyc {
+		act := gm.poolMax(pi)
+		if act > gm.MaxAct[pi] {
+			gm.MaxAct[pi] = act
+		}
+		if gm.GateCyc[pi] < 0 && act > gm.Thr {
+			gm.GateCyc[pi] = ltime.Cycle
+		}
+		if gm.GateCyc[pi] >= 0 {
+			gm.GateAct[pi] = gm.MaxAct[pi]
+		}
+	}
+}
+
+// ConfigLog configures given table with the LogSchema columns only
+func (gm *GatingMonitor) ConfigLog(dt *etable.Table) {
+	gm.GateStats.ConfigLog(dt, gm.Lay.Name())
+}
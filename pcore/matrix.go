@@ -273,9 +273,13 @@ func (pj *MatrixPrjn) Defaults() {
 	pj.Learn.WtBal.On = false
 }
 
+// Build constructs the projection state, and registers the trace
+// synaptic variables to be saved and loaded in weight files.
 func (pj *MatrixPrjn) Build() error {
 	err := pj.Prjn.Build()
 	pj.TrSyns = make([]TraceSyn, len(pj.SConIdx))
+	pj.AddWtsSynVar("NTr", func(si int) *float32 { return &pj.TrSyns[si].NTr })
+	pj.AddWtsSynVar("Tr", func(si int) *float32 { return &pj.TrSyns[si].Tr })
 	return err
 }
 
@@ -0,0 +1,51 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pcore
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+)
+
+func newMatrixNet(t *testing.T) (*leabra.Network, *MatrixPrjn) {
+	net := &leabra.Network{}
+	net.InitName(net, "MatrixNet")
+	in := net.AddLayer2D("Input", 2, 2, emer.Input)
+	mtx := AddMatrixLayer(net, "MtxGo", 1, 2, 2, 1, D1R)
+	pj := ConnectToMatrix(net, in, mtx, prjn.NewFull()).(*MatrixPrjn)
+	net.Defaults()
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	return net, pj
+}
+
+// TestMatrixPrjnWts checks that the trace synaptic values are saved and
+// loaded in weight files
+func TestMatrixPrjnWts(t *testing.T) {
+	net, pj := newMatrixNet(t)
+	for si := range pj.TrSyns {
+		pj.TrSyns[si].NTr = float32(si) * .125
+		pj.TrSyns[si].Tr = -float32(si) * .25
+	}
+	var buf bytes.Buffer
+	if err := net.WriteWtsJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	net2, pj2 := newMatrixNet(t)
+	if err := net2.ReadWtsJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for si := range pj.TrSyns {
+		if pj2.TrSyns[si] != pj.TrSyns[si] {
+			t.Errorf("syn: %d trace read: %v != %v", si, pj2.TrSyns[si], pj.TrSyns[si])
+		}
+	}
+}
@@ -67,7 +67,7 @@ func (pj *AmygModPrjn) InitWts() {
 // GaussScale returns gaussian weight value for given unit indexes in
 // given send and recv layers according to Gaussian Sigma and MaxWt.
 func (pj *AmygModPrjn) GaussScale(_, _ int, _, _ *etensor.Shape) float32 {
-	scale := float32(pj.WtInit.Gen(-1))
+	scale := float32(pj.WtInit.GenRand(pj.Rnd))
 	scale = math32.Max(pj.SetScaleMin, scale)
 	scale = math32.Min(pj.SetScaleMax, scale)
 	return scale
@@ -0,0 +1,485 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pvlv
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/emer/etable/etable"
+	"github.com/emer/etable/etensor"
+	"github.com/goki/gi/gi"
+	"github.com/goki/ki/kit"
+)
+
+// RunParams is a sequence of up to 5 conditions (ConditionParams) run in order.
+// A condition name of NullStep or "" ends the sequence.
+type RunParams struct {
+	Nm      string `desc:"Name of the sequence"`
+	Desc    string `desc:"Description"`
+	Cond1Nm string `desc:"name of condition 1"`
+	Cond2Nm string `desc:"name of condition 2"`
+	Cond3Nm string `desc:"name of condition 3"`
+	Cond4Nm string `desc:"name of condition 4"`
+	Cond5Nm string `desc:"name of condition 5"`
+}
+
+// CondNms returns the names of the conditions in the sequence, in order
+func (rp *RunParams) CondNms() []string {
+	return []string{rp.Cond1Nm, rp.Cond2Nm, rp.Cond3Nm, rp.Cond4Nm, rp.Cond5Nm}
+}
+
+type RunParamsMap map[string]RunParams
+
+// NullStep is the name of the condition used for unused steps in a RunParams sequence
+const NullStep = "NullStep"
+
+// ConditionParams contains settings for one portion of a Run. A ConditionParams refers to a single TrialBlock, along with
+// other information such as the number of times to run each instantiated Block. A single Block can be referenced by many RunBlocks.
+type ConditionParams struct {
+	Nm              string `desc:"identifier for this type of configuration"`
+	Desc            string `desc:"description of this configuration"`
+	TrialBlkNm      string `desc:"trial group name"`
+	FixedProb       bool   `desc:"fixed probability for each trial group"`
+	NIters          int    `desc:"number of iterations to run"`
+	BlocksPerIter   int    `desc:"number of blocks (1 block = one behavioral trial = sequence of CS, US) in each iteration -- needs to be higher if there are stochastic variables (probabilities)."`
+	PermuteTrialGps bool   `desc:"permute list of fully-instantiated trials after generation"`
+	SaveFinalWts    bool   `desc:"save final weights after training"`
+	SaveWtsInterval int    `desc:"how frequently to save weights during training (in blocks)"`
+	TestInterval    int    `desc:"how frequently (blocks) to run a test during training"`
+	LogTrials       bool   `desc:"should trial-level data be saved to log files?"`
+	LoadWeights     bool   `desc:"load initial weights from a file (specified in weights_file)"`
+	WeightsFile     string `desc:"full relative path (from project) of weights file to load -- use CRR: prefix to load from cluster run results directory"`
+	LoadStBlk       int    `desc:"after loading weights, reset block counter to this value (-1 = leave at value from the loaded weights)"`
+	LrsStepBlks     int    `desc:"learning rate schedule blocks per step of decrease in learning rate"`
+	LrsNSteps       int    `desc:"number of steps in the learning rate schedule"`
+	LrsBumpStep     int    `desc:"if positive (3 is typical), then bump up the learning rate at this step in the schedule -- can help improve final performance level"`
+}
+
+type ConditionParamsMap map[string]ConditionParams
+
+// TrialBlockParams specifies one trial group within a TrialBlock: the CS, context and US,
+// with their timing, and the proportion of trials in the block that are of this type.
+// The USType is the name of a positive or negative US in the Vocab, according to the ValenceContext.
+type TrialBlockParams struct {
+	TrialBlkName        string  `desc:"name"`
+	PercentOfTotal      float64 `desc:"Percent of all trials for this type"`
+	ValenceContext      Valence `desc:"Positive or negative reward valence"`
+	USProb              float64 `desc:"Probability of US"`
+	FixedProb           bool    `desc:"Fixed probability?"`
+	MixedUS             bool    `desc:"Mixed US set?"`
+	USMagnitude         float64 `desc:"US magnitude"`
+	AlphTicksPerTrialGp int     `desc:"Number of ticks for a trial"`
+	CS                  string  `desc:"Conditioned stimulus"`
+	CSTimeStart         Tick    `desc:"Tick of CS start"`
+	CSTimeEnd           Tick    `desc:"Tick of CS end"`
+	CS2TimeStart        Tick    `desc:"Tick of CS2 start"`
+	CS2TimeEnd          Tick    `desc:"Tick of CS2 end"`
+	USType              string  `desc:"Unconditioned stimulus"`
+	USTimeStart         Tick    `desc:"Tick for start of US presentation"`
+	USTimeEnd           Tick    `desc:"Tick for end of US presentation"`
+	Context             string  `desc:"Context"`
+	Location            string  `desc:"Location"`
+}
+
+type TrialBlockMap map[string][]*TrialBlockParams
+
+// Experiment holds the full set of PVLV experiment definitions: the RunParams sequences,
+// the ConditionParams they refer to by name, and the TrialBlocks referred to by the
+// conditions, along with the Vocab of stimuli, contexts and USs that they use.
+// It can be saved and loaded as a single JSON file, or as TSV files (see SaveTSV).
+// Loading calls Validate to check all of the references.
+type Experiment struct {
+	Vocab       *Vocab             `desc:"input vocabulary used by the trial blocks -- nil for the DefaultVocab"`
+	Runs        RunParamsMap       `desc:"sequences of conditions, by name"`
+	Conditions  ConditionParamsMap `desc:"conditions, by name"`
+	TrialBlocks TrialBlockMap      `desc:"trial blocks (lists of trial groups), by name"`
+}
+
+// ExptVocab returns the Vocab of the experiment, or the DefaultVocab if none
+func (ex *Experiment) ExptVocab() *Vocab {
+	if ex.Vocab != nil {
+		return ex.Vocab
+	}
+	return DefaultVocab()
+}
+
+// ExptTSVFiles are the file names within a directory for the TSV format of an Experiment
+var ExptTSVFiles = [3]string{"runs.tsv", "conditions.tsv", "trial_blocks.tsv"}
+
+// ExptVocabFile is the file name within a directory for the Vocab of an Experiment
+// in TSV format, which is saved as JSON as it is not a table
+var ExptVocabFile = "vocab.json"
+
+// Validate checks that the names of all records match their keys, that all condition
+// names in the Runs refer to existing conditions, and that those conditions refer to
+// existing trial blocks, with trial group parameters in range and stimuli and USs in the
+// Vocab, which is also validated.  Conditions that are not used in any run but refer
+// to missing trial blocks cannot be run, and are reported in the log (see Unrunnable),
+// without checking their other parameters.
+// Returns an error listing all problems found, or nil if none.
+func (ex *Experiment) Validate() error {
+	vc := ex.ExptVocab()
+	if err := vc.Validate(); err != nil {
+		return err
+	}
+	var errs []string
+	addErr := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Sprintf(format, args...))
+	}
+	for _, nm := range sortedKeys(ex.Runs) {
+		rp := ex.Runs[nm]
+		if rp.Nm != nm {
+			addErr("RunParams %q: Nm field %q does not match", nm, rp.Nm)
+		}
+		for i, cnm := range rp.CondNms() {
+			if cnm == "" || cnm == NullStep {
+				break
+			}
+			if _, ok := ex.Conditions[cnm]; !ok {
+				addErr("RunParams %q: condition %d: %q not found", nm, i+1, cnm)
+			}
+		}
+	}
+	unrun := map[string]bool{}
+	for _, nm := range ex.Unrunnable() {
+		unrun[nm] = true
+		log.Printf("pvlv.Experiment: ConditionParams %q: trial block %q not found -- it is not used in any run, and cannot be run\n", nm, ex.Conditions[nm].TrialBlkNm)
+	}
+	for _, nm := range sortedKeys(ex.Conditions) {
+		cp := ex.Conditions[nm]
+		if cp.Nm != nm {
+			addErr("ConditionParams %q: Nm field %q does not match", nm, cp.Nm)
+		}
+		if unrun[nm] {
+			continue
+		}
+		if _, ok := ex.TrialBlocks[cp.TrialBlkNm]; !ok {
+			addErr("ConditionParams %q: trial block %q not found", nm, cp.TrialBlkNm)
+		}
+		if cp.NIters < 0 || cp.BlocksPerIter <= 0 {
+			addErr("ConditionParams %q: NIters: %d must be >= 0 and BlocksPerIter: %d > 0", nm, cp.NIters, cp.BlocksPerIter)
+		}
+	}
+	for _, nm := range sortedKeys(ex.TrialBlocks) {
+		for i, tb := range ex.TrialBlocks[nm] {
+			if err := tb.Validate(vc); err != nil {
+				addErr("TrialBlock %q: trial group %d %q: %v", nm, i, tb.TrialBlkName, err)
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("pvlv.Experiment: %d errors:\n%s", len(errs), strings.Join(errs, "\n"))
+}
+
+// Unrunnable returns the names of the conditions, in sorted order, that refer to
+// missing trial blocks but are not used in any run -- Validate reports these
+// in the log instead of as errors, so that they are kept until they are fixed.
+func (ex *Experiment) Unrunnable() []string {
+	used := map[string]bool{}
+	for _, rp := range ex.Runs {
+		for _, cnm := range rp.CondNms() {
+			used[cnm] = true
+		}
+	}
+	var nms []string
+	for _, nm := range sortedKeys(ex.Conditions) {
+		if _, ok := ex.TrialBlocks[ex.Conditions[nm].TrialBlkNm]; !ok && !used[nm] {
+			nms = append(nms, nm)
+		}
+	}
+	return nms
+}
+
+// Validate checks that the trial group parameters are in range, and that
+// the stimuli in the CS, the USType and the Context are in given vocabulary.
+// The Context must be a Contexts name, optionally with a CtxVariants variant.
+// A compound context (e.g., AX_B) need not be, but each of its elements in the
+// same variant (e.g., A_B and X_B) must be: it is presented through its elements
+// unless it has its own conjunctive unit in the Contexts.
+func (tb *TrialBlockParams) Validate(vc *Vocab) error {
+	if tb.ValenceContext != POS && tb.ValenceContext != NEG {
+		return fmt.Errorf("invalid ValenceContext: %v", tb.ValenceContext)
+	}
+	if tb.PercentOfTotal < 0 || tb.USProb < 0 || tb.USProb > 1 {
+		return fmt.Errorf("PercentOfTotal: %g must be >= 0 and USProb: %g in 0..1", tb.PercentOfTotal, tb.USProb)
+	}
+	if tb.AlphTicksPerTrialGp <= 0 {
+		return fmt.Errorf("AlphTicksPerTrialGp: %d must be > 0", tb.AlphTicksPerTrialGp)
+	}
+	for _, tk := range []Tick{tb.CSTimeStart, tb.CSTimeEnd, tb.CS2TimeStart, tb.CS2TimeEnd, tb.USTimeStart, tb.USTimeEnd} {
+		if tk < T0 || tk > TckNone {
+			return fmt.Errorf("invalid Tick: %v", tk)
+		}
+	}
+	if vc.USIdx(tb.ValenceContext, tb.USType) < 0 {
+		return fmt.Errorf("invalid %v USType: %q", tb.ValenceContext, tb.USType)
+	}
+	for _, r := range tb.CS {
+		if vc.StimIdx(string(r)) < 0 {
+			return fmt.Errorf("invalid CS: %q", tb.CS)
+		}
+	}
+	base, vnt := tb.Context, ""
+	if ui := strings.LastIndex(base, "_"); ui >= 0 {
+		base, vnt = base[:ui], base[ui:]
+	}
+	if len([]rune(base)) <= 1 {
+		if _, ok := vc.ContextCoords(tb.Context); !ok {
+			return fmt.Errorf("invalid Context: %q", tb.Context)
+		}
+		return nil
+	}
+	for _, r := range base {
+		if _, ok := vc.ContextCoords(string(r) + vnt); !ok {
+			return fmt.Errorf("invalid Context: %q element: %q", tb.Context, string(r)+vnt)
+		}
+	}
+	return nil
+}
+
+// sortedKeys returns the keys of a map with string keys, in sorted order
+func sortedKeys(m interface{}) []string {
+	mv := reflect.ValueOf(m)
+	keys := make([]string, 0, mv.Len())
+	for _, k := range mv.MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ReadJSON reads the experiment from JSON, and calls Validate
+func (ex *Experiment) ReadJSON(r io.Reader) error {
+	*ex = Experiment{}
+	if err := json.NewDecoder(r).Decode(ex); err != nil {
+		return err
+	}
+	return ex.Validate()
+}
+
+// OpenJSON opens the experiment from a JSON file
+func (ex *Experiment) OpenJSON(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return ex.ReadJSON(f)
+}
+
+// SaveJSON saves the experiment to a JSON file
+func (ex *Experiment) SaveJSON(filename string) error {
+	b, err := json.MarshalIndent(ex, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, b, 0644)
+}
+
+// OpenTSV opens the experiment from the ExptTSVFiles in given directory, and calls Validate.
+// Each file is an etable TSV file with a header line with the field names (with etable
+// type prefixes), followed by one record per line.
+// The trial blocks file has an additional first Block column with the name of the block
+// that each trial group belongs to, and trial groups are added to blocks in order.
+// The Vocab is loaded from the ExptVocabFile, if present.
+func (ex *Experiment) OpenTSV(dir string) error {
+	*ex = Experiment{Runs: RunParamsMap{}, Conditions: ConditionParamsMap{}, TrialBlocks: TrialBlockMap{}}
+	if b, err := ioutil.ReadFile(filepath.Join(dir, ExptVocabFile)); err == nil {
+		ex.Vocab = &Vocab{}
+		if err := json.Unmarshal(b, ex.Vocab); err != nil {
+			return fmt.Errorf("%s: %v", ExptVocabFile, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	err := openTSVFile(filepath.Join(dir, ExptTSVFiles[0]), RunParams{}, func(blk string, rec interface{}) {
+		rp := rec.(*RunParams)
+		ex.Runs[rp.Nm] = *rp
+	})
+	if err != nil {
+		return err
+	}
+	err = openTSVFile(filepath.Join(dir, ExptTSVFiles[1]), ConditionParams{}, func(blk string, rec interface{}) {
+		cp := rec.(*ConditionParams)
+		ex.Conditions[cp.Nm] = *cp
+	})
+	if err != nil {
+		return err
+	}
+	err = openTSVFile(filepath.Join(dir, ExptTSVFiles[2]), TrialBlockParams{}, func(blk string, rec interface{}) {
+		ex.TrialBlocks[blk] = append(ex.TrialBlocks[blk], rec.(*TrialBlockParams))
+	})
+	if err != nil {
+		return err
+	}
+	return ex.Validate()
+}
+
+// SaveTSV saves the experiment to the ExptTSVFiles in given directory (see OpenTSV),
+// with records sorted by name, and the Vocab, if set, to the ExptVocabFile.
+// The directory is created if it does not exist.
+func (ex *Experiment) SaveTSV(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	if ex.Vocab != nil {
+		b, err := json.MarshalIndent(ex.Vocab, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, ExptVocabFile), b, 0644); err != nil {
+			return err
+		}
+	}
+	var recs [3][]interface{}
+	var blks []string
+	for _, nm := range sortedKeys(ex.Runs) {
+		rp := ex.Runs[nm]
+		recs[0] = append(recs[0], &rp)
+	}
+	for _, nm := range sortedKeys(ex.Conditions) {
+		cp := ex.Conditions[nm]
+		recs[1] = append(recs[1], &cp)
+	}
+	for _, nm := range sortedKeys(ex.TrialBlocks) {
+		for _, tb := range ex.TrialBlocks[nm] {
+			recs[2] = append(recs[2], tb)
+			blks = append(blks, nm)
+		}
+	}
+	protos := [3]interface{}{RunParams{}, ConditionParams{}, TrialBlockParams{}}
+	blkCols := [3][]string{nil, nil, blks}
+	for i, fnm := range ExptTSVFiles {
+		dt := recsToTable(protos[i], blkCols[i], recs[i])
+		if err := dt.SaveCSV(gi.FileName(filepath.Join(dir, fnm)), etable.Tab, etable.Headers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Open opens the experiment from a JSON file, or from the TSV files in a directory
+func (ex *Experiment) Open(path string) error {
+	if fi, err := os.Stat(path); err == nil && fi.IsDir() {
+		return ex.OpenTSV(path)
+	}
+	return ex.OpenJSON(path)
+}
+
+// openTSVFile reads records of the same type as proto from a TSV file saved by SaveTSV,
+// using tableToRecs
+func openTSVFile(filename string, proto interface{}, addFn func(blk string, rec interface{})) error {
+	dt := &etable.Table{}
+	if err := dt.OpenCSV(gi.FileName(filename), etable.Tab); err != nil {
+		return err
+	}
+	if err := tableToRecs(dt, proto, addFn); err != nil {
+		return fmt.Errorf("%s: %v", filename, err)
+	}
+	return nil
+}
+
+// exptTableSchema returns the etable schema for records of given type, with one
+// column per field, and a first Block column if hasBlk.  Valence and Tick fields
+// are STRING columns with their names.
+func exptTableSchema(typ reflect.Type, hasBlk bool) etable.Schema {
+	var sc etable.Schema
+	if hasBlk {
+		sc = append(sc, etable.Column{Name: "Block", Type: etensor.STRING})
+	}
+	for fi := 0; fi < typ.NumField(); fi++ {
+		fld := typ.Field(fi)
+		ct := etensor.STRING
+		switch {
+		case fld.Type == reflect.TypeOf(Valence(0)) || fld.Type == reflect.TypeOf(Tick(0)):
+		case fld.Type.Kind() == reflect.Bool:
+			ct = etensor.BOOL
+		case fld.Type.Kind() == reflect.Int:
+			ct = etensor.INT64
+		case fld.Type.Kind() == reflect.Float64:
+			ct = etensor.FLOAT64
+		}
+		sc = append(sc, etable.Column{Name: fld.Name, Type: ct})
+	}
+	return sc
+}
+
+// recsToTable returns a table with records of the same type as proto, one per row,
+// with a first Block column from blks if non-nil
+func recsToTable(proto interface{}, blks []string, recs []interface{}) *etable.Table {
+	typ := reflect.TypeOf(proto)
+	dt := &etable.Table{}
+	dt.SetFromSchema(exptTableSchema(typ, blks != nil), len(recs))
+	st := 0
+	if blks != nil {
+		st = 1
+	}
+	for ri, rec := range recs {
+		if blks != nil {
+			dt.Cols[0].SetString1D(ri, blks[ri])
+		}
+		rv := reflect.ValueOf(rec).Elem()
+		for fi := 0; fi < rv.NumField(); fi++ {
+			dt.Cols[st+fi].SetString1D(ri, kit.ToString(rv.Field(fi).Interface()))
+		}
+	}
+	return dt
+}
+
+// tableToRecs reads records of the same type as proto from the rows of given table,
+// calling addFn with the Block column value (if present) and a pointer to each new record.
+// Columns are matched to fields by name.
+func tableToRecs(dt *etable.Table, proto interface{}, addFn func(blk string, rec interface{})) error {
+	typ := reflect.TypeOf(proto)
+	for _, cn := range dt.ColNames {
+		if _, ok := typ.FieldByName(cn); !ok && cn != "Block" {
+			return fmt.Errorf("unknown column: %q", cn)
+		}
+	}
+	for ri := 0; ri < dt.Rows; ri++ {
+		rp := reflect.New(typ)
+		rv := rp.Elem()
+		blk := ""
+		for ci, cn := range dt.ColNames {
+			str := dt.Cols[ci].StringVal1D(ri)
+			if cn == "Block" {
+				blk = str
+				continue
+			}
+			fv := rv.FieldByName(cn)
+			var err error
+			switch fv.Interface().(type) {
+			case Valence:
+				var v Valence
+				v, err = ValenceFmName(str)
+				fv.SetInt(int64(v))
+			case Tick:
+				var tk Tick
+				tk, err = TickFmName(str)
+				fv.SetInt(int64(tk))
+			default:
+				if !kit.SetRobust(fv.Addr().Interface(), str) {
+					err = fmt.Errorf("invalid value: %q", str)
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("row %d: column %s: %v", ri, cn, err)
+			}
+		}
+		addFn(blk, rp.Interface())
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pvlv
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testExpt() *Experiment {
+	return &Experiment{
+		Runs: RunParamsMap{
+			"AcqExt": {Nm: "AcqExt", Cond1Nm: "Acq", Cond2Nm: "Ext", Cond3Nm: NullStep},
+		},
+		Conditions: ConditionParamsMap{
+			"Acq": {Nm: "Acq", Desc: "acquisition", TrialBlkNm: "Acq", NIters: 10, BlocksPerIter: 8, PermuteTrialGps: true},
+			"Ext": {Nm: "Ext", TrialBlkNm: "Ext", NIters: 5, BlocksPerIter: 8, LrsBumpStep: -1},
+		},
+		TrialBlocks: TrialBlockMap{
+			"Acq": {
+				{TrialBlkName: "A_Rf", PercentOfTotal: 0.5, ValenceContext: POS, USProb: 1, USMagnitude: 1, AlphTicksPerTrialGp: 5,
+					CS: "A", CSTimeStart: T1, CSTimeEnd: T3, CS2TimeStart: TckNone, CS2TimeEnd: TckNone,
+					USType: "Food", USTimeStart: T3, USTimeEnd: T3, Context: "A"},
+				{TrialBlkName: "B_Rf", PercentOfTotal: 0.5, ValenceContext: NEG, USProb: 0.25, USMagnitude: 1, AlphTicksPerTrialGp: 5,
+					CS: "B", CSTimeStart: T1, CSTimeEnd: T3, CS2TimeStart: TckNone, CS2TimeEnd: TckNone,
+					USType: "Nausea", USTimeStart: T3, USTimeEnd: T3, Context: "B"},
+			},
+			"Ext": {
+				{TrialBlkName: "A_NR", PercentOfTotal: 1, ValenceContext: POS, AlphTicksPerTrialGp: 5,
+					CS: "A", CSTimeStart: T1, CSTimeEnd: T3, CS2TimeStart: TckNone, CS2TimeEnd: TckNone,
+					USType: "Food", USTimeStart: T3, USTimeEnd: T3, Context: "A"},
+			},
+		},
+	}
+}
+
+func TestExptJSON(t *testing.T) {
+	ex := testExpt()
+	if err := ex.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "pvlv_expt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fnm := filepath.Join(dir, "expt.json")
+	if err := ex.SaveJSON(fnm); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadFile(fnm)
+	for _, s := range []string{`"ValenceContext": "NEG"`, `"USType": "Nausea"`, `"USType": "Food"`, `"CSTimeEnd": "T3"`} {
+		if !bytes.Contains(b, []byte(s)) {
+			t.Errorf("JSON does not contain: %s", s)
+		}
+	}
+	ld := &Experiment{}
+	if err := ld.Open(fnm); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ex, ld) {
+		t.Errorf("JSON loaded experiment differs from saved")
+	}
+}
+
+func TestExptTSV(t *testing.T) {
+	ex := testExpt()
+	ex.Vocab = DefaultVocab()
+	ex.Vocab.NegUSs[1] = "Noise"
+	ex.TrialBlocks["Acq"][1].USType = "Noise"
+	dir, err := ioutil.TempDir("", "pvlv_expt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ex.SaveTSV(dir); err != nil {
+		t.Fatal(err)
+	}
+	ld := &Experiment{}
+	if err := ld.Open(dir); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ex, ld) {
+		t.Errorf("TSV loaded experiment differs from saved")
+	}
+	b, _ := ioutil.ReadFile(filepath.Join(dir, ExptTSVFiles[2]))
+	if !strings.HasPrefix(string(b), "$Block\t$TrialBlkName\t#PercentOfTotal\t$ValenceContext\t") {
+		t.Errorf("trial blocks TSV header: %s", strings.SplitN(string(b), "\n", 2)[0])
+	}
+}
+
+func TestExptValidate(t *testing.T) {
+	tests := []struct {
+		edit func(ex *Experiment)
+		err  string
+	}{
+		{func(ex *Experiment) {
+			rp := ex.Runs["AcqExt"]
+			rp.Cond2Nm = "Renew"
+			ex.Runs["AcqExt"] = rp
+		}, `condition 2: "Renew" not found`},
+		{func(ex *Experiment) {
+			cp := ex.Conditions["Ext"]
+			cp.TrialBlkNm = "Extinct"
+			ex.Conditions["Ext"] = cp
+		}, `trial block "Extinct" not found`},
+		{func(ex *Experiment) {
+			cp := ex.Conditions["Acq"]
+			cp.Nm = "Acquire"
+			ex.Conditions["Acq"] = cp
+		}, `Nm field "Acquire" does not match`},
+		{func(ex *Experiment) { ex.TrialBlocks["Acq"][1].USProb = 1.5 }, `USProb: 1.5`},
+		{func(ex *Experiment) { ex.TrialBlocks["Acq"][0].CS = "AQ" }, `invalid CS: "AQ"`},
+		{func(ex *Experiment) { ex.TrialBlocks["Ext"][0].AlphTicksPerTrialGp = 0 }, `AlphTicksPerTrialGp: 0`},
+		{func(ex *Experiment) { ex.TrialBlocks["Acq"][0].Context = "Q" }, `invalid Context: "Q"`},
+		{func(ex *Experiment) { ex.TrialBlocks["Acq"][0].Context = "AQ" }, `invalid Context: "AQ" element: "Q"`},
+		{func(ex *Experiment) { ex.TrialBlocks["Acq"][1].Context = "B_Q" }, `invalid Context: "B_Q"`},
+		{func(ex *Experiment) {
+			ex.Vocab = DefaultVocab()
+			ex.Vocab.Contexts = append(ex.Vocab.Contexts, "AQ")
+			ex.TrialBlocks["Acq"][0].Context = "AQ"
+		}, `invalid Context: "AQ" element: "Q"`},
+	}
+	for _, tt := range tests {
+		ex := testExpt()
+		tt.edit(ex)
+		err := ex.Validate()
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Validate error: %v, expected: %s", err, tt.err)
+		}
+	}
+	// unused conditions with missing trial blocks are reported, not errors
+	ex := testExpt()
+	ex.Conditions["Unused"] = ConditionParams{Nm: "Unused", TrialBlkNm: "Missing", BlocksPerIter: 1}
+	if err := ex.Validate(); err != nil {
+		t.Errorf("unused condition: %v", err)
+	}
+	if ur := ex.Unrunnable(); !reflect.DeepEqual(ur, []string{"Unused"}) {
+		t.Errorf("Unrunnable: %v", ur)
+	}
+	// compound contexts without their own unit are presented through their elements
+	ex = testExpt()
+	ex.TrialBlocks["Acq"][0].Context = "AC"
+	ex.TrialBlocks["Acq"][1].Context = "AC_B"
+	if err := ex.Validate(); err != nil {
+		t.Errorf("compound context: %v", err)
+	}
+}
@@ -286,6 +286,23 @@ func (val Valence) Negate() Valence {
 
 var KiT_Valence = kit.Enums.AddEnum(ValenceN, kit.NotBitFlag, nil)
 
+// ValenceFmName returns the Valence with given name (String value)
+func ValenceFmName(s string) (Valence, error) {
+	for v := ValNone; v < ValenceN; v++ {
+		if v.String() == s {
+			return v, nil
+		}
+	}
+	return ValNone, fmt.Errorf("invalid Valence: %q", s)
+}
+
+func (ev Valence) MarshalJSON() ([]byte, error) { return kit.EnumMarshalJSON(ev) }
+func (ev *Valence) UnmarshalJSON(b []byte) error {
+	var err error
+	*ev, err = ValenceFmName(strings.Trim(string(b), "\""))
+	return err
+}
+
 var Fooey Inputs = POS // for testing
 //end Valence
 
@@ -523,6 +540,23 @@ func (t Tick) TensorScaled(scale float32) etensor.Tensor {
 
 var KiT_Tick = kit.Enums.AddEnum(TickN+1, kit.NotBitFlag, nil)
 
+// TickFmName returns the Tick with given name (String value)
+func TickFmName(s string) (Tick, error) {
+	for t := T0; t <= TckNone; t++ {
+		if t.String() == s {
+			return t, nil
+		}
+	}
+	return TckNone, fmt.Errorf("invalid Tick: %q", s)
+}
+
+func (ev Tick) MarshalJSON() ([]byte, error) { return kit.EnumMarshalJSON(ev) }
+func (ev *Tick) UnmarshalJSON(b []byte) error {
+	var err error
+	*ev, err = TickFmName(strings.Trim(string(b), "\""))
+	return err
+}
+
 // Tick
 
 // USTimeIn
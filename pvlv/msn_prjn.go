@@ -64,9 +64,13 @@ func (pj *MSNPrjn) Defaults() {
 	pj.MaxVSActMod = 0.5
 }
 
+// Build constructs the projection state, and registers the trace
+// synaptic variables to be saved and loaded in weight files.
 func (pj *MSNPrjn) Build() error {
 	err := pj.Prjn.Build()
 	pj.TrSyns = make([]TraceSyn, len(pj.SConIdx))
+	pj.AddWtsSynVar("NTr", func(si int) *float32 { return &pj.TrSyns[si].NTr })
+	pj.AddWtsSynVar("Tr", func(si int) *float32 { return &pj.TrSyns[si].Tr })
 	return err
 }
 
@@ -0,0 +1,50 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pvlv
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+)
+
+func newMSNNet(t *testing.T) (*Network, *MSNPrjn) {
+	net := &Network{}
+	net.InitName(net, "MSNNet")
+	in := net.AddLayer2D("Input", 2, 2, emer.Input)
+	msn := net.AddMSNLayer("VSPatchPosD1", 1, 1, 2, 2, PATCH, D1R)
+	pj := net.ConnectLayersPrjn(in, msn, prjn.NewFull(), emer.Forward, &MSNPrjn{LearningRule: TraceNoThalVS}).(*MSNPrjn)
+	net.Defaults()
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	return net, pj
+}
+
+// TestMSNPrjnWts checks that the trace synaptic values are saved and
+// loaded in weight files
+func TestMSNPrjnWts(t *testing.T) {
+	net, pj := newMSNNet(t)
+	for si := range pj.TrSyns {
+		pj.TrSyns[si].NTr = float32(si) * .125
+		pj.TrSyns[si].Tr = -float32(si) * .25
+	}
+	var buf bytes.Buffer
+	if err := net.WriteWtsJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	net2, pj2 := newMSNNet(t)
+	if err := net2.ReadWtsJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for si := range pj.TrSyns {
+		if pj2.TrSyns[si] != pj.TrSyns[si] {
+			t.Errorf("syn: %d trace read: %v != %v", si, pj2.TrSyns[si], pj.TrSyns[si])
+		}
+	}
+}
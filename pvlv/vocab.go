@@ -0,0 +1,318 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pvlv
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Vocab is the input vocabulary of a PVLV experiment: the names of the conditioned
+// stimuli, contexts and USs, from which the shapes of the StimIn, ContextIn, USTimeIn
+// and PV input layers, and the PackedUSTimeState encoding, are derived.  Stimuli and
+// USs are identified by their index in the vocabulary, with -1 for none (in contrast
+// to the StmNone and USNone values of the Stim and US enums, which define the
+// DefaultVocab).  The timesteps within a trial are always given by Tick.
+type Vocab struct {
+	Stims         []string `desc:"names of the conditioned stimuli, in StimIn unit order -- each name is a single character, so that compound stimuli can be named by concatenation, e.g., AX"`
+	StimUSTimeGps []int    `desc:"row of the USTimeIn layer for each stimulus, in the same order as Stims -- stimuli can share rows, and row 0 is for USTime states without a stimulus -- if empty, each stimulus has its own row, starting at 1"`
+	NUSTimeRows   int      `desc:"number of stimulus rows in the USTimeIn layer -- if 0, one more than the largest row in StimUSTimeGps"`
+	Contexts      []string `desc:"names of the base contexts, in ContextIn row order -- typically the names of the stimuli and compound stimuli that they accompany"`
+	CtxVariants   []string `desc:"names of the context variants, in ContextIn column order, with the first being the base context itself (empty name) -- variant V of base context B is named B_V, e.g., for extinction in a different context"`
+	PosUSs        []string `desc:"names of the positive USs, in PosPV unit order"`
+	NegUSs        []string `desc:"names of the negative USs, in NegPV unit order"`
+	NUSTimes      int      `desc:"number of timesteps after the onset of a CS or US represented in the USTimeIn layer"`
+}
+
+// DefaultVocab returns the vocabulary defined by the Stim, Context, PosUS and NegUS
+// enums, with USTimeIn rows given by StmGrpMap
+func DefaultVocab() *Vocab {
+	vc := &Vocab{NUSTimeRows: USTimeInShape[0], NUSTimes: USTimeInShape[3]}
+	for stm := StmA; stm < StimN; stm++ {
+		vc.Stims = append(vc.Stims, stm.String())
+		vc.StimUSTimeGps = append(vc.StimUSTimeGps, StmGrpMap[stm])
+	}
+	vc.CtxVariants = make([]string, ContextInShape[1])
+	for ctx := CtxA; ctx < NContexts; ctx += Context(ContextInShape[1]) {
+		vc.Contexts = append(vc.Contexts, ctx.String())
+	}
+	for ctx := CtxA; ctx < Context(ContextInShape[1]); ctx++ {
+		if ps := strings.Split(ctx.String(), "_"); len(ps) == 2 {
+			vc.CtxVariants[ctx] = ps[1]
+		}
+	}
+	for us := Water; us < NPosUS; us++ {
+		vc.PosUSs = append(vc.PosUSs, us.String())
+	}
+	for us := Shock; us < NNegUS; us++ {
+		vc.NegUSs = append(vc.NegUSs, us.String())
+	}
+	return vc
+}
+
+// Validate checks that the vocabulary is complete and consistent,
+// and that the USTime states can be packed into a PackedUSTimeState
+func (vc *Vocab) Validate() error {
+	if len(vc.Stims) == 0 || len(vc.Contexts) == 0 || len(vc.CtxVariants) == 0 || len(vc.PosUSs) == 0 || len(vc.NegUSs) == 0 {
+		return fmt.Errorf("pvlv.Vocab: Stims, Contexts, CtxVariants, PosUSs and NegUSs must all be non-empty")
+	}
+	if err := uniqueNames("Stims", vc.Stims); err != nil {
+		return err
+	}
+	for _, nm := range vc.Stims {
+		if len([]rune(nm)) != 1 || nm == "_" {
+			return fmt.Errorf("pvlv.Vocab: stimulus name: %q is not a single character", nm)
+		}
+	}
+	if len(vc.StimUSTimeGps) > 0 {
+		if len(vc.StimUSTimeGps) != len(vc.Stims) {
+			return fmt.Errorf("pvlv.Vocab: StimUSTimeGps has %d rows for %d Stims", len(vc.StimUSTimeGps), len(vc.Stims))
+		}
+		for i, row := range vc.StimUSTimeGps {
+			if row < 0 || (vc.NUSTimeRows > 0 && row >= vc.NUSTimeRows) {
+				return fmt.Errorf("pvlv.Vocab: USTimeIn row: %d for stimulus %s out of range", row, vc.Stims[i])
+			}
+		}
+	}
+	if err := uniqueNames("Contexts", vc.Contexts); err != nil {
+		return err
+	}
+	if vc.CtxVariants[0] != "" {
+		return fmt.Errorf("pvlv.Vocab: first of CtxVariants must be empty (the base context), not: %q", vc.CtxVariants[0])
+	}
+	if err := uniqueNames("CtxVariants", vc.CtxVariants); err != nil {
+		return err
+	}
+	if err := uniqueNames("PosUSs", vc.PosUSs); err != nil {
+		return err
+	}
+	if err := uniqueNames("NegUSs", vc.NegUSs); err != nil {
+		return err
+	}
+	if vc.NUSTimes <= 0 || vc.NUSTimes > int(TickN) {
+		return fmt.Errorf("pvlv.Vocab: NUSTimes: %d must be in 1..%d", vc.NUSTimes, TickN)
+	}
+	if nbits := vc.tckShift() + int(TickN); nbits > 63 {
+		return fmt.Errorf("pvlv.Vocab: too many Stims and USs to pack USTime states: %d bits > 63", nbits)
+	}
+	return nil
+}
+
+// uniqueNames returns an error if any of the names are duplicates
+func uniqueNames(field string, nms []string) error {
+	has := make(map[string]bool, len(nms))
+	for _, nm := range nms {
+		if has[nm] {
+			return fmt.Errorf("pvlv.Vocab: %s: duplicate name: %q", field, nm)
+		}
+		has[nm] = true
+	}
+	return nil
+}
+
+// NUSs returns the number of units in the PV layers, and of US pools in the
+// amygdala and ventral striatum: the larger of the number of PosUSs and NegUSs
+func (vc *Vocab) NUSs() int {
+	if len(vc.NegUSs) > len(vc.PosUSs) {
+		return len(vc.NegUSs)
+	}
+	return len(vc.PosUSs)
+}
+
+// USTimeRows returns the number of stimulus rows in the USTimeIn layer
+func (vc *Vocab) USTimeRows() int {
+	if vc.NUSTimeRows > 0 {
+		return vc.NUSTimeRows
+	}
+	if len(vc.StimUSTimeGps) == 0 {
+		return len(vc.Stims) + 1
+	}
+	mx := 0
+	for _, row := range vc.StimUSTimeGps {
+		if row > mx {
+			mx = row
+		}
+	}
+	return mx + 1
+}
+
+// StimInShape returns the shape of the StimIn layer: one unit per stimulus
+func (vc *Vocab) StimInShape() []int {
+	return []int{len(vc.Stims), 1}
+}
+
+// ContextInShape returns the shape of the ContextIn layer: base contexts by variants
+func (vc *Vocab) ContextInShape() []int {
+	return []int{len(vc.Contexts), len(vc.CtxVariants)}
+}
+
+// USInShape returns the shape of the PosPV and NegPV layers: one unit per US
+func (vc *Vocab) USInShape() []int {
+	return []int{vc.NUSs()}
+}
+
+// USTimeInShape returns the 4D shape of the USTimeIn layer:
+// stimulus rows, valence, US, and timestep
+func (vc *Vocab) USTimeInShape() []int {
+	return []int{vc.USTimeRows(), 2, vc.NUSs(), vc.NUSTimes}
+}
+
+// StimIdx returns the index of the stimulus with given name, or -1 if not found
+func (vc *Vocab) StimIdx(nm string) int {
+	for i, s := range vc.Stims {
+		if s == nm {
+			return i
+		}
+	}
+	return -1
+}
+
+// ContextCoords returns the ContextIn [row, column] coordinates of the context with
+// given name, either a base context or a variant named Base_Variant.  Returns nil
+// and false if not found.
+func (vc *Vocab) ContextCoords(nm string) ([]int, bool) {
+	base, vnt := nm, ""
+	if ui := strings.LastIndex(nm, "_"); ui >= 0 {
+		base, vnt = nm[:ui], nm[ui+1:]
+	}
+	for ci, c := range vc.Contexts {
+		if c != base {
+			continue
+		}
+		for vi, v := range vc.CtxVariants {
+			if v == vnt {
+				return []int{ci, vi}, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// USIdx returns the index of the US with given name and valence, or -1 if not found
+func (vc *Vocab) USIdx(val Valence, nm string) int {
+	uss := vc.PosUSs
+	if val == NEG {
+		uss = vc.NegUSs
+	}
+	for i, us := range uss {
+		if us == nm {
+			return i
+		}
+	}
+	return -1
+}
+
+// USTimeRow returns the USTimeIn row for the stimulus with given index (-1 = none)
+func (vc *Vocab) USTimeRow(stm int) int {
+	if stm < 0 {
+		return 0
+	}
+	if len(vc.StimUSTimeGps) == 0 {
+		return stm + 1
+	}
+	return vc.StimUSTimeGps[stm]
+}
+
+// bit shifts of the fields of a PackedUSTimeState
+func (vc *Vocab) usShift() int  { return len(vc.Stims) }
+func (vc *Vocab) valShift() int { return vc.usShift() + vc.NUSs() }
+func (vc *Vocab) tckShift() int { return vc.valShift() + int(ValenceN) }
+
+// Pack returns the PackedUSTimeState for given stimulus and US indexes (-1 = none),
+// valence and timestep, with one bit set for each field that is present.
+// For the DefaultVocab, this is the same as USTimeState.Pack.
+func (vc *Vocab) Pack(stm, us int, val Valence, tck Tick) PackedUSTimeState {
+	ret := USTimeNone
+	if stm >= 0 {
+		ret += 1 << uint(stm)
+	}
+	if us >= 0 {
+		ret += 1 << uint(vc.usShift()+us)
+	}
+	if val != ValNone {
+		ret += 1 << uint(vc.valShift()+int(val))
+	}
+	if tck != TckNone {
+		ret += 1 << uint(vc.tckShift()+int(tck))
+	}
+	return ret
+}
+
+// fieldIdx returns the index of the lowest bit set in n bits of ps starting at shift, or -1 if none
+func fieldIdx(ps PackedUSTimeState, shift, n int) int {
+	for i := 0; i < n; i++ {
+		if ps&(1<<uint(shift+i)) != 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// Unpack returns the stimulus and US indexes (-1 = none), valence and timestep of ps
+func (vc *Vocab) Unpack(ps PackedUSTimeState) (stm, us int, val Valence, tck Tick) {
+	stm = fieldIdx(ps, 0, len(vc.Stims))
+	us = fieldIdx(ps, vc.usShift(), vc.NUSs())
+	val = ValNone
+	if vi := fieldIdx(ps, vc.valShift(), int(ValenceN)); vi >= 0 {
+		val = Valence(vi)
+	}
+	tck = TckNone
+	if ti := fieldIdx(ps, vc.tckShift(), int(TickN)); ti >= 0 {
+		tck = Tick(ti)
+	}
+	return
+}
+
+// USTimeCoords returns the USTimeIn [row, valence, US, timestep] coordinates of ps
+func (vc *Vocab) USTimeCoords(ps PackedUSTimeState) []int {
+	stm, us, val, tck := vc.Unpack(ps)
+	valCoord := 0
+	if val == NEG {
+		valCoord = 1
+	}
+	if us < 0 {
+		us = 0
+	}
+	if tck == TckNone {
+		tck = 0
+	}
+	return []int{vc.USTimeRow(stm), valCoord, us, int(tck)}
+}
+
+// USTimeFmString returns the PackedUSTimeState for a string of the form
+// [Stim_](Pos|Neg)US<index>_t<timestep>, e.g., A_PosUS0_t1, and USTimeNone
+// if the string is not of this form, or the stimulus is not in the vocabulary.
+func (vc *Vocab) USTimeFmString(s string) PackedUSTimeState {
+	ui := strings.Index(s, "US")
+	ti := strings.LastIndex(s, "_t")
+	if ui < 3 || ti < ui {
+		return USTimeNone
+	}
+	var val Valence
+	switch s[ui-3 : ui] {
+	case "Pos":
+		val = POS
+	case "Neg":
+		val = NEG
+	default:
+		return USTimeNone
+	}
+	stm := -1
+	if pre := s[:ui-3]; pre != "" {
+		if stm = vc.StimIdx(strings.TrimSuffix(pre, "_")); stm < 0 {
+			return USTimeNone
+		}
+	}
+	us, err := strconv.Atoi(s[ui+2 : ti])
+	if err != nil || us < 0 || us >= vc.NUSs() {
+		return USTimeNone
+	}
+	tck, err := strconv.Atoi(s[ti+2:])
+	if err != nil || tck < 0 || tck >= vc.NUSTimes {
+		return USTimeNone
+	}
+	return vc.Pack(stm, us, val, Tick(tck))
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pvlv
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestDefaultVocab checks that the DefaultVocab reproduces the input enums
+func TestDefaultVocab(t *testing.T) {
+	vc := DefaultVocab()
+	if err := vc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	shapes := []struct {
+		nm        string
+		shp, legc []int
+	}{
+		{"StimIn", vc.StimInShape(), StimInShape},
+		{"ContextIn", vc.ContextInShape(), ContextInShape},
+		{"PV", vc.USInShape(), USInShape},
+		{"USTimeIn", vc.USTimeInShape(), USTimeInShape},
+	}
+	for _, sh := range shapes {
+		if !reflect.DeepEqual(sh.shp, sh.legc) {
+			t.Errorf("%s shape: %v != %v", sh.nm, sh.shp, sh.legc)
+		}
+	}
+	for stm := StmA; stm < StimN; stm++ {
+		if si := vc.StimIdx(stm.String()); si != int(stm) {
+			t.Errorf("Stim %v index: %d", stm, si)
+		}
+	}
+	for ctx := CtxA; ctx < NContexts; ctx++ {
+		coords, ok := vc.ContextCoords(ctx.String())
+		if !ok || !reflect.DeepEqual(coords, ctx.Parts()) {
+			t.Errorf("Context %v coords: %v != %v", ctx, coords, ctx.Parts())
+		}
+	}
+	if coords, ok := vc.ContextCoords("QQ"); ok || coords != nil {
+		t.Errorf("unknown Context coords: %v, %v", coords, ok)
+	}
+	for us := Water; us < NPosUS; us++ {
+		if ui := vc.USIdx(POS, us.String()); ui != int(us) {
+			t.Errorf("PosUS %v index: %d", us, ui)
+		}
+	}
+	for us := Shock; us < NNegUS; us++ {
+		if ui := vc.USIdx(NEG, us.String()); ui != int(us) {
+			t.Errorf("NegUS %v index: %d", us, ui)
+		}
+	}
+}
+
+// TestDefaultVocabPack checks that the DefaultVocab USTime encoding matches USTimeState
+func TestDefaultVocabPack(t *testing.T) {
+	vc := DefaultVocab()
+	for stm := -1; stm < int(StimN); stm++ {
+		for us := 0; us < int(NPosUS); us++ {
+			for _, val := range []Valence{POS, NEG} {
+				for tck := T0; tck < Tick(vc.NUSTimes); tck++ {
+					usts := USTimeState{Stm: Stim(stm), US: US(us), Val: val, Tck: tck}
+					if stm < 0 {
+						usts.Stm = StmNone
+					}
+					ps := vc.Pack(stm, us, val, tck)
+					if ps != usts.Pack() {
+						t.Fatalf("%v Pack: %d != %d", usts, ps, usts.Pack())
+					}
+					if s, u, v, k := vc.Unpack(ps); s != stm || u != us || v != val || k != tck {
+						t.Fatalf("%v Unpack: %d %d %v %v", usts, s, u, v, k)
+					}
+					if coords := vc.USTimeCoords(ps); !reflect.DeepEqual(coords, usts.Coords()) {
+						t.Fatalf("%v USTimeCoords: %v != %v", usts, coords, usts.Coords())
+					}
+				}
+			}
+		}
+	}
+	for _, s := range []string{"A_PosUS0_t1", "Z_NegUS3_t4", "PosUS2_t0", "NegUS1_t3", "USTimeNone", "", "A_PosUS0_t9"} {
+		if ps, lps := vc.USTimeFmString(s), PUSTFromString(s); ps != lps {
+			t.Errorf("USTimeFmString(%q): %d != %d", s, ps, lps)
+		}
+	}
+}
+
+func testVocab() *Vocab {
+	return &Vocab{
+		Stims:       strings.Split("ABCDEFGHIJKLMNOPQRST", ""),
+		Contexts:    []string{"A", "B", "AB", "K"},
+		CtxVariants: []string{"", "X", "Y", "Z"},
+		PosUSs:      []string{"Sucrose", "Water"},
+		NegUSs:      []string{"Shock", "Noise", "AirPuff"},
+		NUSTimes:    6,
+	}
+}
+
+// TestVocab checks a vocabulary that extends beyond the input enums
+func TestVocab(t *testing.T) {
+	vc := testVocab()
+	if err := vc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if shp := vc.StimInShape(); !reflect.DeepEqual(shp, []int{20, 1}) {
+		t.Errorf("StimIn shape: %v", shp)
+	}
+	if shp := vc.ContextInShape(); !reflect.DeepEqual(shp, []int{4, 4}) {
+		t.Errorf("ContextIn shape: %v", shp)
+	}
+	if shp := vc.USTimeInShape(); !reflect.DeepEqual(shp, []int{21, 2, 3, 6}) {
+		t.Errorf("USTimeIn shape: %v", shp)
+	}
+	if coords, ok := vc.ContextCoords("AB_Z"); !ok || !reflect.DeepEqual(coords, []int{2, 3}) {
+		t.Errorf("AB_Z coords: %v", coords)
+	}
+	if vc.USIdx(POS, "Water") != 1 || vc.USIdx(NEG, "AirPuff") != 2 || vc.USIdx(NEG, "Water") != -1 {
+		t.Errorf("USIdx incorrect")
+	}
+	ps := vc.USTimeFmString("T_NegUS2_t5")
+	if stm, us, val, tck := vc.Unpack(ps); stm != 19 || us != 2 || val != NEG || tck != T5 {
+		t.Errorf("T_NegUS2_t5 Unpack: %d %d %v %v", stm, us, val, tck)
+	}
+	if coords := vc.USTimeCoords(ps); !reflect.DeepEqual(coords, []int{20, 1, 2, 5}) {
+		t.Errorf("T_NegUS2_t5 coords: %v", coords)
+	}
+	if ps := vc.USTimeFmString("U_PosUS0_t0"); ps != USTimeNone {
+		t.Errorf("unknown stimulus USTimeFmString: %d", ps)
+	}
+
+	tests := []struct {
+		edit func(vc *Vocab)
+		err  string
+	}{
+		{func(vc *Vocab) { vc.Stims[3] = "A" }, "duplicate"},
+		{func(vc *Vocab) { vc.Stims[3] = "DD" }, "single character"},
+		{func(vc *Vocab) { vc.CtxVariants[0] = "W" }, "first of CtxVariants"},
+		{func(vc *Vocab) { vc.StimUSTimeGps = []int{1, 2} }, "StimUSTimeGps"},
+		{func(vc *Vocab) { vc.NegUSs = nil }, "non-empty"},
+		{func(vc *Vocab) { vc.NUSTimes = 11 }, "NUSTimes"},
+	}
+	for _, tt := range tests {
+		vc := testVocab()
+		tt.edit(vc)
+		if err := vc.Validate(); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Validate error: %v, expected: %s", err, tt.err)
+		}
+	}
+}
+
+// TestExptVocab checks that trial blocks are validated against the Vocab of the experiment
+func TestExptVocab(t *testing.T) {
+	ex := testExpt()
+	ex.Vocab = testVocab()
+	if err := ex.Validate(); err == nil || !strings.Contains(err.Error(), `invalid POS USType: "Food"`) {
+		t.Errorf("Validate error: %v", err)
+	}
+	for _, blk := range ex.TrialBlocks {
+		for _, tb := range blk {
+			if tb.ValenceContext == POS {
+				tb.USType = "Sucrose"
+			} else {
+				tb.USType = "AirPuff"
+			}
+		}
+	}
+	ex.TrialBlocks["Ext"][0].CS = "AT"
+	if err := ex.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	ex.TrialBlocks["Ext"][0].CS = "AZ"
+	if err := ex.Validate(); err == nil || !strings.Contains(err.Error(), `invalid CS: "AZ"`) {
+		t.Errorf("Validate error: %v", err)
+	}
+}
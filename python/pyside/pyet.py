@@ -7,8 +7,9 @@
 # which has the same structure as an `etable`, and is used in the
 # `pytorch` neural network framework.
 
-from leabra import go, etable, etensor
+from leabra import go, etable, etensor, leabra
 
+import ctypes
 import numpy as np
 import pandas as pd
 import torch
@@ -381,3 +382,77 @@ def pandas_to_etable(df):
         pt.AddCol(dc, cn)
     return pt
     
+
+def go_buf_to_numpy(buf):
+    """
+    returns a float32 numpy ndarray that is a view directly on the Go memory
+    of given leabra.Float32Buf, as filled by the leabra UnitValsBuf and
+    SynValsBuf methods: no data is copied, and there are no per-element
+    calls into Go.  The view keeps the buffer alive, and is only valid
+    until the buffer length is changed (e.g., by filling it with a
+    different number of values).
+    """
+    n = buf.Len()
+    if n == 0:
+        return np.zeros(0, dtype=np.float32)
+    cbuf = (ctypes.c_float * n).from_address(buf.Ptr())
+    cbuf._gobuf = buf # keep the Go buffer referenced as long as the view
+    return np.frombuffer(cbuf, dtype=np.float32)
+
+def numpy_to_go_buf(nar, buf=None):
+    """
+    returns a leabra.Float32Buf (given buf if not None) holding the values
+    of the given numpy ndarray in row-major (C) order, as expected by the
+    leabra SetUnitValsBuf and SetSynValsBuf methods.  the values are copied
+    directly into the Go memory in one numpy operation, without per-element
+    calls into Go.
+    """
+    narf = np.reshape(nar, -1)
+    if buf is None:
+        buf = leabra.NewFloat32Buf(len(narf))
+    else:
+        buf.SetLen(len(narf))
+    go_buf_to_numpy(buf)[:] = narf
+    return buf
+
+def layer_to_numpy(ly, varnm, buf=None):
+    """
+    returns a float32 numpy ndarray with the values of given neuron variable
+    name for all units in given leabra layer, shaped according to the layer shape.
+    this is a view on the Go memory of the buffer (see go_buf_to_numpy) --
+    pass the same buf to reuse it across calls, in which case prior views on it
+    are overwritten.
+    """
+    if buf is None:
+        buf = leabra.NewFloat32Buf(0)
+    ly.UnitValsBuf(buf, varnm)
+    return go_buf_to_numpy(buf).reshape(tuple(ly.Shp.Shp))
+
+def numpy_to_layer(ly, nar, varnm, buf=None):
+    """
+    sets the values of given neuron variable name for all units in given
+    leabra layer from given numpy ndarray, which must have at least as
+    many elements as the layer has units (in row-major order).
+    """
+    ly.SetUnitValsBuf(numpy_to_go_buf(nar, buf), varnm)
+
+def prjn_to_numpy(pj, varnm, buf=None):
+    """
+    returns a float32 numpy ndarray with the values of given synapse variable
+    name for given leabra projection, as a dense [recv, send] matrix
+    of flat unit indexes, with NaN for missing synapses.
+    this is a view on the Go memory of the buffer (see layer_to_numpy).
+    """
+    if buf is None:
+        buf = leabra.NewFloat32Buf(0)
+    pj.SynValsBuf(buf, varnm)
+    return go_buf_to_numpy(buf).reshape((pj.Recv.Shape().Len(), pj.Send.Shape().Len()))
+
+def numpy_to_prjn(pj, nar, varnm, buf=None):
+    """
+    sets the values of given synapse variable name for given leabra projection
+    from given dense [recv, send] numpy ndarray, as returned by prjn_to_numpy.
+    values for missing synapses and NaN values are ignored.
+    setting Wt also updates the underlying linear weight LWt.
+    """
+    pj.SetSynValsBuf(numpy_to_go_buf(nar, buf), varnm)
@@ -0,0 +1,116 @@
+# Copyright (c) 2020, The Emergent Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+# tests for the zero-copy transfer of unit and synapse values between
+# Go and numpy in pyet, which can be run without building the gopy
+# bindings: the Go leabra.Float32Buf is stood in for by CountBuf,
+# which counts every call made on it, and is backed by ctypes memory
+# in the same way that the Go buffer is backed by Go memory.
+# run with: python3 -m unittest pyet_test
+
+import ctypes
+import sys
+import types
+import unittest
+
+try:
+    import numpy as np
+except ImportError:
+    np = None
+
+class CountBuf(object):
+    """
+    CountBuf stands in for a Go leabra.Float32Buf, counting all calls
+    """
+    def __init__(self, n):
+        self.calls = 0
+        self.SetLen(n)
+        self.calls = 0
+
+    def SetLen(self, n):
+        self.calls += 1
+        self.mem = (ctypes.c_float * n)()
+
+    def Len(self):
+        self.calls += 1
+        return len(self.mem)
+
+    def Ptr(self):
+        self.calls += 1
+        return ctypes.addressof(self.mem) if len(self.mem) > 0 else 0
+
+class CountLayer(object):
+    """
+    CountLayer stands in for a Go leabra.Layer with given shape
+    """
+    def __init__(self, shp):
+        self.Shp = types.SimpleNamespace(Shp=shp)
+        self.vals = [float(i) for i in range(int(np.prod(shp)))]
+        self.set = None
+
+    def UnitValsBuf(self, buf, varnm):
+        if buf.Len() != len(self.vals):
+            buf.SetLen(len(self.vals))
+        ctypes.memmove(buf.Ptr(), (ctypes.c_float * len(self.vals))(*self.vals), 4 * len(self.vals))
+
+    def SetUnitValsBuf(self, buf, varnm):
+        self.set = list((ctypes.c_float * buf.Len()).from_address(buf.Ptr()))
+
+def import_pyet():
+    """
+    imports pyet with stub leabra (and pandas, torch if missing) modules
+    """
+    lb = types.ModuleType("leabra")
+    for nm in ["go", "etable", "etensor"]:
+        setattr(lb, nm, types.SimpleNamespace())
+    lb.leabra = types.SimpleNamespace(NewFloat32Buf=CountBuf)
+    sys.modules["leabra"] = lb
+    for nm in ["pandas", "torch", "torch.utils", "torch.utils.data"]:
+        try:
+            __import__(nm)
+        except ImportError:
+            sys.modules[nm] = types.ModuleType(nm)
+    sys.modules["torch"].utils = sys.modules["torch.utils"]
+    sys.modules["torch.utils"].data = sys.modules["torch.utils.data"]
+    import pyet
+    return pyet
+
+@unittest.skipIf(np is None, "numpy is required")
+class TestGoBuf(unittest.TestCase):
+    def setUp(self):
+        self.pyet = import_pyet()
+
+    def test_view_no_copy(self):
+        for n in [10, 100000]:
+            buf = CountBuf(n)
+            nar = self.pyet.go_buf_to_numpy(buf)
+            self.assertEqual(buf.calls, 2) # Len and Ptr only, regardless of n
+            self.assertEqual(nar.dtype, np.float32)
+            self.assertEqual(len(nar), n)
+            buf.mem[n-1] = 3.5 # Go side change is seen in numpy
+            self.assertEqual(nar[n-1], 3.5)
+            nar[0] = 1.5 # numpy side change is seen in Go
+            self.assertEqual(buf.mem[0], 1.5)
+
+    def test_numpy_to_buf(self):
+        src = np.arange(100000, dtype=np.float64).reshape(100, 1000)
+        buf = self.pyet.numpy_to_go_buf(src)
+        self.assertLessEqual(buf.calls, 2)
+        self.assertEqual(buf.mem[99999], 99999.0)
+        buf.calls = 0
+        self.pyet.numpy_to_go_buf(src[:10], buf)
+        self.assertLessEqual(buf.calls, 3) # SetLen, Len, Ptr
+        self.assertEqual(len(buf.mem), 10000)
+
+    def test_layer(self):
+        ly = CountLayer([2, 3])
+        buf = CountBuf(0)
+        nar = self.pyet.layer_to_numpy(ly, "Act", buf)
+        self.assertEqual(nar.shape, (2, 3))
+        self.assertEqual(nar[1, 2], 5.0)
+        self.pyet.numpy_to_layer(ly, nar * 2, "Act")
+        self.assertEqual(ly.set, [0.0, 2.0, 4.0, 6.0, 8.0, 10.0])
+
+if __name__ == "__main__":
+    unittest.main()
@@ -0,0 +1,113 @@
+# Copyright (c) 2020, The Emergent Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+"""
+Reference Python client for the leabrax envs.SocketEnv protocol.
+
+An external environment (game, robotic simulator, etc.) connects to a
+leabra model running a SocketEnv with Listen = true, and serves
+observations in response to the model's reset and step messages.
+
+Each message is one line of JSON:
+
+  model -> env:  {"type": "reset", "run": 0}
+                 {"type": "step", "actions": {"Move": {"shape": [1, 2], "values": [0.1, 0.9]}}}
+                 {"type": "close"}
+  env -> model:  {"type": "obs", "obs": {"Pos": {"shape": [1, 4], "values": [...]}},
+                  "reward": 0.0, "done": false}
+
+Tensor values are in row-major order, with the outermost dimension first.
+In lockstep mode (default), each reset and step must be answered with exactly
+one obs message.  Otherwise the environment can send obs messages at any time.
+
+Usage:
+
+    class MyEnv:
+        def reset(self, run):
+            return {"Pos": ([1, 4], [1, 0, 0, 0])}, 0.0, False
+
+        def step(self, actions):
+            shape, values = actions["Move"]
+            ...
+            return obs, reward, done
+
+    client = SockEnvClient("/tmp/leabra.sock")  # or ("localhost", 5555) for tcp
+    client.serve(MyEnv())
+"""
+
+import json
+import socket
+
+
+def encode_tensor(shape, values):
+    """returns the protocol encoding of a tensor with given shape and flat values"""
+    return {"shape": list(shape), "values": [float(v) for v in values]}
+
+
+def decode_tensor(tsr):
+    """returns (shape, values) for given protocol tensor encoding"""
+    return tsr["shape"], tsr["values"]
+
+
+class SockEnvClient(object):
+    """
+    SockEnvClient connects to a leabra SocketEnv listening at given address:
+    a file path string for a unix socket, or a (host, port) tuple for tcp.
+    """
+
+    def __init__(self, address):
+        if isinstance(address, str):
+            self.sock = socket.socket(socket.AF_UNIX, socket.SOCK_STREAM)
+        else:
+            self.sock = socket.socket(socket.AF_INET, socket.SOCK_STREAM)
+        self.sock.connect(address)
+        self.rfile = self.sock.makefile("r")
+        self.wfile = self.sock.makefile("w")
+
+    def send(self, msg):
+        """sends given message dict"""
+        self.wfile.write(json.dumps(msg) + "\n")
+        self.wfile.flush()
+
+    def recv(self):
+        """returns the next message dict, or None if the connection is closed"""
+        line = self.rfile.readline()
+        if not line:
+            return None
+        return json.loads(line)
+
+    def send_obs(self, obs, reward=0.0, done=False):
+        """
+        sends an observation: obs is a dict of name -> (shape, values),
+        reward is for the previous action, and done ends the episode
+        """
+        msg = {"type": "obs", "obs": {}, "reward": float(reward), "done": bool(done)}
+        for nm, (shape, values) in obs.items():
+            msg["obs"][nm] = encode_tensor(shape, values)
+        self.send(msg)
+
+    def serve(self, env):
+        """
+        runs the lockstep protocol until the model closes the connection:
+        env.reset(run) and env.step(actions) must each return (obs, reward, done),
+        where obs and actions are dicts of name -> (shape, values)
+        """
+        while True:
+            msg = self.recv()
+            if msg is None or msg["type"] == "close":
+                break
+            if msg["type"] == "reset":
+                obs, reward, done = env.reset(msg.get("run", 0))
+            elif msg["type"] == "step":
+                acts = {}
+                for nm, tsr in (msg.get("actions") or {}).items():
+                    acts[nm] = decode_tensor(tsr)
+                obs, reward, done = env.step(acts)
+            else:
+                continue
+            self.send_obs(obs, reward, done)
+        self.close()
+
+    def close(self):
+        self.sock.close()
@@ -18,6 +18,16 @@ algorithm (RW) and Temporal Differences (TD) learning, and a minimal
   after activation is updated.  Thus, DA lags by 1 cycle,
   which typically should not be a problem.
 
+* `scalar.go` defines a `ScalarValLayer` that represents a scalar
+  value with a population code of gaussian bumps, and a `ValueLayer`
+  interface that the RW and TD layers use to read values, so they
+  work with either single-unit or population-coded layers
+  (see AddRWLayersScalar and AddTDLayersScalar).
+
+* `tdlambda.go` defines `TDLambdaPrjn`, a `TDRewPredPrjn` with
+  TD(lambda) eligibility traces, which are reset at episode
+  boundaries with ClearTDTraces.
+
 * See the separate `pvlv` package for the full biologically-based
   pvlv model on top of this basic DA infrastructure.
 */
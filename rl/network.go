@@ -25,20 +25,50 @@ func AddClampDaLayer(nt *leabra.Network, name string) *ClampDaLayer {
 // Projection from Rew to RewInteg is given class TDRewToInteg -- should
 // have no learning and 1 weight.
 func AddTDLayers(nt *leabra.Network, prefix string, rel relpos.Relations, space float32) (rew, rp, ri, td leabra.LeabraLayer) {
-	rew = nt.AddLayer2D(prefix+"Rew", 1, 1, emer.Input).(leabra.LeabraLayer)
-	rp = &TDRewPredLayer{}
-	nt.AddLayerInit(rp, prefix+"RewPred", []int{1, 1}, emer.Hidden)
-	ri = &TDRewIntegLayer{}
-	nt.AddLayerInit(ri, prefix+"RewInteg", []int{1, 1}, emer.Hidden)
+	return addTDLayers(nt, prefix, rel, space, 0)
+}
+
+// AddTDLayersScalar adds population-coded TD temporal differences layers,
+// generating a DA signal: Rew is a ScalarValLayer, RewPred a TDRewPredScalarLayer,
+// and RewInteg a TDRewIntegScalarLayer, each with nUnits units, and TD is a
+// single-unit TDDaLayer.  Use ApplyVal on Rew to apply reward values.
+// Projection from Rew to RewInteg is one-to-one, given class TDRewToInteg -- should
+// have no learning and 1 weight.
+func AddTDLayersScalar(nt *leabra.Network, prefix string, rel relpos.Relations, space float32, nUnits int) (rew, rp, ri, td leabra.LeabraLayer) {
+	return addTDLayers(nt, prefix, rel, space, nUnits)
+}
+
+// addTDLayers adds TD layers, population-coded with nUnits units if nUnits > 0
+func addTDLayers(nt *leabra.Network, prefix string, rel relpos.Relations, space float32, nUnits int) (rew, rp, ri, td leabra.LeabraLayer) {
+	var pat prjn.Pattern
+	if nUnits > 0 {
+		rew = &ScalarValLayer{}
+		nt.AddLayerInit(rew, prefix+"Rew", []int{1, nUnits}, emer.Input)
+		rp = &TDRewPredScalarLayer{}
+		nt.AddLayerInit(rp, prefix+"RewPred", []int{1, nUnits}, emer.Hidden)
+		ril := &TDRewIntegScalarLayer{}
+		nt.AddLayerInit(ril, prefix+"RewInteg", []int{1, nUnits}, emer.Hidden)
+		ril.RewInteg.RewPred = rp.Name()
+		ri = ril
+		pat = prjn.NewOneToOne()
+	} else {
+		rew = nt.AddLayer2D(prefix+"Rew", 1, 1, emer.Input).(leabra.LeabraLayer)
+		rp = &TDRewPredLayer{}
+		nt.AddLayerInit(rp, prefix+"RewPred", []int{1, 1}, emer.Hidden)
+		ril := &TDRewIntegLayer{}
+		nt.AddLayerInit(ril, prefix+"RewInteg", []int{1, 1}, emer.Hidden)
+		ril.RewInteg.RewPred = rp.Name()
+		ri = ril
+		pat = prjn.NewFull()
+	}
 	td = &TDDaLayer{}
 	nt.AddLayerInit(td, prefix+"TD", []int{1, 1}, emer.Hidden)
-	ri.(*TDRewIntegLayer).RewInteg.RewPred = rp.Name()
 	td.(*TDDaLayer).RewInteg = ri.Name()
 	rp.SetRelPos(relpos.Rel{Rel: rel, Other: rew.Name(), YAlign: relpos.Front, Space: space})
 	ri.SetRelPos(relpos.Rel{Rel: rel, Other: rp.Name(), YAlign: relpos.Front, Space: space})
 	td.SetRelPos(relpos.Rel{Rel: rel, Other: ri.Name(), YAlign: relpos.Front, Space: space})
 
-	pj := nt.ConnectLayers(rew, ri, prjn.NewFull(), emer.Forward).(leabra.LeabraPrjn).AsLeabra()
+	pj := nt.ConnectLayers(rew, ri, pat, emer.Forward).(leabra.LeabraPrjn).AsLeabra()
 	pj.SetClass("TDRewToInteg")
 	pj.Learn.Learn = false
 	pj.WtInit.Mean = 1
@@ -58,13 +88,34 @@ func AddTDLayers(nt *leabra.Network, prefix string, rel relpos.Relations, space
 // Reward layer, a RWPred prediction layer, and a dopamine layer that computes diff.
 // Only generates DA when Rew layer has external input -- otherwise zero.
 func AddRWLayers(nt *leabra.Network, prefix string, rel relpos.Relations, space float32) (rew, rp, da leabra.LeabraLayer) {
-	rew = nt.AddLayer2D(prefix+"Rew", 1, 1, emer.Input).(leabra.LeabraLayer)
-	rp = &RWPredLayer{}
-	nt.AddLayerInit(rp, prefix+"RWPred", []int{1, 1}, emer.Hidden)
+	return addRWLayers(nt, prefix, rel, space, 0)
+}
+
+// AddRWLayersScalar adds a population-coded Rescorla-Wagner (PV only) dopamine system,
+// with a primary Reward ScalarValLayer, a RWPredScalarLayer prediction layer,
+// each with nUnits units, and a single-unit dopamine layer that computes diff.
+// Use ApplyVal on Rew to apply reward values.
+// Only generates DA when Rew layer has external input -- otherwise zero.
+func AddRWLayersScalar(nt *leabra.Network, prefix string, rel relpos.Relations, space float32, nUnits int) (rew, rp, da leabra.LeabraLayer) {
+	return addRWLayers(nt, prefix, rel, space, nUnits)
+}
+
+// addRWLayers adds RW layers, population-coded with nUnits units if nUnits > 0
+func addRWLayers(nt *leabra.Network, prefix string, rel relpos.Relations, space float32, nUnits int) (rew, rp, da leabra.LeabraLayer) {
+	if nUnits > 0 {
+		rew = &ScalarValLayer{}
+		nt.AddLayerInit(rew, prefix+"Rew", []int{1, nUnits}, emer.Input)
+		rp = &RWPredScalarLayer{}
+		nt.AddLayerInit(rp, prefix+"RWPred", []int{1, nUnits}, emer.Hidden)
+	} else {
+		rew = nt.AddLayer2D(prefix+"Rew", 1, 1, emer.Input).(leabra.LeabraLayer)
+		rp = &RWPredLayer{}
+		nt.AddLayerInit(rp, prefix+"RWPred", []int{1, 1}, emer.Hidden)
+	}
 	da = &RWDaLayer{}
 	nt.AddLayerInit(da, prefix+"DA", []int{1, 1}, emer.Hidden)
 	da.(*RWDaLayer).RewLay = rew.Name()
-	da.(*RWDaLayer).RewLay = rew.Name()
+	da.(*RWDaLayer).RWPredLay = rp.Name()
 	rp.SetRelPos(relpos.Rel{Rel: rel, Other: rew.Name(), YAlign: relpos.Front, Space: space})
 	da.SetRelPos(relpos.Rel{Rel: rel, Other: rp.Name(), YAlign: relpos.Front, Space: space})
 
@@ -88,3 +139,4 @@ func AddRWLayersPy(nt *leabra.Network, prefix string, rel relpos.Relations, spac
 	rew, rp, da := AddRWLayers(nt, prefix, rel, space)
 	return []leabra.LeabraLayer{rew, rp, da}
 }
+
@@ -5,6 +5,7 @@
 package rl
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/ccnlab/leabrax/deep"
@@ -37,6 +38,9 @@ func (ly *RWPredLayer) Defaults() {
 func (ly *RWPredLayer) GetDA() float32   { return ly.DA }
 func (ly *RWPredLayer) SetDA(da float32) { ly.DA = da }
 
+// ActVal returns the prediction value -- ValueLayer interface
+func (ly *RWPredLayer) ActVal(vv ValVars) float32 { return UnitVal(&ly.Neurons[0], vv) }
+
 // ActFmG computes linear activation for RWPred
 func (ly *RWPredLayer) ActFmG(ltime *leabra.Time) {
 	for ni := range ly.Neurons {
@@ -48,6 +52,42 @@ func (ly *RWPredLayer) ActFmG(ltime *leabra.Time) {
 	}
 }
 
+//////////////////////////////////////////////////////////////////////////////////////
+//  RWPredScalarLayer
+
+// RWPredScalarLayer is a population-coded version of RWPredLayer, which
+// represents the reward prediction as a gaussian bump in a ScalarValLayer.
+// Unit activity is a linear function of excitatory conductance, clipped to 0..1.
+// Use with RWPrjn, which learns to produce the bump encoding the actual reward value,
+// using the per-unit errors from UnitDAErrs.
+type RWPredScalarLayer struct {
+	ScalarValLayer
+	PredRange minmax.F32 `desc:"default 0.01..0.99 range of target predictions that are learned -- having a truncated range preserves some sensitivity in dopamine at the extremes of good or poor performance"`
+	DA        float32    `inactive:"+" desc:"dopamine value for this layer"`
+}
+
+var KiT_RWPredScalarLayer = kit.Types.AddType(&RWPredScalarLayer{}, leabra.LayerProps)
+
+func (ly *RWPredScalarLayer) Defaults() {
+	ly.ScalarValLayer.Defaults()
+	ly.PredRange.Set(0.01, 0.99)
+}
+
+// DALayer interface:
+
+func (ly *RWPredScalarLayer) GetDA() float32   { return ly.DA }
+func (ly *RWPredScalarLayer) SetDA(da float32) { ly.DA = da }
+
+// UnitDAErrs returns per-unit errors toward the prediction plus DA -- DAErrLayer interface
+func (ly *RWPredScalarLayer) UnitDAErrs(da float32) []float32 {
+	return ly.DAErrs(da, ly.PredRange.Min, ly.PredRange.Max)
+}
+
+// ActFmG computes linear activation for RWPredScalar
+func (ly *RWPredScalarLayer) ActFmG(ltime *leabra.Time) {
+	ly.linearActFmG()
+}
+
 //////////////////////////////////////////////////////////////////////////////////////
 //  RWDaLayer
 
@@ -57,11 +97,13 @@ func (ly *RWPredLayer) ActFmG(ltime *leabra.Time) {
 // r(t) is accessed directly from a Rew layer -- if no external input then no
 // DA is computed -- critical for effective use of RW only for PV cases.
 // RWPred prediction is also accessed directly from Rew layer to avoid any issues.
+// The reward and prediction values are read using LayerVal, so either can be
+// a single unit or population-coded (ScalarValLayer, RWPredScalarLayer).
 type RWDaLayer struct {
 	leabra.Layer
 	SendDA    SendDA  `desc:"list of layers to send dopamine to"`
 	RewLay    string  `desc:"name of Reward-representing layer from which this computes DA -- if nothing clamped, no dopamine computed"`
-	RWPredLay string  `desc:"name of RWPredLayer layer that is subtracted from the reward value"`
+	RWPredLay string  `desc:"name of RWPredLayer or RWPredScalarLayer layer that is subtracted from the reward value"`
 	DA        float32 `inactive:"+" desc:"dopamine value for this layer"`
 }
 
@@ -83,7 +125,7 @@ func (ly *RWDaLayer) GetDA() float32   { return ly.DA }
 func (ly *RWDaLayer) SetDA(da float32) { ly.DA = da }
 
 // RWLayers returns the reward and RWPred layers based on names
-func (ly *RWDaLayer) RWLayers() (*leabra.Layer, *RWPredLayer, error) {
+func (ly *RWDaLayer) RWLayers() (leabra.LeabraLayer, leabra.LeabraLayer, error) {
 	tly, err := ly.Network.LayerByNameTry(ly.RewLay)
 	if err != nil {
 		log.Printf("RWDaLayer %s, RewLay: %v\n", ly.Name(), err)
@@ -94,7 +136,12 @@ func (ly *RWDaLayer) RWLayers() (*leabra.Layer, *RWPredLayer, error) {
 		log.Printf("RWDaLayer %s, RWPredLay: %v\n", ly.Name(), err)
 		return nil, nil, err
 	}
-	return tly.(leabra.LeabraLayer).AsLeabra(), ply.(*RWPredLayer), nil
+	if _, ok := ply.(ValueLayer); !ok {
+		err = fmt.Errorf("RWDaLayer %s, RWPredLay: %s is not a ValueLayer", ly.Name(), ly.RWPredLay)
+		log.Println(err)
+		return nil, nil, err
+	}
+	return tly.(leabra.LeabraLayer), ply.(leabra.LeabraLayer), nil
 }
 
 // Build constructs the layer state, including calling Build on the projections.
@@ -116,14 +163,9 @@ func (ly *RWDaLayer) ActFmG(ltime *leabra.Time) {
 	if rly == nil || ply == nil {
 		return
 	}
-	rnrn := &(rly.Neurons[0])
-	hasRew := false
-	if rnrn.HasFlag(leabra.NeurHasExt) {
-		hasRew = true
-	}
-	ract := rnrn.Act
-	pnrn := &(ply.Neurons[0])
-	pact := pnrn.Act
+	hasRew := LayerHasExt(rly)
+	ract := LayerVal(rly, ValAct)
+	pact := LayerVal(ply, ValAct)
 	for ni := range ly.Neurons {
 		nrn := &ly.Neurons[ni]
 		if nrn.IsOff() {
@@ -150,6 +192,8 @@ func (ly *RWDaLayer) CyclePost(ltime *leabra.Time) {
 
 // RWPrjn does dopamine-modulated learning for reward prediction: Da * Send.Act
 // Use in RWPredLayer typically to generate reward predictions.
+// For a population-coded RWPredScalarLayer (DAErrLayer), the per-unit
+// errors toward the encoding of the prediction plus DA are used in place of DA.
 // Has no weight bounds or limits on sign etc.
 type RWPrjn struct {
 	leabra.Prjn
@@ -180,6 +224,10 @@ func (pj *RWPrjn) DWt() {
 			return // lda = 0 -- no learning
 		}
 	}
+	var errs []float32
+	if el, ok := pj.Recv.(DAErrLayer); ok {
+		errs = el.UnitDAErrs(lda)
+	}
 	for si := range slay.Neurons {
 		sn := &slay.Neurons[si]
 		nc := int(pj.SConN[si])
@@ -193,10 +241,12 @@ func (pj *RWPrjn) DWt() {
 			rn := &rlay.Neurons[ri]
 
 			da := lda
-			if rn.Ge > rn.Act && da > 0 { // clipped at top, saturate up
+			switch {
+			case errs != nil:
+				da = errs[ri]
+			case rn.Ge > rn.Act && da > 0: // clipped at top, saturate up
 				da = 0
-			}
-			if rn.Ge < rn.Act && da < 0 { // clipped at bottom, saturate down
+			case rn.Ge < rn.Act && da < 0: // clipped at bottom, saturate down
 				da = 0
 			}
 
@@ -0,0 +1,232 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rl
+
+import (
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/chewxy/math32"
+	"github.com/emer/emergent/emer"
+	"github.com/goki/ki/kit"
+)
+
+// ValVars are the activation variables from which a scalar value can be read
+type ValVars int32
+
+const (
+	// ValAct is the current activation
+	ValAct ValVars = iota
+
+	// ValActM is the minus phase activation
+	ValActM
+
+	// ValActP is the plus phase activation
+	ValActP
+)
+
+// ValueLayer is implemented by layers that represent a scalar value, such as reward,
+// or a reward prediction, which is read by the RW and TD layers.  Single-unit layers
+// return the unit activation directly, and ScalarValLayer decodes the value from
+// its population code.  See LayerVal for reading from any layer.
+type ValueLayer interface {
+	// ActVal returns the scalar value represented by given activation variable
+	ActVal(vv ValVars) float32
+}
+
+// UnitVal returns the given activation variable from the neuron
+func UnitVal(nrn *leabra.Neuron, vv ValVars) float32 {
+	switch vv {
+	case ValActM:
+		return nrn.ActM
+	case ValActP:
+		return nrn.ActP
+	}
+	return nrn.Act
+}
+
+// LayerVal returns the scalar value represented by given activation variable in layer:
+// ActVal for a ValueLayer, and otherwise the first unit's activation
+// for a leabra layer (e.g., a single-unit Rew input layer).
+func LayerVal(ly emer.Layer, vv ValVars) float32 {
+	if vl, ok := ly.(ValueLayer); ok {
+		return vl.ActVal(vv)
+	}
+	if ll, ok := ly.(leabra.LeabraLayer); ok {
+		return UnitVal(&ll.AsLeabra().Neurons[0], vv)
+	}
+	return 0
+}
+
+// LayerHasExt returns true if the first unit of the layer has external input --
+// for a ScalarValLayer all units are clamped together by ApplyVal.
+func LayerHasExt(ly leabra.LeabraLayer) bool {
+	return ly.AsLeabra().Neurons[0].HasFlag(leabra.NeurHasExt)
+}
+
+//////////////////////////////////////////////////////////////////////////////////////
+//  ScalarValParams
+
+// ScalarValParams are parameters for a population code of a scalar value,
+// where each unit has a preferred value, evenly spaced between Min and Max,
+// and a value is encoded by a gaussian bump of activity around it,
+// as in the ScalarValLayer of C++ emergent.  The value is decoded as the
+// activation-weighted average of the unit preferred values.
+type ScalarValParams struct {
+	Min    float32 `def:"-0.5" desc:"preferred value of the first unit -- the minimum of the range of values represented"`
+	Max    float32 `def:"1.5" desc:"preferred value of the last unit -- the maximum of the range of values represented -- values should typically fall well within the range as decoding is biased toward the center at the extremes"`
+	Sigma  float32 `def:"0.15" desc:"width of the gaussian bump, as a proportion of the range (Max - Min)"`
+	MinAct float32 `def:"0.01" desc:"total activation below which no value is represented, and the decoded value is 0"`
+}
+
+func (sv *ScalarValParams) Defaults() {
+	sv.Min = -0.5
+	sv.Max = 1.5
+	sv.Sigma = 0.15
+	sv.MinAct = 0.01
+}
+
+// UnitVal returns the preferred value of unit ui out of n units
+func (sv *ScalarValParams) UnitVal(ui, n int) float32 {
+	if n <= 1 {
+		return 0.5 * (sv.Min + sv.Max)
+	}
+	return sv.Min + (sv.Max-sv.Min)*float32(ui)/float32(n-1)
+}
+
+// ClipVal returns the value clipped to the Min..Max range
+func (sv *ScalarValParams) ClipVal(val float32) float32 {
+	return math32.Min(math32.Max(val, sv.Min), sv.Max)
+}
+
+// Encode sets acts to the gaussian bump encoding of given value,
+// with a peak activation of 1.
+func (sv *ScalarValParams) Encode(val float32, acts []float32) {
+	n := len(acts)
+	sig := sv.Sigma * (sv.Max - sv.Min)
+	val = sv.ClipVal(val)
+	for ui := range acts {
+		d := (val - sv.UnitVal(ui, n)) / sig
+		acts[ui] = math32.Exp(-0.5 * d * d)
+	}
+}
+
+// Decode returns the value decoded from given activations,
+// as the activation-weighted average of the unit preferred values.
+// Returns 0 if the total activation is less than MinAct.
+func (sv *ScalarValParams) Decode(acts []float32) float32 {
+	n := len(acts)
+	sum := float32(0)
+	wsum := float32(0)
+	for ui, act := range acts {
+		if act <= 0 {
+			continue
+		}
+		sum += act
+		wsum += act * sv.UnitVal(ui, n)
+	}
+	if sum < sv.MinAct {
+		return 0
+	}
+	return wsum / sum
+}
+
+//////////////////////////////////////////////////////////////////////////////////////
+//  ScalarValLayer
+
+// ScalarValLayer represents a scalar value with a population code
+// of gaussian bumps (see ScalarValParams).
+// Use ApplyVal to clamp a value as external input (e.g., for a Rew layer),
+// and ActVal to read the decoded value.  It is also the basis for the
+// population-coded RW and TD reward prediction layers.
+type ScalarValLayer struct {
+	leabra.Layer
+	ScalarVal ScalarValParams `view:"inline" desc:"population code parameters"`
+	Acts      []float32       `view:"-" desc:"buffer of unit activations for decoding, and bump encoding"`
+	Errs      []float32       `view:"-" desc:"buffer of unit errors for DA-driven learning"`
+}
+
+var KiT_ScalarValLayer = kit.Types.AddType(&ScalarValLayer{}, leabra.LayerProps)
+
+func (ly *ScalarValLayer) Defaults() {
+	ly.Layer.Defaults()
+	ly.ScalarVal.Defaults()
+}
+
+// Build constructs the layer state, including calling Build on the projections.
+func (ly *ScalarValLayer) Build() error {
+	err := ly.Layer.Build()
+	if err != nil {
+		return err
+	}
+	ly.Acts = make([]float32, len(ly.Neurons))
+	ly.Errs = make([]float32, len(ly.Neurons))
+	return nil
+}
+
+// ActVal returns the value decoded from given activation variable -- ValueLayer interface
+func (ly *ScalarValLayer) ActVal(vv ValVars) float32 {
+	for ni := range ly.Neurons {
+		ly.Acts[ni] = UnitVal(&ly.Neurons[ni], vv)
+	}
+	return ly.ScalarVal.Decode(ly.Acts)
+}
+
+// GeVal returns the value decoded from the excitatory conductances
+func (ly *ScalarValLayer) GeVal() float32 {
+	for ni := range ly.Neurons {
+		ly.Acts[ni] = ly.Neurons[ni].Ge
+	}
+	return ly.ScalarVal.Decode(ly.Acts)
+}
+
+// ApplyVal applies the gaussian bump encoding of given value as external input
+func (ly *ScalarValLayer) ApplyVal(val float32) {
+	ly.ScalarVal.Encode(val, ly.Acts)
+	ly.ApplyExt1D32(ly.Acts)
+}
+
+// SetActsVal sets the activations of all units to the encoding of given value
+func (ly *ScalarValLayer) SetActsVal(val float32) {
+	ly.ScalarVal.Encode(val, ly.Acts)
+	for ni := range ly.Neurons {
+		nrn := &ly.Neurons[ni]
+		if nrn.IsOff() {
+			continue
+		}
+		nrn.Act = ly.Acts[ni]
+	}
+}
+
+// DAErrs returns the per-unit errors used for dopamine-driven learning:
+// the difference between the encoding of the target value, given by the
+// minus-phase value plus the dopamine, clipped to targRange, and the
+// minus-phase activations.  This is the population-code equivalent of
+// the dopamine in a single-unit prediction layer.
+func (ly *ScalarValLayer) DAErrs(da float32, targMin, targMax float32) []float32 {
+	val := ly.ActVal(ValActM)
+	targ := math32.Min(math32.Max(val+da, targMin), targMax)
+	ly.ScalarVal.Encode(targ, ly.Errs)
+	for ni := range ly.Neurons {
+		ly.Errs[ni] -= ly.Neurons[ni].ActM
+	}
+	return ly.Errs
+}
+
+// linearActFmG sets activations to the excitatory conductance, clipped to 0..1
+func (ly *ScalarValLayer) linearActFmG() {
+	for ni := range ly.Neurons {
+		nrn := &ly.Neurons[ni]
+		if nrn.IsOff() {
+			continue
+		}
+		nrn.Act = math32.Min(math32.Max(nrn.Ge, 0), 1)
+	}
+}
+
+// DAErrLayer is a population-coded prediction layer that converts the dopamine
+// signal into per-unit errors for learning, used by RWPrjn and TDRewPredPrjn.
+type DAErrLayer interface {
+	// UnitDAErrs returns the per-unit errors for given dopamine value
+	UnitDAErrs(da float32) []float32
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rl
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/chewxy/math32"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+	"github.com/emer/emergent/relpos"
+)
+
+func TestScalarValEncode(t *testing.T) {
+	sv := ScalarValParams{}
+	sv.Defaults()
+	acts := make([]float32, 21)
+	for _, val := range []float32{0, 0.25, 0.5, 0.7, 1} {
+		sv.Encode(val, acts)
+		if dv := sv.Decode(acts); math32.Abs(dv-val) > 0.05 {
+			t.Errorf("val: %g decoded: %g", val, dv)
+		}
+	}
+	for i := range acts {
+		acts[i] = 0
+	}
+	if dv := sv.Decode(acts); dv != 0 {
+		t.Errorf("no activity decoded: %g != 0", dv)
+	}
+}
+
+// rlTestNet is an Input layer projecting to a reward prediction layer
+type rlTestNet struct {
+	Net   *leabra.Network
+	Input *leabra.Layer
+	Rew   leabra.LeabraLayer
+	Pred  leabra.LeabraLayer
+	DA    leabra.LeabraLayer
+	Time  *leabra.Time
+}
+
+func newRWTestNet(t *testing.T, nUnits int) *rlTestNet {
+	rand.Seed(1)
+	tn := &rlTestNet{}
+	net := &leabra.Network{}
+	net.InitName(net, "RWTest")
+	tn.Net = net
+	tn.Input = net.AddLayer2D("Input", 1, 4, emer.Input).(*leabra.Layer)
+	if nUnits > 0 {
+		tn.Rew, tn.Pred, tn.DA = AddRWLayersScalar(net, "", relpos.Behind, 2, nUnits)
+	} else {
+		tn.Rew, tn.Pred, tn.DA = AddRWLayers(net, "", relpos.Behind, 2)
+	}
+	tn.DA.(*RWDaLayer).SendDA.Add(tn.Pred.Name())
+	pj := net.ConnectLayersPrjn(tn.Input, tn.Pred, prjn.NewFull(), emer.Forward, &RWPrjn{})
+	net.Defaults()
+	pj.(*RWPrjn).WtInit.Mean = 0
+	pj.(*RWPrjn).WtInit.Var = 0
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	tn.Time = leabra.NewTime()
+	return tn
+}
+
+// Trial runs one alpha cycle with the Input active and given reward
+func (tn *rlTestNet) Trial(rew float32, learn bool) {
+	tn.Input.ApplyExt1D32([]float32{1, 1, 1, 1})
+	if sl, ok := tn.Rew.(*ScalarValLayer); ok {
+		sl.ApplyVal(rew)
+	} else {
+		tn.Rew.AsLeabra().ApplyExt1D32([]float32{rew})
+	}
+	tn.Net.AlphaCycInit()
+	tn.Time.AlphaCycStart()
+	for qtr := 0; qtr < 4; qtr++ {
+		for cyc := 0; cyc < tn.Time.CycPerQtr; cyc++ {
+			tn.Net.Cycle(tn.Time)
+			tn.Time.CycleInc()
+		}
+		tn.Net.QuarterFinal(tn.Time)
+		tn.Time.QuarterInc()
+	}
+	if learn {
+		tn.Net.DWt()
+		tn.Net.WtFmDWt()
+	}
+}
+
+func TestRWScalar(t *testing.T) {
+	for _, nUnits := range []int{0, 21} {
+		tn := newRWTestNet(t, nUnits)
+		rew := float32(0.7)
+		tn.Trial(rew, false)
+		if da := tn.DA.(*RWDaLayer).DA; math32.Abs(da-rew) > 0.05 {
+			t.Errorf("nUnits: %d initial DA: %g != reward: %g", nUnits, da, rew)
+		}
+		for trl := 0; trl < 100; trl++ {
+			tn.Trial(rew, true)
+		}
+		if pred := LayerVal(tn.Pred, ValActP); math32.Abs(pred-rew) > 0.1 {
+			t.Errorf("nUnits: %d learned prediction: %g != reward: %g", nUnits, pred, rew)
+		}
+		if da := tn.DA.(*RWDaLayer).DA; math32.Abs(da) > 0.1 {
+			t.Errorf("nUnits: %d DA after learning: %g != 0", nUnits, da)
+		}
+	}
+}
+
+func TestTDScalar(t *testing.T) {
+	net := &leabra.Network{}
+	net.InitName(net, "TDTest")
+	rew, rp, ri, td := AddTDLayersScalar(net, "", relpos.Behind, 2, 21)
+	net.Defaults()
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	ltime := leabra.NewTime()
+	rv := float32(0.7)
+	rew.(*ScalarValLayer).ApplyVal(rv)
+	net.AlphaCycInit()
+	ltime.AlphaCycStart()
+	for qtr := 0; qtr < 4; qtr++ {
+		for cyc := 0; cyc < ltime.CycPerQtr; cyc++ {
+			net.Cycle(ltime)
+			ltime.CycleInc()
+		}
+		net.QuarterFinal(ltime)
+		ltime.QuarterInc()
+	}
+	if pv := LayerVal(rp, ValActP); math32.Abs(pv) > 0.05 {
+		t.Errorf("RewPred without learning: %g != 0", pv)
+	}
+	if iv := LayerVal(ri, ValActP); math32.Abs(iv-rv) > 0.05 {
+		t.Errorf("RewInteg plus phase: %g != reward: %g", iv, rv)
+	}
+	if da := td.(*TDDaLayer).DA; math32.Abs(da-rv) > 0.05 {
+		t.Errorf("TD: %g != reward: %g", da, rv)
+	}
+}
@@ -5,11 +5,13 @@
 package rl
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/ccnlab/leabrax/deep"
 	"github.com/ccnlab/leabrax/leabra"
 	"github.com/chewxy/math32"
+	"github.com/emer/emergent/emer"
 	"github.com/goki/ki/kit"
 )
 
@@ -29,6 +31,9 @@ var KiT_TDRewPredLayer = kit.Types.AddType(&TDRewPredLayer{}, leabra.LayerProps)
 func (ly *TDRewPredLayer) GetDA() float32   { return ly.DA }
 func (ly *TDRewPredLayer) SetDA(da float32) { ly.DA = da }
 
+// ActVal returns the prediction value -- ValueLayer interface
+func (ly *TDRewPredLayer) ActVal(vv ValVars) float32 { return UnitVal(&ly.Neurons[0], vv) }
+
 // ActFmG computes linear activation for TDRewPred
 func (ly *TDRewPredLayer) ActFmG(ltime *leabra.Time) {
 	for ni := range ly.Neurons {
@@ -44,13 +49,53 @@ func (ly *TDRewPredLayer) ActFmG(ltime *leabra.Time) {
 	}
 }
 
+//////////////////////////////////////////////////////////////////////////////////////
+//  TDRewPredScalarLayer
+
+// TDRewPredScalarLayer is a population-coded version of TDRewPredLayer, which
+// represents the estimated value as a gaussian bump in a ScalarValLayer:
+// V(t) in the minus phase, and V(t+1) in the plus phase, computed as a
+// linear function of excitatory conductance, clipped to 0..1.
+// Use with TDRewPredPrjn, which learns using the per-unit errors from UnitDAErrs.
+type TDRewPredScalarLayer struct {
+	ScalarValLayer
+	DA float32 `inactive:"+" desc:"dopamine value for this layer"`
+}
+
+var KiT_TDRewPredScalarLayer = kit.Types.AddType(&TDRewPredScalarLayer{}, leabra.LayerProps)
+
+// DALayer interface:
+
+func (ly *TDRewPredScalarLayer) GetDA() float32   { return ly.DA }
+func (ly *TDRewPredScalarLayer) SetDA(da float32) { ly.DA = da }
+
+// UnitDAErrs returns per-unit errors toward the V(t) prediction plus DA -- DAErrLayer interface
+func (ly *TDRewPredScalarLayer) UnitDAErrs(da float32) []float32 {
+	return ly.DAErrs(da, ly.ScalarVal.Min, ly.ScalarVal.Max)
+}
+
+// ActFmG computes linear activation for TDRewPredScalar
+func (ly *TDRewPredScalarLayer) ActFmG(ltime *leabra.Time) {
+	if ltime.Quarter == 3 { // plus phase
+		ly.linearActFmG()
+		return
+	}
+	for ni := range ly.Neurons {
+		nrn := &ly.Neurons[ni]
+		if nrn.IsOff() {
+			continue
+		}
+		nrn.Act = nrn.ActP // previous actP
+	}
+}
+
 //////////////////////////////////////////////////////////////////////////////////////
 //  TDRewIntegLayer
 
 // TDRewIntegParams are params for reward integrator layer
 type TDRewIntegParams struct {
 	Discount float32 `desc:"discount factor -- how much to discount the future prediction from RewPred"`
-	RewPred  string  `desc:"name of TDRewPredLayer to get reward prediction from "`
+	RewPred  string  `desc:"name of TDRewPredLayer or TDRewPredScalarLayer to get reward prediction from "`
 }
 
 func (tp *TDRewIntegParams) Defaults() {
@@ -60,6 +105,22 @@ func (tp *TDRewIntegParams) Defaults() {
 	}
 }
 
+// RewPredLayer returns the RewPred layer, which must be a ValueLayer.
+// lnm is the name of the integ layer, for error messages.
+func (tp *TDRewIntegParams) RewPredLayer(net emer.Network, lnm string) (leabra.LeabraLayer, error) {
+	tly, err := net.LayerByNameTry(tp.RewPred)
+	if err != nil {
+		log.Printf("TDRewIntegLayer %s RewPredLayer: %v\n", lnm, err)
+		return nil, err
+	}
+	if _, ok := tly.(ValueLayer); !ok {
+		err = fmt.Errorf("TDRewIntegLayer %s RewPredLayer: %s is not a ValueLayer", lnm, tp.RewPred)
+		log.Println(err)
+		return nil, err
+	}
+	return tly.(leabra.LeabraLayer), nil
+}
+
 // TDRewIntegLayer is the temporal differences reward integration layer.
 // It represents estimated value V(t) in the minus phase, and
 // estimated V(t+1) + r(t) in the plus phase.
@@ -83,13 +144,11 @@ func (ly *TDRewIntegLayer) Defaults() {
 func (ly *TDRewIntegLayer) GetDA() float32   { return ly.DA }
 func (ly *TDRewIntegLayer) SetDA(da float32) { ly.DA = da }
 
-func (ly *TDRewIntegLayer) RewPredLayer() (*TDRewPredLayer, error) {
-	tly, err := ly.Network.LayerByNameTry(ly.RewInteg.RewPred)
-	if err != nil {
-		log.Printf("TDRewIntegLayer %s RewPredLayer: %v\n", ly.Name(), err)
-		return nil, err
-	}
-	return tly.(*TDRewPredLayer), nil
+// ActVal returns the integrated value -- ValueLayer interface
+func (ly *TDRewIntegLayer) ActVal(vv ValVars) float32 { return UnitVal(&ly.Neurons[0], vv) }
+
+func (ly *TDRewIntegLayer) RewPredLayer() (leabra.LeabraLayer, error) {
+	return ly.RewInteg.RewPredLayer(ly.Network, ly.Name())
 }
 
 // Build constructs the layer state, including calling Build on the projections.
@@ -107,8 +166,8 @@ func (ly *TDRewIntegLayer) ActFmG(ltime *leabra.Time) {
 	if rply == nil {
 		return
 	}
-	rpActP := rply.Neurons[0].ActP
-	rpAct := rply.Neurons[0].Act
+	rpActP := LayerVal(rply, ValActP)
+	rpAct := LayerVal(rply, ValAct)
 	for ni := range ly.Neurons {
 		nrn := &ly.Neurons[ni]
 		if nrn.IsOff() {
@@ -122,15 +181,69 @@ func (ly *TDRewIntegLayer) ActFmG(ltime *leabra.Time) {
 	}
 }
 
+//////////////////////////////////////////////////////////////////////////////////////
+//  TDRewIntegScalarLayer
+
+// TDRewIntegScalarLayer is a population-coded version of TDRewIntegLayer,
+// which represents the estimated value V(t) in the minus phase, and
+// V(t+1) + r(t) in the plus phase, as a gaussian bump in a ScalarValLayer.
+// r(t) is decoded from the excitatory conductances, typically from
+// fixed one-to-one weights from a population-coded reward layer.
+type TDRewIntegScalarLayer struct {
+	ScalarValLayer
+	RewInteg TDRewIntegParams `desc:"parameters for reward integration"`
+	DA       float32          `desc:"dopamine value for this layer"`
+}
+
+var KiT_TDRewIntegScalarLayer = kit.Types.AddType(&TDRewIntegScalarLayer{}, leabra.LayerProps)
+
+func (ly *TDRewIntegScalarLayer) Defaults() {
+	ly.ScalarValLayer.Defaults()
+	ly.RewInteg.Defaults()
+}
+
+// DALayer interface:
+
+func (ly *TDRewIntegScalarLayer) GetDA() float32   { return ly.DA }
+func (ly *TDRewIntegScalarLayer) SetDA(da float32) { ly.DA = da }
+
+func (ly *TDRewIntegScalarLayer) RewPredLayer() (leabra.LeabraLayer, error) {
+	return ly.RewInteg.RewPredLayer(ly.Network, ly.Name())
+}
+
+// Build constructs the layer state, including calling Build on the projections.
+func (ly *TDRewIntegScalarLayer) Build() error {
+	err := ly.ScalarValLayer.Build()
+	if err != nil {
+		return err
+	}
+	_, err = ly.RewPredLayer()
+	return err
+}
+
+func (ly *TDRewIntegScalarLayer) ActFmG(ltime *leabra.Time) {
+	rply, _ := ly.RewPredLayer()
+	if rply == nil {
+		return
+	}
+	if ltime.Quarter == 3 { // plus phase
+		ly.SetActsVal(ly.GeVal() + ly.RewInteg.Discount*LayerVal(rply, ValAct))
+	} else {
+		ly.SetActsVal(LayerVal(rply, ValActP)) // previous actP
+	}
+}
+
 //////////////////////////////////////////////////////////////////////////////////////
 //  TDDaLayer
 
 // TDDaLayer computes a dopamine (DA) signal as the temporal difference (TD)
-// between the TDRewIntegLayer activations in the minus and plus phase.
+// between the TDRewIntegLayer values in the minus and plus phase.
+// The values are read using LayerVal, so the integ layer can be
+// a single unit or population-coded (TDRewIntegScalarLayer).
 type TDDaLayer struct {
 	leabra.Layer
 	SendDA   SendDA  `desc:"list of layers to send dopamine to"`
-	RewInteg string  `desc:"name of TDRewIntegLayer from which this computes the temporal derivative"`
+	RewInteg string  `desc:"name of TDRewIntegLayer or TDRewIntegScalarLayer from which this computes the temporal derivative"`
 	DA       float32 `desc:"dopamine value for this layer"`
 }
 
@@ -149,13 +262,18 @@ func (ly *TDDaLayer) Defaults() {
 func (ly *TDDaLayer) GetDA() float32   { return ly.DA }
 func (ly *TDDaLayer) SetDA(da float32) { ly.DA = da }
 
-func (ly *TDDaLayer) RewIntegLayer() (*TDRewIntegLayer, error) {
+func (ly *TDDaLayer) RewIntegLayer() (leabra.LeabraLayer, error) {
 	tly, err := ly.Network.LayerByNameTry(ly.RewInteg)
 	if err != nil {
 		log.Printf("TDDaLayer %s RewIntegLayer: %v\n", ly.Name(), err)
 		return nil, err
 	}
-	return tly.(*TDRewIntegLayer), nil
+	if _, ok := tly.(ValueLayer); !ok {
+		err = fmt.Errorf("TDDaLayer %s RewIntegLayer: %s is not a ValueLayer", ly.Name(), ly.RewInteg)
+		log.Println(err)
+		return nil, err
+	}
+	return tly.(leabra.LeabraLayer), nil
 }
 
 // Build constructs the layer state, including calling Build on the projections.
@@ -177,8 +295,8 @@ func (ly *TDDaLayer) ActFmG(ltime *leabra.Time) {
 	if rily == nil {
 		return
 	}
-	rpActP := rily.Neurons[0].Act
-	rpActM := rily.Neurons[0].ActM
+	rpActP := LayerVal(rily, ValAct)
+	rpActM := LayerVal(rily, ValActM)
 	da := rpActP - rpActM
 	for ni := range ly.Neurons {
 		nrn := &ly.Neurons[ni]
@@ -207,6 +325,8 @@ func (ly *TDDaLayer) CyclePost(ltime *leabra.Time) {
 // TDRewPredPrjn does dopamine-modulated learning for reward prediction:
 // DWt = Da * Send.ActQ0 (activity on *previous* timestep)
 // Use in TDRewPredLayer typically to generate reward predictions.
+// For a population-coded TDRewPredScalarLayer (DAErrLayer), the per-unit
+// errors toward the encoding of V(t) plus DA are used in place of DA.
 // Has no weight bounds or limits on sign etc.
 type TDRewPredPrjn struct {
 	leabra.Prjn
@@ -230,17 +350,24 @@ func (pj *TDRewPredPrjn) DWt() {
 	}
 	slay := pj.Send.(leabra.LeabraLayer).AsLeabra()
 	// rlay := pj.Recv.(leabra.LeabraLayer).AsLeabra()
-	da := pj.Recv.(DALayer).GetDA()
+	lda := pj.Recv.(DALayer).GetDA()
+	var errs []float32
+	if el, ok := pj.Recv.(DAErrLayer); ok {
+		errs = el.UnitDAErrs(lda)
+	}
 	for si := range slay.Neurons {
 		sn := &slay.Neurons[si]
 		nc := int(pj.SConN[si])
 		st := int(pj.SConIdxSt[si])
 		syns := pj.Syns[st : st+nc]
-		// scons := pj.SConIdx[st : st+nc]
+		scons := pj.SConIdx[st : st+nc]
 
 		for ci := range syns {
 			sy := &syns[ci]
-			// ri := scons[ci]
+			da := lda
+			if errs != nil {
+				da = errs[scons[ci]]
+			}
 
 			dwt := da * sn.ActQ0 // no recv unit activation, prior trial act
 
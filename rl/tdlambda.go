@@ -0,0 +1,146 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rl
+
+import (
+	"github.com/ccnlab/leabrax/deep"
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/chewxy/math32"
+	"github.com/emer/emergent/emer"
+	"github.com/goki/ki/kit"
+)
+
+// TDLambdaParams are parameters for TD(lambda) eligibility traces
+type TDLambdaParams struct {
+	Lambda float32 `def:"0.9" min:"0" max:"1" desc:"trace decay factor -- 0 = one-step TD(0) learning, and 1 = credit assignment to all prior states in the episode, discounted only by Gamma"`
+	Gamma  float32 `def:"0.9" min:"0" max:"1" desc:"discount factor applied to the trace each trial -- should match the Discount in the TDRewIntegLayer"`
+}
+
+func (tp *TDLambdaParams) Defaults() {
+	tp.Lambda = 0.9
+	tp.Gamma = 0.9
+}
+
+// Decay returns the trace decay factor per trial: Gamma * Lambda
+func (tp *TDLambdaParams) Decay() float32 {
+	return tp.Gamma * tp.Lambda
+}
+
+// TDLambdaPrjn is a TDRewPredPrjn that learns with TD(lambda) eligibility traces:
+// each trial the per-synapse trace decays by Gamma * Lambda and accumulates
+// the sending activity on the previous timestep (Send.ActQ0), and
+// DWt = Da * Tr, so that each DA signal updates the values of all recently
+// visited states, instead of only the previous one as in TD(0).
+// Call ClearTrace (or ClearTDTraces for the whole network) at episode boundaries.
+type TDLambdaPrjn struct {
+	TDRewPredPrjn
+	TDLambda TDLambdaParams `view:"inline" desc:"eligibility trace parameters"`
+	TrSyns   []float32      `desc:"eligibility trace values, ordered by the sending layer units which owns them -- one-to-one with SConIdx array"`
+}
+
+var KiT_TDLambdaPrjn = kit.Types.AddType(&TDLambdaPrjn{}, deep.PrjnProps)
+
+func (pj *TDLambdaPrjn) Defaults() {
+	pj.TDRewPredPrjn.Defaults()
+	pj.TDLambda.Defaults()
+}
+
+// Build constructs the projection state, and registers the trace
+// synaptic variable to be saved and loaded in weight files.
+func (pj *TDLambdaPrjn) Build() error {
+	err := pj.TDRewPredPrjn.Build()
+	pj.TrSyns = make([]float32, len(pj.SConIdx))
+	pj.AddWtsSynVar("Tr", func(si int) *float32 { return &pj.TrSyns[si] })
+	return err
+}
+
+// ClearTrace resets the eligibility traces -- call at the start of each episode
+func (pj *TDLambdaPrjn) ClearTrace() {
+	for si := range pj.TrSyns {
+		pj.TrSyns[si] = 0
+	}
+}
+
+func (pj *TDLambdaPrjn) InitWts() {
+	pj.TDRewPredPrjn.InitWts()
+	pj.ClearTrace()
+}
+
+// DWt computes the weight change (learning) -- on sending projections.
+// The traces are updated even when learning is off, so they remain
+// consistent with the sequence of states.
+func (pj *TDLambdaPrjn) DWt() {
+	slay := pj.Send.(leabra.LeabraLayer).AsLeabra()
+	lda := pj.Recv.(DALayer).GetDA()
+	var errs []float32
+	if el, ok := pj.Recv.(DAErrLayer); ok && pj.Learn.Learn {
+		errs = el.UnitDAErrs(lda)
+	}
+	decay := pj.TDLambda.Decay()
+	for si := range slay.Neurons {
+		sn := &slay.Neurons[si]
+		nc := int(pj.SConN[si])
+		st := int(pj.SConIdxSt[si])
+		syns := pj.Syns[st : st+nc]
+		trsyns := pj.TrSyns[st : st+nc]
+		scons := pj.SConIdx[st : st+nc]
+
+		for ci := range syns {
+			sy := &syns[ci]
+			tr := decay*trsyns[ci] + sn.ActQ0 // prior trial act
+			trsyns[ci] = tr
+			if !pj.Learn.Learn {
+				continue
+			}
+			da := lda
+			if errs != nil {
+				da = errs[scons[ci]]
+			}
+			dwt := da * tr
+
+			norm := float32(1)
+			if pj.Learn.Norm.On {
+				norm = pj.Learn.Norm.NormFmAbsDWt(&sy.Norm, math32.Abs(dwt))
+			}
+			if pj.Learn.Momentum.On {
+				dwt = norm * pj.Learn.Momentum.MomentFmDWt(&sy.Moment, dwt)
+			} else {
+				dwt *= norm
+			}
+			sy.DWt += pj.Learn.Lrate * dwt
+		}
+		// aggregate max DWtNorm over sending synapses
+		if pj.Learn.Norm.On {
+			maxNorm := float32(0)
+			for ci := range syns {
+				sy := &syns[ci]
+				if sy.Norm > maxNorm {
+					maxNorm = sy.Norm
+				}
+			}
+			for ci := range syns {
+				sy := &syns[ci]
+				sy.Norm = maxNorm
+			}
+		}
+	}
+}
+
+// ClearTDTraces calls ClearTrace on all TDLambdaPrjn projections in the network --
+// call at episode boundaries so that credit is not assigned across episodes.
+func ClearTDTraces(net emer.Network) {
+	nl := net.NLayers()
+	for li := 0; li < nl; li++ {
+		ly, ok := net.Layer(li).(leabra.LeabraLayer)
+		if !ok {
+			continue
+		}
+		for _, p := range *ly.AsLeabra().RecvPrjns() {
+			if tp, ok := p.(*TDLambdaPrjn); ok {
+				tp.ClearTrace()
+			}
+		}
+	}
+}
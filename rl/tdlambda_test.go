@@ -0,0 +1,135 @@
+// Copyright (c) 2020, The Emergent Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rl
+
+import (
+	"testing"
+
+	"github.com/ccnlab/leabrax/leabra"
+	"github.com/emer/emergent/emer"
+	"github.com/emer/emergent/prjn"
+	"github.com/emer/emergent/relpos"
+)
+
+// chainNet is a TD network for a chain walk: states 0..NStates-1 are visited in
+// order, one per trial, as one-hot Input patterns, followed by an outcome trial
+// with no input, on which the reward is delivered.
+type chainNet struct {
+	Net     *leabra.Network
+	Input   *leabra.Layer
+	Rew     leabra.LeabraLayer
+	RewPred leabra.LeabraLayer
+	TD      *TDDaLayer
+	Prjn    *TDLambdaPrjn
+	Time    *leabra.Time
+	NStates int
+	Pat     []float32
+}
+
+func newChainNet(t *testing.T, nstates int, lambda float32) *chainNet {
+	cn := &chainNet{NStates: nstates}
+	net := &leabra.Network{}
+	net.InitName(net, "ChainTest")
+	cn.Net = net
+	cn.Input = net.AddLayer2D("Input", 1, nstates, emer.Input).(*leabra.Layer)
+	rew, rp, ri, td := AddTDLayers(net, "", relpos.Behind, 2)
+	cn.Rew, cn.RewPred, cn.TD = rew, rp, td.(*TDDaLayer)
+	cn.TD.SendDA.Add(rp.Name())
+	cn.Prjn = net.ConnectLayersPrjn(cn.Input, rp, prjn.NewFull(), emer.Forward, &TDLambdaPrjn{}).(*TDLambdaPrjn)
+	net.Defaults()
+	cn.Prjn.TDLambda.Lambda = lambda
+	cn.Prjn.TDLambda.Gamma = ri.(*TDRewIntegLayer).RewInteg.Discount
+	cn.Prjn.Learn.Lrate = 0.2
+	cn.Prjn.WtInit.Mean = 0
+	cn.Prjn.WtInit.Var = 0
+	rpj := ri.AsLeabra().RcvPrjns.SendName(rew.Name()).(leabra.LeabraPrjn).AsLeabra()
+	rpj.Learn.Learn = false
+	rpj.WtInit.Mean = 1
+	rpj.WtInit.Var = 0
+	if err := net.Build(); err != nil {
+		t.Fatal(err)
+	}
+	net.InitWts()
+	cn.Time = leabra.NewTime()
+	cn.Pat = make([]float32, nstates)
+	return cn
+}
+
+// Trial runs one alpha cycle in given state (none if < 0) with given reward
+func (cn *chainNet) Trial(state int, rew float32, learn bool) {
+	for i := range cn.Pat {
+		cn.Pat[i] = 0
+	}
+	if state >= 0 {
+		cn.Pat[state] = 1
+	}
+	cn.Input.ApplyExt1D32(cn.Pat)
+	cn.Rew.AsLeabra().ApplyExt1D32([]float32{rew})
+	cn.Net.AlphaCycInit()
+	cn.Time.AlphaCycStart()
+	for qtr := 0; qtr < 4; qtr++ {
+		for cyc := 0; cyc < cn.Time.CycPerQtr; cyc++ {
+			cn.Net.Cycle(cn.Time)
+			cn.Time.CycleInc()
+		}
+		cn.Net.QuarterFinal(cn.Time)
+		cn.Time.QuarterInc()
+	}
+	if learn {
+		cn.Net.DWt()
+		cn.Net.WtFmDWt()
+	}
+}
+
+// Episode walks the chain, with the reward on the final outcome trial
+func (cn *chainNet) Episode() {
+	ClearTDTraces(cn.Net)
+	for st := 0; st < cn.NStates; st++ {
+		cn.Trial(st, 0, true)
+	}
+	cn.Trial(-1, 1, true)
+}
+
+// Value returns the predicted value of given state, without learning
+func (cn *chainNet) Value(state int) float32 {
+	cn.Trial(state, 0, false)
+	return LayerVal(cn.RewPred, ValActP)
+}
+
+func TestTDLambdaChain(t *testing.T) {
+	nstates := 5
+	neps := 3
+	td0 := newChainNet(t, nstates, 0)
+	tdl := newChainNet(t, nstates, 0.9)
+	for ep := 0; ep < neps; ep++ {
+		td0.Episode()
+		tdl.Episode()
+	}
+	// TD(0) propagates value back one state per episode, so it
+	// has not yet reached the first states of the chain
+	if v := td0.Value(0); v > 0.01 {
+		t.Errorf("TD(0) state 0 value after %d episodes: %g > 0", neps, v)
+	}
+	if v := td0.Value(nstates - 1); v <= 0.05 {
+		t.Errorf("TD(0) final state value after %d episodes: %g, not learned", neps, v)
+	}
+	// the final state is learned the same by both from the reward itself
+	for st := 0; st < nstates-1; st++ {
+		v0 := td0.Value(st)
+		vl := tdl.Value(st)
+		t.Logf("state: %d\tTD(0): %g\tTD(lambda): %g\n", st, v0, vl)
+		if vl <= v0 {
+			t.Errorf("state: %d TD(lambda) value: %g <= TD(0) value: %g", st, vl, v0)
+		}
+	}
+
+	ClearTDTraces(tdl.Net)
+	for si, tr := range tdl.Prjn.TrSyns {
+		if tr != 0 {
+			t.Errorf("trace %d not cleared: %g", si, tr)
+			break
+		}
+	}
+}